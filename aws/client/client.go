@@ -59,8 +59,9 @@ func New(cfg aws.Config, info metadata.ClientInfo, handlers request.Handlers, op
 	case ok:
 		svc.Retryer = retryer
 	case cfg.Retryer != nil && cfg.Logger != nil:
-		s := fmt.Sprintf("WARNING: %T does not implement request.Retryer; using DefaultRetryer instead", cfg.Retryer)
-		cfg.Logger.Log(s)
+		aws.LogWithFields(cfg.Logger, aws.LogSeverityWarn,
+			"Retryer does not implement request.Retryer; using DefaultRetryer instead",
+			aws.LogField{Key: "retryer", Value: fmt.Sprintf("%T", cfg.Retryer)})
 		fallthrough
 	default:
 		maxRetries := aws.IntValue(cfg.MaxRetries)
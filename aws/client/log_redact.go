@@ -0,0 +1,709 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// LogRedactedHeaders is the list of HTTP headers whose values will be
+// redacted from the request and response HTTP wire logs. Headers bound to
+// members modeled as sensitive are redacted in addition to this list.
+var LogRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Amz-Security-Token",
+	"X-Amz-Server-Side-Encryption-Customer-Key",
+	"X-Amz-Copy-Source-Server-Side-Encryption-Customer-Key",
+}
+
+// logRedactedValue replaces the value of redacted headers and members.
+const logRedactedValue = "<sensitive>"
+
+// sensitiveMembers are the members of a shape that are modeled as sensitive.
+type sensitiveMembers struct {
+	// body is the tree of the shape's members serialized in the body that
+	// are sensitive, or have sensitive nested members. Used to redact JSON,
+	// XML, and query bodies by the path of the members. Nil if the body has
+	// no sensitive members.
+	body *sensitiveShape
+	// headers are the canonical header names of sensitive members bound to
+	// HTTP headers.
+	headers map[string]struct{}
+	// payload is true if the shape's payload member is sensitive, and the
+	// body must be redacted completely.
+	payload bool
+}
+
+// sensitiveShape is a node of the tree of sensitive members. A node is either
+// a sensitive member whose value is redacted completely, or a structure, list
+// or map shape with sensitive nested members.
+type sensitiveShape struct {
+	sensitive bool
+
+	kind reflect.Kind
+	// members of a structure shape, by their wire names.
+	members map[string]*sensitiveShape
+	// elem is the member of list shapes, and value of map shapes.
+	elem *sensitiveShape
+	// flattened is true if the elements of a list are serialized without a
+	// wrapping element.
+	flattened bool
+	// keyName is the wire name of the keys of a map shape.
+	keyName string
+}
+
+// sensitiveMembersCache caches the sensitive members of each shape type, so
+// the shape is only walked the first time a request of it is logged.
+var sensitiveMembersCache = struct {
+	sync.Mutex
+	types map[reflect.Type]*sensitiveMembers
+}{
+	types: map[reflect.Type]*sensitiveMembers{},
+}
+
+// newSensitiveMembers returns the sensitive members of the shape v, walking
+// nested structures, lists, and maps. The sensitive members are identified by
+// the "sensitive" tag generated for members modeled as sensitive.
+func newSensitiveMembers(v interface{}) *sensitiveMembers {
+	if v == nil {
+		return &sensitiveMembers{}
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	sensitiveMembersCache.Lock()
+	defer sensitiveMembersCache.Unlock()
+
+	if m, ok := sensitiveMembersCache.types[t]; ok {
+		return m
+	}
+	m := buildSensitiveMembers(t)
+	sensitiveMembersCache.types[t] = m
+
+	return m
+}
+
+func buildSensitiveMembers(t reflect.Type) *sensitiveMembers {
+	m := &sensitiveMembers{
+		headers: map[string]struct{}{},
+	}
+	if t.Kind() != reflect.Struct {
+		return m
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("sensitive") != "true" {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+		if len(name) == 0 {
+			name = field.Name
+		}
+		switch field.Tag.Get("location") {
+		case "header":
+			m.headers[textproto.CanonicalMIMEHeaderKey(name)] = struct{}{}
+		case "headers":
+			// Prefixed header maps are matched by the header name prefix.
+			m.headers[textproto.CanonicalMIMEHeaderKey(name)+"*"] = struct{}{}
+		}
+	}
+
+	b := sensitiveShapeBuilder{}
+	m.body = b.build(t, "")
+
+	// The body of shapes with a payload member is the payload.
+	if field, ok := t.FieldByName("_"); ok {
+		if name := field.Tag.Get("payload"); len(name) != 0 {
+			m.body = nil
+			if pf, ok := t.FieldByName(name); ok {
+				if pf.Tag.Get("sensitive") == "true" {
+					m.payload = true
+				} else {
+					m.body = b.build(pf.Type, pf.Tag)
+				}
+			}
+		}
+	}
+
+	return m
+}
+
+// sensitiveShapeBuilder builds the sensitive shape trees of structures,
+// caching the structures by type to support recursive shapes.
+type sensitiveShapeBuilder map[reflect.Type]*sensitiveShape
+
+// build returns the sensitive shape of the type t of a member with the tag,
+// or nil if the member has no sensitive nested members.
+func (b sensitiveShapeBuilder) build(t reflect.Type, tag reflect.StructTag) *sensitiveShape {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if s, ok := b[t]; ok {
+			return s
+		}
+		s := &sensitiveShape{
+			kind:    reflect.Struct,
+			members: map[string]*sensitiveShape{},
+		}
+		b[t] = s
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.PkgPath != "" || len(field.Tag.Get("location")) != 0 {
+				continue
+			}
+
+			member := &sensitiveShape{sensitive: true}
+			if field.Tag.Get("sensitive") != "true" {
+				if member = b.build(field.Type, field.Tag); member == nil {
+					continue
+				}
+			}
+			for _, name := range memberWireNames(field) {
+				s.members[name] = member
+			}
+		}
+
+		if len(s.members) == 0 {
+			b[t] = nil
+			return nil
+		}
+		return s
+
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// blob
+			return nil
+		}
+		elem := b.build(t.Elem(), "")
+		if elem == nil {
+			return nil
+		}
+		return &sensitiveShape{
+			kind:      reflect.Slice,
+			elem:      elem,
+			flattened: len(tag.Get("flattened")) != 0,
+		}
+
+	case reflect.Map:
+		elem := b.build(t.Elem(), "")
+		if elem == nil {
+			return nil
+		}
+		keyName := tag.Get("locationNameKey")
+		if len(keyName) == 0 {
+			keyName = "key"
+		}
+		return &sensitiveShape{
+			kind:    reflect.Map,
+			elem:    elem,
+			keyName: keyName,
+		}
+
+	default:
+		return nil
+	}
+}
+
+// memberWireNames returns the names a structure member may be serialized
+// with by the JSON, XML, and query protocols.
+func memberWireNames(field reflect.StructField) []string {
+	names := []string{field.Name}
+	for _, key := range []string{"locationName", "locationNameList", "queryName"} {
+		if name := field.Tag.Get(key); len(name) != 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// redactHeader returns if the value of the header must be redacted.
+func (m *sensitiveMembers) redactHeader(name string) bool {
+	name = textproto.CanonicalMIMEHeaderKey(name)
+	for _, h := range LogRedactedHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	if _, ok := m.headers[name]; ok {
+		return true
+	}
+	for h := range m.headers {
+		if strings.HasSuffix(h, "*") && strings.HasPrefix(name, h[:len(h)-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactDump redacts the header values, and if logBody is set, the body of a
+// HTTP request or response wire dump.
+func (m *sensitiveMembers) redactDump(dump []byte, header http.Header, logBody bool) []byte {
+	sep := []byte("\r\n\r\n")
+	head, body := dump, []byte(nil)
+	if i := bytes.Index(dump, sep); i >= 0 {
+		head, body = dump[:i], dump[i+len(sep):]
+	}
+
+	lines := bytes.Split(head, []byte("\r\n"))
+	// The first line is the request or status line.
+	for i := 1; i < len(lines); i++ {
+		kv := bytes.SplitN(lines[i], []byte(":"), 2)
+		if len(kv) != 2 || !m.redactHeader(string(kv[0])) {
+			continue
+		}
+		lines[i] = []byte(string(kv[0]) + ": " + logRedactedValue)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(bytes.Join(lines, []byte("\r\n")))
+	buf.Write(sep)
+	if logBody {
+		buf.Write(m.redactBody(body, header))
+	}
+
+	return buf.Bytes()
+}
+
+// redactBody returns the body with the values of the sensitive members
+// redacted in place, leaving the rest of the body as it was sent or
+// received. The body is redacted completely if the payload is sensitive, or
+// if the body cannot be parsed and the shape has sensitive members.
+func (m *sensitiveMembers) redactBody(body []byte, header http.Header) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	if m.payload {
+		return []byte(logRedactedValue)
+	}
+	if m.body == nil {
+		return body
+	}
+
+	var r redactions
+	var err error
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case strings.HasPrefix(header.Get("Content-Type"), "application/x-www-form-urlencoded"):
+		r, err = redactQuery(body, m.body)
+	case bytes.HasPrefix(trimmed, []byte("{")), bytes.HasPrefix(trimmed, []byte("[")):
+		r, err = redactJSON(body, m.body)
+	case bytes.HasPrefix(trimmed, []byte("<")):
+		r, err = redactXML(body, m.body)
+	default:
+		err = fmt.Errorf("unknown body format")
+	}
+	if err != nil {
+		return []byte(logRedactedValue)
+	}
+
+	return r.apply(body)
+}
+
+// redaction replaces the bytes of the body from start to end with value.
+type redaction struct {
+	start, end int
+	value      string
+}
+
+// redactions are the redactions of a body, in the order of the body.
+type redactions []redaction
+
+func (r redactions) apply(body []byte) []byte {
+	if len(r) == 0 {
+		return body
+	}
+
+	var buf bytes.Buffer
+	last := 0
+	for _, v := range r {
+		buf.Write(body[last:v.start])
+		buf.WriteString(v.value)
+		last = v.end
+	}
+	buf.Write(body[last:])
+
+	return buf.Bytes()
+}
+
+// redactJSON returns the redactions of the values of the sensitive members
+// in the JSON body.
+func redactJSON(body []byte, shape *sensitiveShape) (redactions, error) {
+	s := &jsonRedactor{data: body}
+	if err := s.value(shape); err != nil {
+		return nil, err
+	}
+	if s.skipSpace(); s.pos != len(s.data) {
+		return nil, fmt.Errorf("invalid character after JSON value, offset %d", s.pos)
+	}
+	return s.redactions, nil
+}
+
+// jsonRedactor scans a JSON document, recording the offsets of the values of
+// sensitive members.
+type jsonRedactor struct {
+	data       []byte
+	pos        int
+	redactions redactions
+}
+
+func (s *jsonRedactor) value(shape *sensitiveShape) error {
+	s.skipSpace()
+	if s.pos >= len(s.data) {
+		return io.ErrUnexpectedEOF
+	}
+
+	if shape != nil && shape.sensitive {
+		start := s.pos
+		if err := s.value(nil); err != nil {
+			return err
+		}
+		s.redactions = append(s.redactions, redaction{
+			start: start, end: s.pos, value: `"` + logRedactedValue + `"`,
+		})
+		return nil
+	}
+
+	switch s.data[s.pos] {
+	case '{':
+		return s.object(shape)
+	case '[':
+		return s.array(shape)
+	case '"':
+		return s.string()
+	default:
+		return s.literal()
+	}
+}
+
+func (s *jsonRedactor) object(shape *sensitiveShape) error {
+	s.pos++
+	if s.skipSpace(); s.pos < len(s.data) && s.data[s.pos] == '}' {
+		s.pos++
+		return nil
+	}
+
+	for {
+		s.skipSpace()
+		start := s.pos
+		if err := s.string(); err != nil {
+			return err
+		}
+		var key string
+		if err := json.Unmarshal(s.data[start:s.pos], &key); err != nil {
+			return err
+		}
+		if err := s.expect(':'); err != nil {
+			return err
+		}
+
+		var member *sensitiveShape
+		if shape != nil {
+			switch shape.kind {
+			case reflect.Struct:
+				member = shape.members[key]
+			case reflect.Map:
+				member = shape.elem
+			}
+		}
+		if err := s.value(member); err != nil {
+			return err
+		}
+
+		if done, err := s.next('}'); err != nil || done {
+			return err
+		}
+	}
+}
+
+func (s *jsonRedactor) array(shape *sensitiveShape) error {
+	s.pos++
+	if s.skipSpace(); s.pos < len(s.data) && s.data[s.pos] == ']' {
+		s.pos++
+		return nil
+	}
+
+	var elem *sensitiveShape
+	if shape != nil && shape.kind == reflect.Slice {
+		elem = shape.elem
+	}
+	for {
+		if err := s.value(elem); err != nil {
+			return err
+		}
+		if done, err := s.next(']'); err != nil || done {
+			return err
+		}
+	}
+}
+
+func (s *jsonRedactor) string() error {
+	if s.pos >= len(s.data) || s.data[s.pos] != '"' {
+		return fmt.Errorf("expect JSON string, offset %d", s.pos)
+	}
+	for s.pos++; s.pos < len(s.data); s.pos++ {
+		switch s.data[s.pos] {
+		case '\\':
+			s.pos++
+		case '"':
+			s.pos++
+			return nil
+		}
+	}
+	return io.ErrUnexpectedEOF
+}
+
+func (s *jsonRedactor) literal() error {
+	start := s.pos
+	for ; s.pos < len(s.data); s.pos++ {
+		c := s.data[s.pos]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+			c == '-' || c == '+' || c == '.') {
+			break
+		}
+	}
+	if s.pos == start {
+		return fmt.Errorf("invalid JSON value, offset %d", s.pos)
+	}
+	return nil
+}
+
+// next consumes the separator after a member or element, returning true if
+// the object or array was closed by end.
+func (s *jsonRedactor) next(end byte) (bool, error) {
+	s.skipSpace()
+	if s.pos >= len(s.data) {
+		return false, io.ErrUnexpectedEOF
+	}
+	switch s.data[s.pos] {
+	case ',':
+		s.pos++
+		return false, nil
+	case end:
+		s.pos++
+		return true, nil
+	default:
+		return false, fmt.Errorf("invalid JSON character %q, offset %d", s.data[s.pos], s.pos)
+	}
+}
+
+func (s *jsonRedactor) expect(c byte) error {
+	s.skipSpace()
+	if s.pos >= len(s.data) || s.data[s.pos] != c {
+		return fmt.Errorf("expect JSON %q, offset %d", c, s.pos)
+	}
+	s.pos++
+	return nil
+}
+
+func (s *jsonRedactor) skipSpace() {
+	for ; s.pos < len(s.data); s.pos++ {
+		switch s.data[s.pos] {
+		case ' ', '\t', '\r', '\n':
+		default:
+			return
+		}
+	}
+}
+
+// redactXML returns the redactions of the content of the elements of
+// sensitive members in the XML body. Elements of the document that are not
+// members of the shape, such as the operation's response and result
+// elements, are walked as wrappers of the shape's members.
+func redactXML(body []byte, shape *sensitiveShape) (redactions, error) {
+	s := &xmlRedactor{d: xml.NewDecoder(bytes.NewReader(body))}
+	if err := s.element(shape, true); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return s.redactions, nil
+}
+
+// xmlRedactor walks a XML document, recording the offsets of the content of
+// sensitive members.
+type xmlRedactor struct {
+	d          *xml.Decoder
+	redactions redactions
+}
+
+// element walks the content of an element of the shape, until its end
+// element. The top elements are wrappers of the shape's members.
+func (s *xmlRedactor) element(shape *sensitiveShape, top bool) error {
+	for {
+		tok, err := s.d.Token()
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, childTop := xmlChild(shape, t.Name.Local, top)
+			if child == nil || !child.sensitive {
+				err = s.element(child, childTop)
+			} else {
+				start := s.d.InputOffset()
+				var end int64
+				if end, err = s.skip(); err == nil && end > start {
+					s.redactions = append(s.redactions, redaction{
+						start: int(start), end: int(end), value: "&lt;sensitive&gt;",
+					})
+				}
+			}
+			if err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// skip skips the content of the current element, returning the offset of
+// its end element.
+func (s *xmlRedactor) skip() (int64, error) {
+	for depth := 0; ; {
+		offset := s.d.InputOffset()
+		tok, err := s.d.Token()
+		if err != nil {
+			return 0, err
+		}
+
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			if depth == 0 {
+				return offset, nil
+			}
+			depth--
+		}
+	}
+}
+
+// xmlChild returns the shape of the child element name of an element with
+// the shape, and if the child is a wrapper of the shape's members.
+func xmlChild(shape *sensitiveShape, name string, top bool) (*sensitiveShape, bool) {
+	if shape == nil {
+		return nil, false
+	}
+
+	switch shape.kind {
+	case reflect.Struct:
+		member, ok := shape.members[name]
+		if !ok {
+			if top {
+				return shape, true
+			}
+			return nil, false
+		}
+		if member.kind == reflect.Slice && member.flattened {
+			// Flattened list elements are not wrapped by the member's
+			// element.
+			return member.elem, false
+		}
+		return member, false
+
+	case reflect.Slice:
+		return shape.elem, false
+
+	case reflect.Map:
+		switch name {
+		case shape.keyName:
+			return nil, false
+		case "entry":
+			return shape, false
+		default:
+			return shape.elem, false
+		}
+	}
+
+	return nil, false
+}
+
+// redactQuery returns the redactions of the values of the sensitive members
+// in the query string body.
+func redactQuery(body []byte, shape *sensitiveShape) (redactions, error) {
+	var r redactions
+	offset := 0
+	for _, pair := range bytes.Split(body, []byte("&")) {
+		start := offset
+		offset += len(pair) + 1
+
+		i := bytes.IndexByte(pair, '=')
+		if i < 0 {
+			continue
+		}
+		key, err := url.QueryUnescape(string(pair[:i]))
+		if err != nil {
+			return nil, err
+		}
+
+		if querySensitive(shape, strings.Split(key, ".")) {
+			r = append(r, redaction{
+				start: start + i + 1, end: start + len(pair),
+				value: url.QueryEscape(logRedactedValue),
+			})
+		}
+	}
+
+	return r, nil
+}
+
+// querySensitive returns if the query key, split into its parts, is the key
+// of a sensitive member of the shape.
+func querySensitive(shape *sensitiveShape, parts []string) bool {
+	for ; shape != nil && len(parts) != 0; parts = parts[1:] {
+		if shape.sensitive {
+			return true
+		}
+
+		switch shape.kind {
+		case reflect.Struct:
+			shape = shape.members[parts[0]]
+
+		case reflect.Slice:
+			// List elements are keyed by their index, after the optional
+			// member name.
+			if isQueryIndex(parts[0]) {
+				shape = shape.elem
+			}
+
+		case reflect.Map:
+			// Map entries are keyed by their index, after the optional entry
+			// name, followed by the key or value name.
+			if isQueryIndex(parts[0]) {
+				parts = parts[1:]
+				if len(parts) == 0 || parts[0] == shape.keyName {
+					return false
+				}
+				shape = shape.elem
+			}
+		}
+	}
+
+	return shape != nil && shape.sensitive
+}
+
+func isQueryIndex(part string) bool {
+	_, err := strconv.Atoi(part)
+	return err == nil
+}
@@ -0,0 +1,157 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type mockSensitiveInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+
+	Secret *string `type:"string" sensitive:"true"`
+
+	Token *string `location:"header" locationName:"x-amz-mock-token" type:"string" sensitive:"true"`
+
+	Nested *mockSensitiveNested `type:"structure"`
+
+	// Members named as the sensitive members of nested shapes are not
+	// sensitive.
+	Password *string `locationName:"password" type:"string"`
+
+	List []*mockSensitiveNested `type:"list"`
+
+	Map map[string]*mockSensitiveNested `type:"map"`
+}
+
+type mockSensitiveNested struct {
+	_ struct{} `type:"structure"`
+
+	Password *string `locationName:"password" type:"string" sensitive:"true"`
+
+	Secret *string `type:"string"`
+
+	Nested *mockSensitiveNested `type:"structure"`
+}
+
+type mockSensitivePayload struct {
+	_ struct{} `type:"structure" payload:"Body"`
+
+	Body []byte `type:"blob" sensitive:"true"`
+}
+
+func TestRedactDump_Headers(t *testing.T) {
+	m := newSensitiveMembers(&mockSensitiveInput{})
+
+	dump := "POST / HTTP/1.1\r\n" +
+		"Host: mock-service.mock-region.amazonaws.com\r\n" +
+		"Authorization: AWS4-HMAC-SHA256 Credential=AKID/20200101/mock-region/mock/aws4_request\r\n" +
+		"X-Amz-Mock-Token: token\r\n" +
+		"X-Amz-Security-Token: session\r\n" +
+		"\r\n"
+
+	actual := string(m.redactDump([]byte(dump), http.Header{}, false))
+
+	for _, v := range []string{"AKID", "token", "session"} {
+		if strings.Contains(actual, v) {
+			t.Errorf("expect %q to be redacted, got %s", v, actual)
+		}
+	}
+	if e, a := "Host: mock-service.mock-region.amazonaws.com", actual; !strings.Contains(a, e) {
+		t.Errorf("expect %q to not be redacted, got %s", e, a)
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := map[string]struct {
+		Shape       interface{}
+		ContentType string
+		Body        string
+		Expect      string
+	}{
+		"json": {
+			Shape:  &mockSensitiveInput{},
+			Body:   `{"Name":"abc","Secret":"123","Nested":{"password":"456","Secret":"abc"}}`,
+			Expect: `{"Name":"abc","Secret":"<sensitive>","Nested":{"password":"<sensitive>","Secret":"abc"}}`,
+		},
+		"json by path": {
+			Shape: &mockSensitiveInput{},
+			Body: `{ "password": "abc", "Nested": {"Nested": {"password": {"a": [1, 2]}}},
+				"List": [{"password": "123"}, {"Secret": "abc"}],
+				"Map": {"password": {"password": "456"}} }`,
+			Expect: `{ "password": "abc", "Nested": {"Nested": {"password": "<sensitive>"}},
+				"List": [{"password": "<sensitive>"}, {"Secret": "abc"}],
+				"Map": {"password": {"password": "<sensitive>"}} }`,
+		},
+		"xml": {
+			Shape:  &mockSensitiveInput{},
+			Body:   `<Input><Name>abc</Name><Secret>123</Secret><Nested><password a="b">456</password></Nested></Input>`,
+			Expect: `<Input><Name>abc</Name><Secret>&lt;sensitive&gt;</Secret><Nested><password a="b">&lt;sensitive&gt;</password></Nested></Input>`,
+		},
+		"xml by path": {
+			Shape: &mockSensitiveInput{},
+			Body: `<OpResponse><OpResult><password>abc</password>` +
+				`<List><member><password>123</password></member><member><Secret>abc</Secret></member></List>` +
+				`<Map><entry><key>password</key><value><password>456</password></value></entry></Map>` +
+				`<Nested><password/></Nested></OpResult></OpResponse>`,
+			Expect: `<OpResponse><OpResult><password>abc</password>` +
+				`<List><member><password>&lt;sensitive&gt;</password></member><member><Secret>abc</Secret></member></List>` +
+				`<Map><entry><key>password</key><value><password>&lt;sensitive&gt;</password></value></entry></Map>` +
+				`<Nested><password/></Nested></OpResult></OpResponse>`,
+		},
+		"query": {
+			Shape:       &mockSensitiveInput{},
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Body:        `Action=Op&Secret=123&Name=abc&Nested.password=456`,
+			Expect:      `Action=Op&Secret=%3Csensitive%3E&Name=abc&Nested.password=%3Csensitive%3E`,
+		},
+		"query by path": {
+			Shape:       &mockSensitiveInput{},
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Body: `Action=Op&password=abc&List.member.1.password=123&List.member.2.Secret=abc` +
+				`&Map.entry.1.key=password&Map.entry.1.value.password=456`,
+			Expect: `Action=Op&password=abc&List.member.1.password=%3Csensitive%3E&List.member.2.Secret=abc` +
+				`&Map.entry.1.key=password&Map.entry.1.value.password=%3Csensitive%3E`,
+		},
+		"invalid json": {
+			Shape:  &mockSensitiveInput{},
+			Body:   `{"Secret":"123"`,
+			Expect: `<sensitive>`,
+		},
+		"sensitive payload": {
+			Shape:  &mockSensitivePayload{},
+			Body:   `123`,
+			Expect: `<sensitive>`,
+		},
+		"no sensitive members": {
+			Shape:  &struct{ Name *string }{},
+			Body:   `{"Name":"abc"}`,
+			Expect: `{"Name":"abc"}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			header := http.Header{}
+			if len(c.ContentType) != 0 {
+				header.Set("Content-Type", c.ContentType)
+			}
+
+			m := newSensitiveMembers(c.Shape)
+			actual := string(m.redactBody([]byte(c.Body), header))
+			if e, a := c.Expect, actual; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestNewSensitiveMembers_Cached(t *testing.T) {
+	a := newSensitiveMembers(&mockSensitiveInput{})
+	b := newSensitiveMembers(mockSensitiveInput{})
+	if a != b {
+		t.Errorf("expect sensitive members of the shape to be cached")
+	}
+}
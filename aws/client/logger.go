@@ -11,15 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 )
 
-const logReqMsg = `DEBUG: Request %s/%s Details:
+const logReqMsg = `Request Details:
 ---[ REQUEST POST-SIGN ]-----------------------------
 %s
 -----------------------------------------------------`
 
-const logReqErrMsg = `DEBUG ERROR: Request %s/%s:
----[ REQUEST DUMP ERROR ]-----------------------------
-%s
-------------------------------------------------------`
+const logReqErrMsg = `Request dump failed`
 
 type logWriter struct {
 	// Logger is what we will use to log the payload of a response.
@@ -47,6 +44,9 @@ func (reader *teeReaderCloser) Close() error {
 // LogHTTPRequestHandler is a SDK request handler to log the HTTP request sent
 // to a service. Will include the HTTP request body if the LogLevel of the
 // request matches LogDebugWithHTTPBody.
+//
+// The values of headers in LogRedactedHeaders, and of members modeled as
+// sensitive are redacted from the logged request.
 var LogHTTPRequestHandler = request.NamedHandler{
 	Name: "awssdk.client.LogRequest",
 	Fn:   logRequest,
@@ -58,8 +58,7 @@ func logRequest(r *request.Request) {
 
 	b, err := httputil.DumpRequestOut(r.HTTPRequest, logBody)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logError(r, logReqErrMsg, err)
 		return
 	}
 
@@ -71,14 +70,13 @@ func logRequest(r *request.Request) {
 		// r.HTTPRequest's Body as a NoOpCloser and will not be reset after
 		// read by the HTTP client reader.
 		if err := r.Error; err != nil {
-			r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-				r.ClientInfo.ServiceName, r.Operation.Name, err))
+			logError(r, logReqErrMsg, err)
 			return
 		}
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logReqMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	b = newSensitiveMembers(r.Params).redactDump(b, r.HTTPRequest.Header, logBody)
+	logDebug(r, fmt.Sprintf(logReqMsg, string(b)))
 }
 
 // LogHTTPRequestHeaderHandler is a SDK request handler to log the HTTP request sent
@@ -92,28 +90,32 @@ var LogHTTPRequestHeaderHandler = request.NamedHandler{
 func logRequestHeader(r *request.Request) {
 	b, err := httputil.DumpRequestOut(r.HTTPRequest, false)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logReqErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logError(r, logReqErrMsg, err)
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logReqMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	b = newSensitiveMembers(r.Params).redactDump(b, r.HTTPRequest.Header, false)
+	logDebug(r, fmt.Sprintf(logReqMsg, string(b)))
 }
 
-const logRespMsg = `DEBUG: Response %s/%s Details:
+const logRespMsg = `Response Details:
 ---[ RESPONSE ]--------------------------------------
 %s
 -----------------------------------------------------`
 
-const logRespErrMsg = `DEBUG ERROR: Response %s/%s:
----[ RESPONSE DUMP ERROR ]-----------------------------
+const logRespBodyMsg = `Response Body:
+---[ RESPONSE BODY ]---------------------------------
 %s
 -----------------------------------------------------`
 
+const logRespErrMsg = `Response dump failed`
+
 // LogHTTPResponseHandler is a SDK request handler to log the HTTP response
 // received from a service. Will include the HTTP response body if the LogLevel
 // of the request matches LogDebugWithHTTPBody.
+//
+// The values of headers in LogRedactedHeaders, and of members modeled as
+// sensitive are redacted from the logged response.
 var LogHTTPResponseHandler = request.NamedHandler{
 	Name: "awssdk.client.LogResponse",
 	Fn:   logResponse,
@@ -123,8 +125,7 @@ func logResponse(r *request.Request) {
 	lw := &logWriter{r.Config.Logger, bytes.NewBuffer(nil)}
 
 	if r.HTTPResponse == nil {
-		lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, "request's HTTPResponse is nil"))
+		logError(r, logRespErrMsg, "request's HTTPResponse is nil")
 		return
 	}
 
@@ -137,25 +138,26 @@ func logResponse(r *request.Request) {
 	}
 
 	handlerFn := func(req *request.Request) {
+		sensitive := newSensitiveMembers(req.Data)
+
 		b, err := httputil.DumpResponse(req.HTTPResponse, false)
 		if err != nil {
-			lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-				req.ClientInfo.ServiceName, req.Operation.Name, err))
+			logError(req, logRespErrMsg, err)
 			return
 		}
 
-		lw.Logger.Log(fmt.Sprintf(logRespMsg,
-			req.ClientInfo.ServiceName, req.Operation.Name, string(b)))
+		b = sensitive.redactDump(b, req.HTTPResponse.Header, false)
+		logDebug(req, fmt.Sprintf(logRespMsg, string(b)))
 
 		if logBody {
 			b, err := ioutil.ReadAll(lw.buf)
 			if err != nil {
-				lw.Logger.Log(fmt.Sprintf(logRespErrMsg,
-					req.ClientInfo.ServiceName, req.Operation.Name, err))
+				logError(req, logRespErrMsg, err)
 				return
 			}
 
-			lw.Logger.Log(string(b))
+			b = sensitive.redactBody(b, req.HTTPResponse.Header)
+			logDebug(req, fmt.Sprintf(logRespBodyMsg, string(b)))
 		}
 	}
 
@@ -184,11 +186,23 @@ func logResponseHeader(r *request.Request) {
 
 	b, err := httputil.DumpResponse(r.HTTPResponse, false)
 	if err != nil {
-		r.Config.Logger.Log(fmt.Sprintf(logRespErrMsg,
-			r.ClientInfo.ServiceName, r.Operation.Name, err))
+		logError(r, logRespErrMsg, err)
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf(logRespMsg,
-		r.ClientInfo.ServiceName, r.Operation.Name, string(b)))
+	b = newSensitiveMembers(r.Data).redactDump(b, r.HTTPResponse.Header, false)
+	logDebug(r, fmt.Sprintf(logRespMsg, string(b)))
+}
+
+// logDebug writes a debug entry for the request, with the request scoped
+// fields attached.
+func logDebug(r *request.Request, msg string) {
+	aws.LogWithFields(r.Config.Logger, aws.LogSeverityDebug, msg, r.LogFields()...)
+}
+
+// logError writes an error entry for the request, with the request scoped
+// fields and the error attached.
+func logError(r *request.Request, msg string, err interface{}) {
+	aws.LogWithFields(r.Config.Logger, aws.LogSeverityError, msg,
+		append(r.LogFields(), aws.LogField{Key: aws.LogFieldError, Value: err})...)
 }
@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -128,6 +129,59 @@ func TestLogRequest(t *testing.T) {
 	}
 }
 
+func TestLogRequest_StructuredLogger(t *testing.T) {
+	var entries []structuredEntry
+	req := request.New(
+		aws.Config{
+			Credentials: credentials.AnonymousCredentials,
+			Logger: aws.StructuredLoggerFunc(func(s aws.LogSeverity, msg string, fields ...aws.LogField) {
+				entries = append(entries, structuredEntry{severity: s, msg: msg, fields: fields})
+			}),
+			LogLevel: aws.LogLevel(aws.LogDebug),
+		},
+		metadata.ClientInfo{
+			ServiceName: "mockService",
+			Endpoint:    "https://mock-service.mock-region.amazonaws.com",
+		},
+		testHandlers(),
+		nil,
+		&request.Operation{
+			Name:       "APIName",
+			HTTPMethod: "POST",
+			HTTPPath:   "/",
+		},
+		struct{}{}, nil,
+	)
+	req.Build()
+
+	logRequest(req)
+
+	if e, a := 1, len(entries); e != a {
+		t.Fatalf("expect %v log entries, got %v", e, a)
+	}
+	entry := entries[0]
+	if e, a := aws.LogSeverityDebug, entry.severity; e != a {
+		t.Errorf("expect %v severity, got %v", e, a)
+	}
+	if e, a := "Request Details:", entry.msg; !strings.HasPrefix(a, e) {
+		t.Errorf("expect message to start with %q, got %q", e, a)
+	}
+
+	fields := map[string]interface{}{}
+	for _, f := range entry.fields {
+		fields[f.Key] = f.Value
+	}
+	if e, a := "mockService", fields[aws.LogFieldService]; e != a {
+		t.Errorf("expect %v service field, got %v", e, a)
+	}
+	if e, a := "APIName", fields[aws.LogFieldOperation]; e != a {
+		t.Errorf("expect %v operation field, got %v", e, a)
+	}
+	if e, a := 1, fields[aws.LogFieldAttempt]; e != a {
+		t.Errorf("expect %v attempt field, got %v", e, a)
+	}
+}
+
 func TestLogResponse(t *testing.T) {
 	cases := []struct {
 		Body       *bytes.Buffer
@@ -213,6 +267,12 @@ func (l *bufLogger) Log(args ...interface{}) {
 	fmt.Fprintln(l.w, args...)
 }
 
+type structuredEntry struct {
+	severity aws.LogSeverity
+	msg      string
+	fields   []aws.LogField
+}
+
 func testHandlers() request.Handlers {
 	var handlers request.Handlers
 
@@ -189,6 +189,31 @@ type Config struct {
 	//
 	EC2MetadataDisableTimeoutOverride *bool
 
+	// Set this to override the endpoint of the EC2 instance metadata service
+	// used by EC2Metadata clients created from a session and by the default
+	// credentials chain, such as "http://[fd00:ec2::254]". The "/latest"
+	// path is appended to the endpoint by the SDK. Takes precedence over
+	// EC2MetadataServiceEndpointMode. Ignored by clients with Endpoint set.
+	//
+	// Can also be set with the AWS_EC2_METADATA_SERVICE_ENDPOINT environment
+	// variable, or the ec2_metadata_service_endpoint shared config key.
+	EC2MetadataServiceEndpoint *string
+
+	// Selects the IPv4 or IPv6 endpoint of the EC2 instance metadata service.
+	// Defaults to the IPv4 endpoint. Select the IPv6 endpoint on instances
+	// without IPv4 connectivity, such as IPv6-only Nitro instances.
+	//
+	// Can also be set with the AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE
+	// environment variable, or the ec2_metadata_service_endpoint_mode shared
+	// config key, to `IPv4` or `IPv6`.
+	EC2MetadataServiceEndpointMode endpoints.EC2IMDSEndpointModeState
+
+	// Set this to `false` to prevent the EC2Metadata client from falling back
+	// to insecure, IMDSv1, requests when it fails to retrieve an IMDSv2 session
+	// token. With the fallback disabled the failure to retrieve the token is
+	// returned as the request's error. Enabled by default.
+	EC2MetadataEnableFallback *bool
+
 	// Instructs the endpoint to be generated for a service client to
 	// be the dual stack endpoint. The dual stack endpoint will support
 	// both IPv4 and IPv6 addressing.
@@ -403,6 +428,28 @@ func (c *Config) WithS3UseARNRegion(enable bool) *Config {
 	return c
 }
 
+// WithEC2MetadataServiceEndpoint sets a config EC2MetadataServiceEndpoint
+// value returning a Config pointer for chaining.
+func (c *Config) WithEC2MetadataServiceEndpoint(endpoint string) *Config {
+	c.EC2MetadataServiceEndpoint = &endpoint
+	return c
+}
+
+// WithEC2MetadataServiceEndpointMode sets a config
+// EC2MetadataServiceEndpointMode value returning a Config pointer for
+// chaining.
+func (c *Config) WithEC2MetadataServiceEndpointMode(mode endpoints.EC2IMDSEndpointModeState) *Config {
+	c.EC2MetadataServiceEndpointMode = mode
+	return c
+}
+
+// WithEC2MetadataEnableFallback sets a config EC2MetadataEnableFallback value
+// returning a Config pointer for chaining.
+func (c *Config) WithEC2MetadataEnableFallback(enable bool) *Config {
+	c.EC2MetadataEnableFallback = &enable
+	return c
+}
+
 // WithUseDualStack sets a config UseDualStack value returning a Config
 // pointer for chaining.
 func (c *Config) WithUseDualStack(enable bool) *Config {
@@ -543,6 +590,18 @@ func mergeInConfig(dst *Config, other *Config) {
 		dst.EC2MetadataDisableTimeoutOverride = other.EC2MetadataDisableTimeoutOverride
 	}
 
+	if other.EC2MetadataServiceEndpoint != nil {
+		dst.EC2MetadataServiceEndpoint = other.EC2MetadataServiceEndpoint
+	}
+
+	if other.EC2MetadataServiceEndpointMode != endpoints.EC2IMDSEndpointModeStateUnset {
+		dst.EC2MetadataServiceEndpointMode = other.EC2MetadataServiceEndpointMode
+	}
+
+	if other.EC2MetadataEnableFallback != nil {
+		dst.EC2MetadataEnableFallback = other.EC2MetadataEnableFallback
+	}
+
 	if other.SleepDelay != nil {
 		dst.SleepDelay = other.SleepDelay
 	}
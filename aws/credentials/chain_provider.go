@@ -1,6 +1,10 @@
 package credentials
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
 
@@ -30,6 +34,11 @@ var (
 // will cache that Provider for all calls to IsExpired(), until Retrieve is
 // called again.
 //
+// The attempts of the providers to retrieve credentials during the most recent
+// Retrieve, and the time each took, are reported by Attempts. Use Attempts to
+// explain why credentials were retrieved from a provider, and not from the
+// providers before it in the chain.
+//
 // Example of ChainProvider to be used with an EnvProvider and EC2RoleProvider.
 // In this example EnvProvider will first check if any credentials are available
 // via the environment variables. If there are none ChainProvider will check
@@ -54,6 +63,30 @@ type ChainProvider struct {
 	Providers     []Provider
 	curr          Provider
 	VerboseErrors bool
+
+	attemptsMu sync.Mutex
+	attempts   []ChainAttempt
+}
+
+// A ChainAttempt is the attempt of a ChainProvider's provider to retrieve
+// credentials.
+type ChainAttempt struct {
+	// The provider, and the name of its type, such as
+	// "*credentials.EnvProvider".
+	Provider     Provider
+	ProviderType string
+
+	// The time the attempt started, and how long it took.
+	Start    time.Time
+	Duration time.Duration
+
+	// The error retrieving the credentials, nil if the provider retrieved
+	// the credentials.
+	Err error
+
+	// The source of the credentials retrieved. Only set if the provider
+	// retrieved the credentials.
+	Source Source
 }
 
 // NewChainCredentials returns a pointer to a new Credentials object
@@ -70,9 +103,46 @@ func NewChainCredentials(providers []Provider) *Credentials {
 // If a provider is found it will be cached and any calls to IsExpired()
 // will return the expired state of the cached provider.
 func (c *ChainProvider) Retrieve() (Value, error) {
+	return c.RetrieveWithContext(backgroundContext())
+}
+
+// RetrieveWithContext returns the credentials value or error if no provider
+// returned without error. The context is passed to the providers which
+// implement ProviderWithContext.
+//
+// If a provider is found it will be cached and any calls to IsExpired()
+// will return the expired state of the cached provider.
+func (c *ChainProvider) RetrieveWithContext(ctx Context) (Value, error) {
 	var errs []error
+	attempts := make([]ChainAttempt, 0, len(c.Providers))
+	defer func() {
+		c.attemptsMu.Lock()
+		c.attempts = attempts
+		c.attemptsMu.Unlock()
+	}()
+
 	for _, p := range c.Providers {
-		creds, err := p.Retrieve()
+		attempt := ChainAttempt{
+			Provider:     p,
+			ProviderType: fmt.Sprintf("%T", p),
+			Start:        time.Now(),
+		}
+
+		var creds Value
+		var err error
+		if pc, ok := p.(ProviderWithContext); ok {
+			creds, err = pc.RetrieveWithContext(ctx)
+		} else {
+			creds, err = p.Retrieve()
+		}
+
+		attempt.Duration = time.Since(attempt.Start)
+		attempt.Err = err
+		if err == nil {
+			attempt.Source = creds.Source
+		}
+		attempts = append(attempts, attempt)
+
 		if err == nil {
 			c.curr = p
 			return creds, nil
@@ -89,6 +159,17 @@ func (c *ChainProvider) Retrieve() (Value, error) {
 	return Value{}, err
 }
 
+// Attempts returns the attempts of the providers to retrieve credentials
+// during the most recent Retrieve, in the order of the providers. The last
+// attempt is of the provider which retrieved the credentials, unless all of
+// the providers failed.
+func (c *ChainProvider) Attempts() []ChainAttempt {
+	c.attemptsMu.Lock()
+	defer c.attemptsMu.Unlock()
+
+	return append([]ChainAttempt(nil), c.attempts...)
+}
+
 // IsExpired will returned the expired state of the currently cached provider
 // if there is one.  If there is no current provider, true will be returned.
 func (c *ChainProvider) IsExpired() bool {
@@ -98,3 +179,14 @@ func (c *ChainProvider) IsExpired() bool {
 
 	return true
 }
+
+// ExtendedErr returns the error the currently cached provider failed to
+// refresh the credentials with, if it implements ExtendedExpirer and returned
+// its previous credentials with an extended expiration.
+func (c *ChainProvider) ExtendedErr() error {
+	if e, ok := c.curr.(ExtendedExpirer); ok {
+		return e.ExtendedErr()
+	}
+
+	return nil
+}
@@ -128,6 +128,37 @@ func TestChainProviderIsExpired(t *testing.T) {
 	}
 }
 
+func TestChainProviderExtendedErr(t *testing.T) {
+	extender := &stubProviderExtender{
+		stubProviderExpirer: stubProviderExpirer{
+			stubProvider: stubProvider{
+				creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"},
+			},
+		},
+	}
+	p := &ChainProvider{
+		Providers: []Provider{
+			&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
+			extender,
+		},
+	}
+
+	if err := p.ExtendedErr(); err != nil {
+		t.Errorf("expect no error before retrieving, got %v", err)
+	}
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := p.ExtendedErr(); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+
+	extender.extendedErr = awserr.New("ProviderError", "failed", nil)
+	if e, a := extender.extendedErr, p.ExtendedErr(); e != a {
+		t.Errorf("expect %v error of the current provider, got %v", e, a)
+	}
+}
+
 func TestChainProviderWithNoProvider(t *testing.T) {
 	p := &ChainProvider{
 		Providers: []Provider{},
@@ -187,3 +218,95 @@ func TestChainProviderWithNoValidProviderWithVerboseEnabled(t *testing.T) {
 		t.Errorf("Expect no providers error returned, %v, got %v", e, a)
 	}
 }
+
+func TestChainProviderAttempts(t *testing.T) {
+	errs := []error{
+		awserr.New("FirstError", "first provider error", nil),
+		awserr.New("SecondError", "second provider error", nil),
+	}
+	p := &ChainProvider{
+		Providers: []Provider{
+			&stubProvider{err: errs[0]},
+			&secondStubProvider{err: errs[1]},
+			&stubProvider{
+				creds: Value{
+					AccessKeyID:     "AKID",
+					SecretAccessKey: "SECRET",
+					Source:          Source{Kind: SourceStatic},
+				},
+			},
+			&stubProvider{},
+		},
+	}
+
+	if attempts := p.Attempts(); len(attempts) != 0 {
+		t.Errorf("expect no attempts before retrieve, got %v", attempts)
+	}
+
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	attempts := p.Attempts()
+	if e, a := 3, len(attempts); e != a {
+		t.Fatalf("expect %v attempts, got %v", e, a)
+	}
+
+	expectTypes := []string{
+		"*credentials.stubProvider",
+		"*credentials.secondStubProvider",
+		"*credentials.stubProvider",
+	}
+	expectErrs := []error{errs[0], errs[1], nil}
+	for i, attempt := range attempts {
+		if e, a := p.Providers[i], attempt.Provider; e != a {
+			t.Errorf("%d, expect provider %v, got %v", i, e, a)
+		}
+		if e, a := expectTypes[i], attempt.ProviderType; e != a {
+			t.Errorf("%d, expect provider type %v, got %v", i, e, a)
+		}
+		if e, a := expectErrs[i], attempt.Err; e != a {
+			t.Errorf("%d, expect error %v, got %v", i, e, a)
+		}
+		if attempt.Start.IsZero() {
+			t.Errorf("%d, expect start time to be set", i)
+		}
+		if attempt.Duration < 0 {
+			t.Errorf("%d, expect non-negative duration, got %v", i, attempt.Duration)
+		}
+	}
+
+	if e, a := (Source{}), attempts[0].Source; e != a {
+		t.Errorf("expect no source for failed attempt, got %v", a)
+	}
+	if e, a := (Source{Kind: SourceStatic}), attempts[2].Source; e != a {
+		t.Errorf("expect %v source, got %v", e, a)
+	}
+
+	// Attempts are replaced by the next retrieve.
+	p.Providers = p.Providers[2:]
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(p.Attempts()); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+}
+
+func TestCredentialsChainAttempts(t *testing.T) {
+	c := NewChainCredentials([]Provider{
+		&stubProvider{err: awserr.New("FirstError", "first provider error", nil)},
+		&stubProvider{creds: Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"}},
+	})
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(c.ChainAttempts()); e != a {
+		t.Errorf("expect %v attempts, got %v", e, a)
+	}
+
+	c = NewStaticCredentials("AKID", "SECRET", "")
+	if attempts := c.ChainAttempts(); attempts != nil {
+		t.Errorf("expect no attempts for non chain provider, got %v", attempts)
+	}
+}
@@ -0,0 +1,88 @@
+// +build !go1.7
+
+package credentials
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/internal/context"
+)
+
+// backgroundContext returns a context that will never be canceled, has no
+// values, and no deadline. This context is used by the SDK to provide
+// backwards compatibility with non-context API operations and functionality.
+//
+// Go 1.6 and before:
+// This context function is equivalent to context.Background in the Go stdlib.
+//
+// Go 1.7 and later:
+// The context returned will be the value returned by context.Background()
+//
+// See https://golang.org/pkg/context for more information on Contexts.
+func backgroundContext() Context {
+	return context.BackgroundCtx
+}
+
+var (
+	errContextCanceled         = errors.New("context canceled")
+	errContextDeadlineExceeded = errors.New("context deadline exceeded")
+)
+
+// A timeoutCtx is a Go 1.6 and before compatible context that is canceled
+// once its deadline passes, or it is canceled.
+type timeoutCtx struct {
+	deadline time.Time
+	timer    *time.Timer
+	done     chan struct{}
+
+	m   sync.Mutex
+	err error
+}
+
+// timeoutContext returns a background context that is canceled once the
+// timeout elapses, or the returned cancel func is called.
+func timeoutContext(timeout time.Duration) (Context, func()) {
+	ctx := &timeoutCtx{
+		deadline: time.Now().Add(timeout),
+		done:     make(chan struct{}),
+	}
+	ctx.timer = time.AfterFunc(timeout, func() {
+		ctx.cancel(errContextDeadlineExceeded)
+	})
+
+	return ctx, func() {
+		ctx.timer.Stop()
+		ctx.cancel(errContextCanceled)
+	}
+}
+
+func (ctx *timeoutCtx) cancel(err error) {
+	ctx.m.Lock()
+	defer ctx.m.Unlock()
+
+	if ctx.err == nil {
+		ctx.err = err
+		close(ctx.done)
+	}
+}
+
+func (ctx *timeoutCtx) Deadline() (deadline time.Time, ok bool) {
+	return ctx.deadline, true
+}
+
+func (ctx *timeoutCtx) Done() <-chan struct{} {
+	return ctx.done
+}
+
+func (ctx *timeoutCtx) Err() error {
+	ctx.m.Lock()
+	defer ctx.m.Unlock()
+
+	return ctx.err
+}
+
+func (ctx *timeoutCtx) Value(key interface{}) interface{} {
+	return nil
+}
@@ -31,6 +31,30 @@
 //     credsValue, err := creds.Get()
 //     // New credentials will be retrieved instead of from cache.
 //
+// Example of refreshing credentials in the background before they expire.
+// Get() will continue to return the cached credentials while they are
+// refreshed, instead of blocking until the refresh completes.
+//
+//     creds := credentials.NewCredentials(&ec2rolecreds.EC2RoleProvider{},
+//         func(c *credentials.Credentials) {
+//             c.RefreshAhead = 5 * time.Minute
+//         })
+//
+// Example of observing the refreshes of the credentials, such as to export
+// metrics, or alert when the credentials are close to expiring without a
+// successful refresh.
+//
+//     creds := credentials.NewCredentials(&ec2rolecreds.EC2RoleProvider{},
+//         func(c *credentials.Credentials) {
+//             c.RefreshAhead = 5 * time.Minute
+//             c.OnRefresh = func(e credentials.RefreshEvent) {
+//                 if e.Type == credentials.RefreshFailed && e.Background {
+//                     log.Printf("refresh failed, credentials expire at %v, %v",
+//                         e.Expiration, e.Err)
+//                 }
+//             }
+//         })
+//
 //
 // Custom Provider
 //
@@ -46,6 +70,11 @@
 //     creds := credentials.NewCredentials(&MyProvider{})
 //     credValue, err := creds.Get()
 //
+// Providers which can retrieve credentials with a Context, such as making an
+// API request, should also implement the ProviderWithContext interface. The
+// Context passed to Credentials.GetWithContext() will be used to retrieve the
+// credentials.
+//
 package credentials
 
 import (
@@ -82,6 +111,10 @@ type Value struct {
 
 	// Provider used to get credentials
 	ProviderName string
+
+	// Source the credentials were retrieved from, set by the SDK's
+	// providers.
+	Source Source
 }
 
 // HasKeys returns if the credentials Value has both AccessKeyID and
@@ -106,6 +139,18 @@ type Provider interface {
 	IsExpired() bool
 }
 
+// A ProviderWithContext is a Provider that can retrieve credentials with a
+// Context. Credentials will call RetrieveWithContext instead of Retrieve for
+// providers that implement this interface, allowing the retrieval to be
+// canceled.
+type ProviderWithContext interface {
+	Provider
+
+	// RetrieveWithContext returns nil if it successfully retrieved the
+	// value. Error is returned if the value were not obtainable, or empty.
+	RetrieveWithContext(Context) (Value, error)
+}
+
 // An Expirer is an interface that Providers can implement to expose the expiration
 // time, if known.  If the Provider cannot accurately provide this info,
 // it should not implement this interface.
@@ -114,6 +159,17 @@ type Expirer interface {
 	ExpiresAt() time.Time
 }
 
+// An ExtendedExpirer is an interface that Providers which continue to return
+// their last retrieved credentials when refreshing them fails, extending
+// their expiration, can implement to report the refresh's failure. The
+// Credentials report these refreshes as RefreshFailed events.
+type ExtendedExpirer interface {
+	// ExtendedErr returns the error refreshing the credentials failed with,
+	// if the last retrieval returned the previous credentials with an
+	// extended expiration. Nil otherwise.
+	ExtendedErr() error
+}
+
 // An ErrorProvider is a stub credentials provider that always returns an error
 // this is used by the SDK when construction a known provider is not possible
 // due to an error.
@@ -196,21 +252,81 @@ func (e *Expiry) ExpiresAt() time.Time {
 // The first Credentials.Get() will always call Provider.Retrieve() to get the
 // first instance of the credentials Value. All calls to Get() after that
 // will return the cached credentials Value until IsExpired() returns true.
+//
+// Concurrent calls to Get() that need the credentials to be retrieved share
+// a single call to the Provider's Retrieve().
 type Credentials struct {
+	// RefreshAhead is the window before the credentials expire in which the
+	// credentials will be refreshed in the background. While the refresh is
+	// in progress Get() continues to return the cached credentials. Requires
+	// the Provider to implement the Expirer interface. Disabled if zero.
+	//
+	// If the background refresh fails the cached credentials continue to be
+	// used, and the refresh will be retried, until they expire.
+	//
+	// Must not be modified after the Credentials are first used.
+	RefreshAhead time.Duration
+
+	// OnRefresh, if set, is called with the events of each refresh of the
+	// credentials, such as to export metrics of the refreshes, or alert when
+	// background refreshes fail as the credentials get close to expiring.
+	//
+	// OnRefresh is called from the goroutine retrieving the credentials, and
+	// callers of Get waiting on the refresh are not returned until it
+	// returns. It must not block, or call Get of the same Credentials.
+	//
+	// Must not be modified after the Credentials are first used.
+	OnRefresh func(RefreshEvent)
+
+	// RetrieveTimeout is the maximum time a Provider implementing
+	// ProviderWithContext is given to retrieve the credentials, before the
+	// context RetrieveWithContext is called with is canceled. Defaults to
+	// DefaultRetrieveTimeout if zero.
+	//
+	// Must not be modified after the Credentials are first used.
+	RetrieveTimeout time.Duration
+
 	creds        Value
 	forceRefresh bool
 
 	m sync.RWMutex
 
 	provider Provider
+
+	// refresh is the in progress retrieval of the credentials, nil if none.
+	refresh *refreshCall
+
+	// nextRefreshAhead is the earliest time a background refresh will be
+	// retried after a failed refresh.
+	nextRefreshAhead time.Time
 }
 
+// DefaultRetrieveTimeout is the default RetrieveTimeout of Credentials.
+const DefaultRetrieveTimeout = time.Minute
+
+// refreshAheadRetryDelay is the time to wait before retrying a failed
+// background refresh.
+var refreshAheadRetryDelay = 5 * time.Second
+
 // NewCredentials returns a pointer to a new Credentials with the provider set.
-func NewCredentials(provider Provider) *Credentials {
-	return &Credentials{
+//
+// Options can be used to configure the Credentials, such as enabling
+// RefreshAhead.
+//
+//     creds := credentials.NewCredentials(provider, func(c *credentials.Credentials) {
+//         c.RefreshAhead = 5 * time.Minute
+//     })
+func NewCredentials(provider Provider, options ...func(*Credentials)) *Credentials {
+	c := &Credentials{
 		provider:     provider,
 		forceRefresh: true,
 	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
 }
 
 // Get returns the credentials value, or error if the credentials Value failed
@@ -223,30 +339,202 @@ func NewCredentials(provider Provider) *Credentials {
 // If Credentials.Expire() was called the credentials Value will be force
 // expired, and the next call to Get() will cause them to be refreshed.
 func (c *Credentials) Get() (Value, error) {
+	return c.GetWithContext(backgroundContext())
+}
+
+// GetWithContext returns the credentials value, or error if the credentials
+// Value failed to be retrieved. Will return early if the passed in context is
+// canceled before the credentials are retrieved.
+//
+// Will return the cached credentials Value if it has not expired. If the
+// credentials Value has expired the Provider's Retrieve() will be called
+// to refresh the credentials. If the Provider implements ProviderWithContext,
+// RetrieveWithContext will be called with a context that is not canceled by
+// the callers waiting on the refresh, but once RetrieveTimeout elapses.
+// Canceling the passed in context only stops the caller waiting on the
+// refresh.
+//
+// If Credentials.Expire() was called the credentials Value will be force
+// expired, and the next call to GetWithContext() will cause them to be
+// refreshed.
+func (c *Credentials) GetWithContext(ctx Context) (Value, error) {
 	// Check the cached credentials first with just the read lock.
 	c.m.RLock()
-	if !c.isExpired() {
+	if c.refresh == nil && !c.isExpired() && !c.inRefreshAheadWindow() {
 		creds := c.creds
 		c.m.RUnlock()
 		return creds, nil
 	}
 	c.m.RUnlock()
 
-	// Credentials are expired need to retrieve the credentials taking the full
-	// lock.
+	// Credentials need to be refreshed, taking the full lock to start, or
+	// join, the retrieval of the credentials.
 	c.m.Lock()
-	defer c.m.Unlock()
+	creds, call := c.cachedOrRefresh()
+	c.m.Unlock()
+
+	if call == nil {
+		return creds, nil
+	}
+
+	select {
+	case <-call.done:
+		return call.creds, call.err
+	case <-ctx.Done():
+		return Value{}, awserr.New("RequestCanceled",
+			"request context canceled", ctx.Err())
+	}
+}
+
+// cachedOrRefresh returns the cached credentials if they can be used,
+// starting a background refresh if they are within the RefreshAhead window.
+// Otherwise returns the retrieval the caller must wait on. Must be called
+// with the write lock held.
+func (c *Credentials) cachedOrRefresh() (Value, *refreshCall) {
+	if call := c.refresh; call != nil {
+		// The provider's state must not be read while it is retrieving the
+		// credentials, rely on the expiration known when the refresh started.
+		if !c.forceRefresh && time.Now().Before(call.validUntil) {
+			return c.creds, nil
+		}
+		return Value{}, call
+	}
 
 	if c.isExpired() {
-		creds, err := c.provider.Retrieve()
-		if err != nil {
-			return Value{}, err
+		return Value{}, c.startRefresh(time.Time{})
+	}
+
+	if c.inRefreshAheadWindow() && !time.Now().Before(c.nextRefreshAhead) {
+		c.startRefresh(c.provider.(Expirer).ExpiresAt())
+	}
+	return c.creds, nil
+}
+
+// startRefresh starts retrieving the credentials from the provider. The
+// cached credentials may be used until validUntil while the refresh is in
+// progress. Must be called with the write lock held.
+func (c *Credentials) startRefresh(validUntil time.Time) *refreshCall {
+	call := &refreshCall{
+		done:       make(chan struct{}),
+		validUntil: validUntil,
+		onRefresh:  c.OnRefresh,
+	}
+	c.refresh = call
+
+	go c.retrieve(call)
+
+	return call
+}
+
+// retrieve retrieves the credentials from the provider, and updates the
+// cached credentials if successful. The credentials are retrieved with a
+// context of their own, as the callers waiting on the refresh may each stop
+// waiting.
+func (c *Credentials) retrieve(call *refreshCall) {
+	background := !call.validUntil.IsZero()
+	call.report(RefreshEvent{
+		Type:       RefreshStarted,
+		Background: background,
+		Expiration: call.validUntil,
+	})
+
+	start := time.Now()
+	var creds Value
+	var err error
+	if p, ok := c.provider.(ProviderWithContext); ok {
+		timeout := c.RetrieveTimeout
+		if timeout == 0 {
+			timeout = DefaultRetrieveTimeout
 		}
+		ctx, cancel := timeoutContext(timeout)
+		creds, err = p.RetrieveWithContext(ctx)
+		cancel()
+	} else {
+		creds, err = c.provider.Retrieve()
+	}
+
+	event := RefreshEvent{
+		Type:         RefreshSucceeded,
+		Background:   background,
+		Duration:     time.Since(start),
+		ProviderName: creds.ProviderName,
+		Source:       creds.Source,
+	}
+
+	c.m.Lock()
+	if err == nil {
 		c.creds = creds
 		c.forceRefresh = false
+		if expirer, ok := c.provider.(Expirer); ok {
+			event.Expiration = expirer.ExpiresAt()
+		}
+		if extender, ok := c.provider.(ExtendedExpirer); ok {
+			if extErr := extender.ExtendedErr(); extErr != nil {
+				event.Type, event.Extended, event.Err = RefreshFailed, true, extErr
+				if background {
+					c.nextRefreshAhead = time.Now().Add(refreshAheadRetryDelay)
+				}
+			}
+		}
+	} else if background {
+		c.nextRefreshAhead = time.Now().Add(refreshAheadRetryDelay)
 	}
+	c.refresh = nil
+	c.m.Unlock()
 
-	return c.creds, nil
+	if err != nil {
+		event.Type, event.Expiration, event.Err = RefreshFailed, call.validUntil, err
+		creds = Value{}
+	}
+	call.report(event)
+
+	call.creds, call.err = creds, err
+	close(call.done)
+}
+
+// inRefreshAheadWindow returns if the cached credentials should be refreshed
+// in the background because they will expire within the RefreshAhead window.
+func (c *Credentials) inRefreshAheadWindow() bool {
+	if c.RefreshAhead <= 0 || c.forceRefresh {
+		return false
+	}
+
+	expirer, ok := c.provider.(Expirer)
+	if !ok {
+		return false
+	}
+
+	expiresAt := expirer.ExpiresAt()
+	if expiresAt.IsZero() {
+		// The provider's credentials do not expire.
+		return false
+	}
+
+	return !time.Now().Before(expiresAt.Add(-c.RefreshAhead))
+}
+
+// A refreshCall is an in progress retrieval of the credentials that callers
+// of Get can wait on.
+type refreshCall struct {
+	// closed once the retrieval completes
+	done chan struct{}
+
+	// the time the cached credentials may be used until while the refresh
+	// is in progress. Zero if the cached credentials cannot be used.
+	validUntil time.Time
+
+	// the Credentials' OnRefresh when the refresh started, if set
+	onRefresh func(RefreshEvent)
+
+	creds Value
+	err   error
+}
+
+// report reports the refresh event to the Credentials' OnRefresh, if set.
+func (call *refreshCall) report(event RefreshEvent) {
+	if call.onRefresh != nil {
+		call.onRefresh(event)
+	}
 }
 
 // Expire expires the credentials and forces them to be retrieved on the
@@ -270,6 +558,9 @@ func (c *Credentials) IsExpired() bool {
 	c.m.RLock()
 	defer c.m.RUnlock()
 
+	if call := c.refresh; call != nil {
+		return c.forceRefresh || !time.Now().Before(call.validUntil)
+	}
 	return c.isExpired()
 }
 
@@ -295,5 +586,32 @@ func (c *Credentials) ExpiresAt() (time.Time, error) {
 		// set expiration time to the distant past
 		return time.Time{}, nil
 	}
+	if call := c.refresh; call != nil {
+		return call.validUntil, nil
+	}
 	return expirer.ExpiresAt(), nil
 }
+
+// ChainAttempts returns the attempts of the providers to retrieve credentials
+// during the most recent retrieval, if the Credentials' provider is a
+// ChainProvider, such as the SDK's default credentials chain. Returns nil
+// otherwise.
+//
+// Example explaining where a session's credentials were retrieved from:
+//
+//    v, err := sess.Config.Credentials.Get()
+//    if err == nil {
+//        fmt.Println("credentials from", v.Source)
+//    }
+//    for _, attempt := range sess.Config.Credentials.ChainAttempts() {
+//        fmt.Println(attempt.ProviderType, attempt.Duration, attempt.Err)
+//    }
+func (c *Credentials) ChainAttempts() []ChainAttempt {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	if chain, ok := c.provider.(*ChainProvider); ok {
+		return chain.Attempts()
+	}
+	return nil
+}
@@ -0,0 +1,314 @@
+// +build go1.7
+
+package credentials
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+type ctxKey struct{}
+
+type blockingProvider struct {
+	Expiry
+
+	calls   int32
+	release chan struct{}
+	values  []Value
+	errs    []error
+	ctxVal  chan interface{}
+}
+
+func (p *blockingProvider) Retrieve() (Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *blockingProvider) RetrieveWithContext(ctx Context) (Value, error) {
+	i := int(atomic.AddInt32(&p.calls, 1)) - 1
+	if p.ctxVal != nil {
+		p.ctxVal <- ctx.Value(ctxKey{})
+	}
+	if p.release != nil {
+		select {
+		case <-p.release:
+		case <-ctx.Done():
+			return Value{}, ctx.Err()
+		}
+	}
+
+	if i < len(p.errs) && p.errs[i] != nil {
+		return Value{}, p.errs[i]
+	}
+	if i >= len(p.values) {
+		i = len(p.values) - 1
+	}
+	p.SetExpiration(time.Now().Add(time.Minute), 0)
+	return p.values[i], nil
+}
+
+func TestCredentialsGetWithContext_Canceled(t *testing.T) {
+	p := &blockingProvider{
+		release: make(chan struct{}),
+		values:  []Value{{AccessKeyID: "AKID"}},
+	}
+	defer close(p.release)
+	c := NewCredentials(p)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := c.GetWithContext(ctx)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		t.Fatalf("expect awserr.Error, got %T", err)
+	}
+	if e, a := "RequestCanceled", aerr.Code(); e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+}
+
+func TestCredentialsGetWithContext_RefreshNotCanceledByCaller(t *testing.T) {
+	p := &blockingProvider{
+		release: make(chan struct{}),
+		ctxVal:  make(chan interface{}, 1),
+		values:  []Value{{AccessKeyID: "AKID"}},
+	}
+	c := NewCredentials(p)
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := c.GetWithContext(ctx)
+		firstErr <- err
+	}()
+	if v := <-p.ctxVal; v != nil {
+		t.Errorf("expect no caller context value, got %v", v)
+	}
+
+	second := make(chan Value, 1)
+	go func() {
+		creds, err := c.Get()
+		if err != nil {
+			t.Errorf("expect no error, got %v", err)
+		}
+		second <- creds
+	}()
+
+	cancel()
+	if err := <-firstErr; err == nil {
+		t.Errorf("expect first caller error, got none")
+	}
+
+	close(p.release)
+	if e, a := "AKID", (<-second).AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := int32(1), atomic.LoadInt32(&p.calls); e != a {
+		t.Errorf("expect %v retrieve calls, got %v", e, a)
+	}
+}
+
+func TestCredentialsGetWithContext_RetrieveTimeout(t *testing.T) {
+	p := &blockingProvider{
+		release: make(chan struct{}),
+		values:  []Value{{AccessKeyID: "AKID"}},
+	}
+	defer close(p.release)
+	c := NewCredentials(p, func(c *Credentials) {
+		c.RetrieveTimeout = 10 * time.Millisecond
+	})
+
+	_, err := c.Get()
+	if e, a := context.DeadlineExceeded, err; e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_ConcurrentRefresh(t *testing.T) {
+	p := &blockingProvider{
+		release: make(chan struct{}),
+		values:  []Value{{AccessKeyID: "AKID"}},
+	}
+	c := NewCredentials(p)
+
+	const n = 10
+	var wg sync.WaitGroup
+	wg.Add(n)
+	results := make(chan Value, n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			creds, err := c.Get()
+			if err != nil {
+				t.Errorf("expect no error, got %v", err)
+			}
+			results <- creds
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(p.release)
+	wg.Wait()
+	close(results)
+
+	for creds := range results {
+		if e, a := "AKID", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v access key ID, got %v", e, a)
+		}
+	}
+	if e, a := int32(1), atomic.LoadInt32(&p.calls); e != a {
+		t.Errorf("expect %v retrieve calls, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAhead(t *testing.T) {
+	p := &blockingProvider{
+		values: []Value{{AccessKeyID: "AKID1"}, {AccessKeyID: "AKID2"}},
+	}
+	c := NewCredentials(p, func(c *Credentials) {
+		c.RefreshAhead = 5 * time.Minute
+	})
+
+	creds, err := c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID1", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+
+	// Credentials expire within the refresh ahead window, the cached value is
+	// returned while the credentials are refreshed in the background.
+	p.release = make(chan struct{})
+	creds, err = c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID1", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if c.IsExpired() {
+		t.Errorf("expect credentials not to be expired during refresh")
+	}
+
+	c.m.RLock()
+	call := c.refresh
+	c.m.RUnlock()
+	if call == nil {
+		t.Fatalf("expect background refresh to be started")
+	}
+	close(p.release)
+	<-call.done
+
+	creds, err = c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID2", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAheadError(t *testing.T) {
+	p := &blockingProvider{
+		values: []Value{{AccessKeyID: "AKID1"}, {}},
+		errs:   []error{nil, awserr.New("ProviderError", "failed", nil)},
+	}
+	c := NewCredentials(p, func(c *Credentials) {
+		c.RefreshAhead = 5 * time.Minute
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	p.release = make(chan struct{})
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	c.m.RLock()
+	call := c.refresh
+	c.m.RUnlock()
+	close(p.release)
+	<-call.done
+
+	// The failed refresh keeps the cached credentials, and is not retried
+	// immediately.
+	for i := 0; i < 2; i++ {
+		creds, err := c.Get()
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "AKID1", creds.AccessKeyID; e != a {
+			t.Errorf("expect %v access key ID, got %v", e, a)
+		}
+	}
+	if e, a := int32(2), atomic.LoadInt32(&p.calls); e != a {
+		t.Errorf("expect %v retrieve calls, got %v", e, a)
+	}
+}
+
+func TestCredentialsGet_RefreshAheadOnRefresh(t *testing.T) {
+	p := &blockingProvider{
+		values: []Value{{AccessKeyID: "AKID1"}, {}},
+		errs:   []error{nil, awserr.New("ProviderError", "failed", nil)},
+	}
+
+	var mu sync.Mutex
+	var events []RefreshEvent
+	c := NewCredentials(p, func(c *Credentials) {
+		c.RefreshAhead = 5 * time.Minute
+		c.OnRefresh = func(e RefreshEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, e)
+		}
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expiresAt := p.ExpiresAt()
+
+	p.release = make(chan struct{})
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	c.m.RLock()
+	call := c.refresh
+	c.m.RUnlock()
+	close(p.release)
+	<-call.done
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if e, a := 4, len(events); e != a {
+		t.Fatalf("expect %v events, got %v", e, a)
+	}
+
+	// The failed background refresh reports the expiration of the cached
+	// credentials that are still in use.
+	for i, e := range events[2:] {
+		if !e.Background {
+			t.Errorf("%d, expect background refresh", i)
+		}
+		if !expiresAt.Equal(e.Expiration) {
+			t.Errorf("%d, expect %v expiration, got %v", i, expiresAt, e.Expiration)
+		}
+	}
+	if e, a := RefreshFailed, events[3].Type; e != a {
+		t.Errorf("expect %v event, got %v", e, a)
+	}
+	if events[3].Err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
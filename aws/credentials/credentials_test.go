@@ -171,3 +171,141 @@ func TestCredentialsExpiresAt_HasExpirer(t *testing.T) {
 		t.Errorf("Expected distant past expiration, got %v", expiration)
 	}
 }
+
+func TestCredentialsGet_OnRefresh(t *testing.T) {
+	stub := &stubProviderExpirer{
+		stubProvider: stubProvider{
+			creds: Value{
+				AccessKeyID:     "AKID",
+				SecretAccessKey: "SECRET",
+				Source:          Source{Kind: SourceEnvironment},
+			},
+			expired: true,
+		},
+		expiration: time.Now().Add(time.Hour),
+	}
+
+	var events []RefreshEvent
+	c := NewCredentials(stub, func(c *Credentials) {
+		c.OnRefresh = func(e RefreshEvent) {
+			events = append(events, e)
+		}
+	})
+
+	if _, err := c.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	stub.expired = true
+	stub.err = awserr.New("ProviderError", "failed", nil)
+	if _, err := c.Get(); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	expect := []RefreshEventType{
+		RefreshStarted, RefreshSucceeded,
+		RefreshStarted, RefreshFailed,
+	}
+	if e, a := len(expect), len(events); e != a {
+		t.Fatalf("expect %v events, got %v", e, a)
+	}
+	for i, e := range events {
+		if e.Type != expect[i] {
+			t.Errorf("%d, expect %v event, got %v", i, expect[i], e.Type)
+		}
+		if e.Background {
+			t.Errorf("%d, expect not background refresh", i)
+		}
+	}
+
+	succeeded := events[1]
+	if e, a := stub.expiration, succeeded.Expiration; !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+	if e, a := "stubProvider", succeeded.ProviderName; e != a {
+		t.Errorf("expect %v provider, got %v", e, a)
+	}
+	if e, a := SourceEnvironment, succeeded.Source.Kind; e != a {
+		t.Errorf("expect %v source, got %v", e, a)
+	}
+	if succeeded.Err != nil {
+		t.Errorf("expect no error, got %v", succeeded.Err)
+	}
+
+	failed := events[3]
+	if e, a := "ProviderError", failed.Err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if !failed.Expiration.IsZero() {
+		t.Errorf("expect no expiration, got %v", failed.Expiration)
+	}
+}
+
+type stubProviderExtender struct {
+	stubProviderExpirer
+	extendedErr error
+}
+
+func (s *stubProviderExtender) ExtendedErr() error {
+	return s.extendedErr
+}
+
+func TestCredentialsGet_OnRefreshExtended(t *testing.T) {
+	stub := &stubProviderExtender{
+		stubProviderExpirer: stubProviderExpirer{
+			stubProvider: stubProvider{
+				creds:   Value{AccessKeyID: "AKID", SecretAccessKey: "SECRET"},
+				expired: true,
+			},
+			expiration: time.Now().Add(5 * time.Minute),
+		},
+		extendedErr: awserr.New("ProviderError", "failed", nil),
+	}
+
+	var events []RefreshEvent
+	c := NewCredentials(stub, func(c *Credentials) {
+		c.OnRefresh = func(e RefreshEvent) {
+			events = append(events, e)
+		}
+	})
+
+	v, err := c.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+
+	if e, a := 2, len(events); e != a {
+		t.Fatalf("expect %v events, got %v", e, a)
+	}
+	extended := events[1]
+	if e, a := RefreshFailed, extended.Type; e != a {
+		t.Errorf("expect %v event, got %v", e, a)
+	}
+	if !extended.Extended {
+		t.Errorf("expect extended refresh")
+	}
+	if e, a := "ProviderError", extended.Err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+	if e, a := stub.expiration, extended.Expiration; !e.Equal(a) {
+		t.Errorf("expect %v expiration, got %v", e, a)
+	}
+}
+
+func TestRefreshEventTypeString(t *testing.T) {
+	cases := map[RefreshEventType]string{
+		RefreshStarted:       "RefreshStarted",
+		RefreshSucceeded:     "RefreshSucceeded",
+		RefreshFailed:        "RefreshFailed",
+		RefreshEventType(-1): "Unknown",
+	}
+
+	for typ, expect := range cases {
+		if e, a := expect, typ.String(); e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+}
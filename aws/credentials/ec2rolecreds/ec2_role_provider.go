@@ -7,12 +7,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/sdkuri"
+	"github.com/aws/aws-sdk-go/internal/staticstability"
 )
 
 // ProviderName provides a name of EC2Role provider
@@ -51,6 +53,25 @@ type EC2RoleProvider struct {
 	//
 	// If ExpiryWindow is 0 or less it will be ignored.
 	ExpiryWindow time.Duration
+
+	// StaticStability enables the provider to continue returning the last
+	// retrieved credentials when refreshing them fails, such as during an
+	// outage or throttling of the EC2 instance metadata service. The
+	// expiration of the credentials is extended by a randomized interval,
+	// after which the refresh will be retried. A warning is logged to the
+	// Client's Logger each time the credentials are extended, and the
+	// Credentials' OnRefresh is reported a RefreshFailed event with the error.
+	//
+	// An error is still returned if credentials were never retrieved, or the
+	// refresh was canceled.
+	StaticStability bool
+
+	// the last retrieved credentials, used if StaticStability is enabled.
+	creds credentials.Value
+
+	// the error the last refresh failed with, if the previous credentials
+	// were returned with an extended expiration.
+	extendedErr error
 }
 
 // NewCredentials returns a pointer to a new Credentials object wrapping
@@ -87,7 +108,43 @@ func NewCredentialsWithClient(client *ec2metadata.EC2Metadata, options ...func(*
 // Error will be returned if the request fails, or unable to extract
 // the desired credentials.
 func (m *EC2RoleProvider) Retrieve() (credentials.Value, error) {
-	credsList, err := requestCredList(m.Client)
+	return m.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext retrieves credentials from the EC2 service.
+// Error will be returned if the request fails, or unable to extract
+// the desired credentials.
+func (m *EC2RoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	m.extendedErr = nil
+	creds, err := m.retrieve(ctx)
+	if err != nil {
+		// Canceled retrievals are not a failure of the metadata service, and
+		// do not extend the credentials.
+		if m.StaticStability && m.creds.HasKeys() && ctx.Err() == nil {
+			staticstability.ExtendExpiration(&m.Expiry, m.Client.Config.Logger,
+				"Failed to refresh EC2 instance role credentials, using the previous credentials", err)
+			m.extendedErr = err
+			return m.creds, nil
+		}
+		return creds, err
+	}
+
+	if m.StaticStability {
+		m.creds = creds
+	}
+	return creds, nil
+}
+
+// ExtendedErr returns the error refreshing the credentials from the EC2
+// instance metadata service failed with, if the last retrieval returned the
+// previous credentials with an extended expiration because StaticStability is
+// enabled. Nil otherwise.
+func (m *EC2RoleProvider) ExtendedErr() error {
+	return m.extendedErr
+}
+
+func (m *EC2RoleProvider) retrieve(ctx aws.Context) (credentials.Value, error) {
+	credsList, err := requestCredList(ctx, m.Client)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
@@ -97,7 +154,7 @@ func (m *EC2RoleProvider) Retrieve() (credentials.Value, error) {
 	}
 	credsName := credsList[0]
 
-	roleCreds, err := requestCred(m.Client, credsName)
+	roleCreds, err := requestCred(ctx, m.Client, credsName)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
@@ -109,6 +166,11 @@ func (m *EC2RoleProvider) Retrieve() (credentials.Value, error) {
 		SecretAccessKey: roleCreds.SecretAccessKey,
 		SessionToken:    roleCreds.Token,
 		ProviderName:    ProviderName,
+		Source: credentials.Source{
+			Kind:     credentials.SourceEC2InstanceMetadata,
+			Endpoint: m.Client.Endpoint,
+			RoleName: credsName,
+		},
 	}, nil
 }
 
@@ -130,8 +192,8 @@ const iamSecurityCredsPath = "iam/security-credentials/"
 
 // requestCredList requests a list of credentials from the EC2 service.
 // If there are no credentials, or there is an error making or receiving the request
-func requestCredList(client *ec2metadata.EC2Metadata) ([]string, error) {
-	resp, err := client.GetMetadata(iamSecurityCredsPath)
+func requestCredList(ctx aws.Context, client *ec2metadata.EC2Metadata) ([]string, error) {
+	resp, err := client.GetMetadataWithContext(ctx, iamSecurityCredsPath)
 	if err != nil {
 		return nil, awserr.New("EC2RoleRequestError", "no EC2 instance role found", err)
 	}
@@ -154,8 +216,8 @@ func requestCredList(client *ec2metadata.EC2Metadata) ([]string, error) {
 //
 // If the credentials cannot be found, or there is an error reading the response
 // and error will be returned.
-func requestCred(client *ec2metadata.EC2Metadata, credsName string) (ec2RoleCredRespBody, error) {
-	resp, err := client.GetMetadata(sdkuri.PathJoin(iamSecurityCredsPath, credsName))
+func requestCred(ctx aws.Context, client *ec2metadata.EC2Metadata, credsName string) (ec2RoleCredRespBody, error) {
+	resp, err := client.GetMetadataWithContext(ctx, sdkuri.PathJoin(iamSecurityCredsPath, credsName))
 	if err != nil {
 		return ec2RoleCredRespBody{},
 			awserr.New("EC2RoleRequestError",
@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
 )
 
@@ -193,3 +197,207 @@ func BenchmarkEC3RoleProvider(b *testing.B) {
 		}
 	}
 }
+
+func TestEC2RoleProviderStaticStability(t *testing.T) {
+	expireOn := time.Now().Add(-time.Minute)
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			http.Error(w, "throttled", http.StatusTooManyRequests)
+			return
+		}
+		if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "RoleName")
+		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/RoleName" {
+			fmt.Fprintf(w, credsRespTmpl, expireOn.UTC().Format(time.RFC3339))
+		} else {
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var logs []string
+	p := &ec2rolecreds.EC2RoleProvider{
+		Client: ec2metadata.New(unit.Session, &aws.Config{
+			Endpoint:   aws.String(server.URL + "/latest"),
+			MaxRetries: aws.Int(0),
+			Logger: aws.LoggerFunc(func(args ...interface{}) {
+				logs = append(logs, fmt.Sprint(args...))
+			}),
+		}),
+		StaticStability: true,
+	}
+
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !p.IsExpired() {
+		t.Fatalf("expect credentials to be expired")
+	}
+
+	fail = true
+	creds, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "accessKey", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if p.IsExpired() {
+		t.Errorf("expect extended credentials not to be expired")
+	}
+	if a := p.ExpiresAt(); a.Before(time.Now().Add(5*time.Minute-time.Second)) || a.After(time.Now().Add(10*time.Minute)) {
+		t.Errorf("expect expiration extended by 5 to 10 minutes, got %v", a)
+	}
+	if e, a := 1, len(logs); e != a {
+		t.Fatalf("expect %v log entries, got %v, %v", e, a, logs)
+	}
+	if e, a := "WARNING: Failed to refresh EC2 instance role credentials", logs[0]; !strings.HasPrefix(a, e) {
+		t.Errorf("expect %q log prefix, got %q", e, a)
+	}
+}
+
+func TestEC2RoleProviderStaticStability_OnRefresh(t *testing.T) {
+	expireOn := time.Now().Add(-time.Minute)
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			http.Error(w, "throttled", http.StatusTooManyRequests)
+			return
+		}
+		if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "RoleName")
+		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/RoleName" {
+			fmt.Fprintf(w, credsRespTmpl, expireOn.UTC().Format(time.RFC3339))
+		} else {
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var events []credentials.RefreshEvent
+	p := &ec2rolecreds.EC2RoleProvider{
+		Client: ec2metadata.New(unit.Session, &aws.Config{
+			Endpoint:   aws.String(server.URL + "/latest"),
+			MaxRetries: aws.Int(0),
+		}),
+		StaticStability: true,
+	}
+	creds := credentials.NewCredentials(p, func(c *credentials.Credentials) {
+		c.OnRefresh = func(e credentials.RefreshEvent) {
+			if e.Type != credentials.RefreshStarted {
+				events = append(events, e)
+			}
+		}
+	})
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	fail = true
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "accessKey", v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+
+	if e, a := 2, len(events); e != a {
+		t.Fatalf("expect %v refresh events, got %v, %v", e, a, events)
+	}
+	if e, a := credentials.RefreshSucceeded, events[0].Type; e != a {
+		t.Errorf("expect %v first event, got %v", e, a)
+	}
+	if events[0].Extended {
+		t.Errorf("expect first refresh not to be extended")
+	}
+
+	extended := events[1]
+	if e, a := credentials.RefreshFailed, extended.Type; e != a {
+		t.Errorf("expect %v event for the extended credentials, got %v", e, a)
+	}
+	if !extended.Extended {
+		t.Errorf("expect refresh to be extended")
+	}
+	if extended.Err == nil {
+		t.Errorf("expect the refresh error, got none")
+	}
+	if e, a := p.ExpiresAt(), extended.Expiration; !e.Equal(a) {
+		t.Errorf("expect %v extended expiration, got %v", e, a)
+	}
+
+	// The extension is only reported by the refresh that failed.
+	fail = false
+	creds.Expire()
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := credentials.RefreshSucceeded, events[2].Type; e != a {
+		t.Errorf("expect %v event, got %v", e, a)
+	}
+	if p.ExtendedErr() != nil {
+		t.Errorf("expect no extended error, got %v", p.ExtendedErr())
+	}
+}
+
+func TestEC2RoleProviderStaticStability_NoPreviousCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "throttled", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	p := &ec2rolecreds.EC2RoleProvider{
+		Client: ec2metadata.New(unit.Session, &aws.Config{
+			Endpoint:   aws.String(server.URL + "/latest"),
+			MaxRetries: aws.Int(0),
+		}),
+		StaticStability: true,
+	}
+
+	if _, err := p.Retrieve(); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
+
+func TestEC2RoleProviderRetrieveWithContext_Canceled(t *testing.T) {
+	expireOn := time.Now().Add(-time.Minute)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/latest/meta-data/iam/security-credentials/" {
+			fmt.Fprintln(w, "RoleName")
+		} else if r.URL.Path == "/latest/meta-data/iam/security-credentials/RoleName" {
+			fmt.Fprintf(w, credsRespTmpl, expireOn.UTC().Format(time.RFC3339))
+		} else {
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	p := &ec2rolecreds.EC2RoleProvider{
+		Client: ec2metadata.New(unit.Session, &aws.Config{
+			Endpoint:   aws.String(server.URL + "/latest"),
+			MaxRetries: aws.Int(0),
+		}),
+		StaticStability: true,
+	}
+
+	if _, err := p.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ctx := &awstesting.FakeContext{DoneCh: make(chan struct{})}
+	ctx.Error = fmt.Errorf("context canceled")
+	close(ctx.DoneCh)
+
+	_, err := p.RetrieveWithContext(ctx)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.CanceledErrorCode, err.(awserr.Error).OrigErr().(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if !p.IsExpired() {
+		t.Errorf("expect canceled refresh not to extend the credentials")
+	}
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/client/metadata"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/internal/staticstability"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 )
 
@@ -70,6 +71,25 @@ type Provider struct {
 	// Optional authorization token value if set will be used as the value of
 	// the Authorization header of the endpoint credential request.
 	AuthorizationToken string
+
+	// StaticStability enables the provider to continue returning the last
+	// retrieved credentials when refreshing them fails, such as during an
+	// outage of the container credentials endpoint. The expiration of the
+	// credentials is extended by a randomized interval, after which the
+	// refresh will be retried. A warning is logged to the Client's Logger
+	// each time the credentials are extended, and the Credentials' OnRefresh
+	// is reported a RefreshFailed event with the error.
+	//
+	// An error is still returned if credentials were never retrieved, or the
+	// refresh was canceled.
+	StaticStability bool
+
+	// the last retrieved credentials, used if StaticStability is enabled.
+	creds credentials.Value
+
+	// the error the last refresh failed with, if the previous credentials
+	// were returned with an extended expiration.
+	extendedErr error
 }
 
 // NewProviderClient returns a credentials Provider for retrieving AWS credentials
@@ -116,7 +136,42 @@ func (p *Provider) IsExpired() bool {
 // Retrieve will attempt to request the credentials from the endpoint the Provider
 // was configured for. And error will be returned if the retrieval fails.
 func (p *Provider) Retrieve() (credentials.Value, error) {
-	resp, err := p.getCredentials()
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext will attempt to request the credentials from the endpoint
+// the Provider was configured for. And error will be returned if the retrieval
+// fails.
+func (p *Provider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
+	p.extendedErr = nil
+	creds, err := p.retrieve(ctx)
+	if err != nil {
+		// Canceled retrievals are not a failure of the endpoint, and do not
+		// extend the credentials.
+		if p.StaticStability && p.creds.HasKeys() && ctx.Err() == nil {
+			staticstability.ExtendExpiration(&p.Expiry, p.Client.Config.Logger,
+				"Failed to refresh endpoint credentials, using the previous credentials", err)
+			p.extendedErr = err
+			return p.creds, nil
+		}
+		return creds, err
+	}
+
+	if p.StaticStability {
+		p.creds = creds
+	}
+	return creds, nil
+}
+
+// ExtendedErr returns the error refreshing the credentials from the endpoint
+// failed with, if the last retrieval returned the previous credentials with
+// an extended expiration because StaticStability is enabled. Nil otherwise.
+func (p *Provider) ExtendedErr() error {
+	return p.extendedErr
+}
+
+func (p *Provider) retrieve(ctx aws.Context) (credentials.Value, error) {
+	resp, err := p.getCredentials(ctx)
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName},
 			awserr.New("CredentialsEndpointError", "failed to load credentials", err)
@@ -133,6 +188,10 @@ func (p *Provider) Retrieve() (credentials.Value, error) {
 		SecretAccessKey: resp.SecretAccessKey,
 		SessionToken:    resp.Token,
 		ProviderName:    ProviderName,
+		Source: credentials.Source{
+			Kind:     credentials.SourceContainerEndpoint,
+			Endpoint: p.Client.Endpoint,
+		},
 	}, nil
 }
 
@@ -148,7 +207,7 @@ type errorOutput struct {
 	Message string `json:"message"`
 }
 
-func (p *Provider) getCredentials() (*getCredentialsOutput, error) {
+func (p *Provider) getCredentials(ctx aws.Context) (*getCredentialsOutput, error) {
 	op := &request.Operation{
 		Name:       "GetCredentials",
 		HTTPMethod: "GET",
@@ -156,6 +215,7 @@ func (p *Provider) getCredentials() (*getCredentialsOutput, error) {
 
 	out := &getCredentialsOutput{}
 	req := p.Client.NewRequest(op, nil, out)
+	req.SetContext(ctx)
 	req.HTTPRequest.Header.Set("Accept", "application/json")
 	if authToken := p.AuthorizationToken; len(authToken) != 0 {
 		req.HTTPRequest.Header.Set("Authorization", authToken)
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
@@ -220,3 +222,61 @@ func TestAuthorizationToken(t *testing.T) {
 		t.Errorf("expect expired, wasn't")
 	}
 }
+
+func TestStaticStability(t *testing.T) {
+	fail := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail {
+			w.WriteHeader(500)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"Code":    "Error",
+				"Message": "Message",
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"AccessKeyID":     "AKID",
+			"SecretAccessKey": "SECRET",
+			"Token":           "TOKEN",
+			"Expiration":      time.Now().Add(-1 * time.Minute),
+		})
+	}))
+	defer server.Close()
+
+	var logs []string
+	cfg := unit.Session.Config.Copy(&aws.Config{
+		MaxRetries: aws.Int(0),
+		Logger: aws.LoggerFunc(func(args ...interface{}) {
+			logs = append(logs, fmt.Sprint(args...))
+		}),
+	})
+	client := endpointcreds.NewProviderClient(*cfg, unit.Session.Handlers, server.URL,
+		func(p *endpointcreds.Provider) {
+			p.StaticStability = true
+		})
+
+	if _, err := client.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !client.IsExpired() {
+		t.Fatalf("expect expired, wasn't")
+	}
+
+	fail = true
+	creds, err := client.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "AKID", creds.AccessKeyID; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if client.IsExpired() {
+		t.Errorf("expect not expired, was")
+	}
+	if e, a := 1, len(logs); e != a {
+		t.Fatalf("expect %v log entries, got %v, %v", e, a, logs)
+	}
+	if e, a := "WARNING: Failed to refresh endpoint credentials", logs[0]; !strings.HasPrefix(a, e) {
+		t.Errorf("expect %q log prefix, got %q", e, a)
+	}
+}
@@ -65,6 +65,7 @@ func (e *EnvProvider) Retrieve() (Value, error) {
 		SecretAccessKey: secret,
 		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
 		ProviderName:    EnvProviderName,
+		Source:          Source{Kind: SourceEnvironment},
 	}, nil
 }
 
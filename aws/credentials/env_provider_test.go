@@ -29,6 +29,9 @@ func TestEnvProviderRetrieve(t *testing.T) {
 	if e, a := "token", creds.SessionToken; e != a {
 		t.Errorf("expect %v, got %v", e, a)
 	}
+	if e, a := (Source{Kind: SourceEnvironment}), creds.Source; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestEnvProviderIsExpired(t *testing.T) {
@@ -0,0 +1,71 @@
+package processcreds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// cacheFilename returns the name of the file the credentials of the command
+// are cached in. The environment variables set for, and passed through to,
+// the command are part of the name, as the credentials the command returns
+// may depend on them.
+func cacheFilename(dir, command string, env, envPassthrough []string) string {
+	h := sha1.New()
+	h.Write([]byte(command))
+	for _, kv := range env {
+		h.Write([]byte("\x00env\x00" + kv))
+	}
+	for _, name := range envPassthrough {
+		h.Write([]byte("\x00passthrough\x00" + name))
+	}
+	return filepath.Join(dir, hex.EncodeToString(h.Sum(nil))+".json")
+}
+
+// readCache reads the cached credentials from the file. The file has the
+// same format as the output of the process.
+func readCache(filename string) (*credentialProcessResponse, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCredentialProcessResponse(b)
+}
+
+// writeCache writes the credentials to the file. The file is written to a
+// temporary file, created readable only by the user, which then replaces the
+// file so that concurrent readers never read a partially written file.
+func writeCache(filename string, resp *credentialProcessResponse) error {
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filename)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(dir, filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(b)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	if err := os.Rename(f.Name(), filename); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return nil
+}
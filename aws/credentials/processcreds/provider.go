@@ -62,6 +62,40 @@ buffer size of 2k.
             opt.MaxBufSize = 2048
         })
 
+Credentials returned without an Expiration never expire, unless the
+provider's SessionLifetime is set. In session mode the credentials expire the
+SessionLifetime after they were retrieved, and the process is executed again.
+
+    creds := processcreds.NewCredentials(
+        "/path/to/command",
+        func(opt *processcreds.ProcessProvider) {
+            opt.SessionLifetime = time.Duration(30) * time.Minute
+        })
+
+Processes which prompt for MFA tokens, or are otherwise slow to execute, can
+be executed less often by caching their credentials in a directory. The
+credentials are cached in a file keyed by the command, and are used by any
+ProcessProvider with the same command until they expire. Credentials which do
+not expire are not cached.
+
+    creds := processcreds.NewCredentials(
+        "/path/to/command",
+        func(opt *processcreds.ProcessProvider) {
+            opt.CacheDir = filepath.Join(os.Getenv("HOME"), ".aws", "processcreds", "cache")
+        })
+
+The process inherits the environment variables of the SDK's process. Set
+EnvPassthrough to restrict the variables inherited, and Env to set additional
+variables. The output of the process on stderr is displayed on the SDK's
+stderr, and included in the error returned if the process fails.
+
+    creds := processcreds.NewCredentials(
+        "/path/to/command",
+        func(opt *processcreds.ProcessProvider) {
+            opt.EnvPassthrough = []string{"PATH", "HOME"}
+            opt.Env = []string{"HELPER_PROFILE=dev"}
+        })
+
 You can also use your own `exec.Cmd`:
 
 	// Create an exec.Cmd
@@ -134,6 +168,9 @@ const (
 	// errMsgProcessProviderEmptyCmd command must not be empty
 	errMsgProcessProviderEmptyCmd = "command must not be empty"
 
+	// errMsgProcessProviderSplitCmd command cannot be split into arguments
+	errMsgProcessProviderSplitCmd = "command cannot be split into arguments"
+
 	// errMsgProcessProviderPipe failed to initialize pipe
 	errMsgProcessProviderPipe = "failed to initialize pipe"
 
@@ -180,6 +217,33 @@ type ProcessProvider struct {
 
 	// Timeout limits the time a process can run.
 	Timeout time.Duration
+
+	// SessionLifetime is how long credentials returned by the process
+	// without an Expiration are valid for, from when they were retrieved. If
+	// zero, the default, such credentials never expire.
+	SessionLifetime time.Duration
+
+	// CacheDir is the directory the credentials returned by the process are
+	// cached in, keyed by the command, Env, and EnvPassthrough. Cached
+	// credentials are used until they expire, instead of executing the
+	// process. Credentials which do not expire are not cached. Caching is
+	// disabled if empty.
+	CacheDir string
+
+	// Stderr is where the output of the process on stderr is written to, in
+	// addition to being included in the error returned if the process
+	// fails. Defaults to os.Stderr, so that prompts such as for MFA tokens
+	// are displayed.
+	Stderr io.Writer
+
+	// Env is additional environment variables, in the form "key=value", set
+	// for the process. Overrides the inherited variables of the same name.
+	Env []string
+
+	// EnvPassthrough restricts the environment variables the process
+	// inherits from the SDK's process to the variables named. If empty, the
+	// process inherits all of the variables.
+	EnvPassthrough []string
 }
 
 // NewCredentials returns a pointer to a new Credentials object wrapping the
@@ -235,42 +299,85 @@ type credentialProcessResponse struct {
 }
 
 // Retrieve executes the 'credential_process' and returns the credentials.
+// If the provider's CacheDir is set, unexpired cached credentials are
+// returned instead.
 func (p *ProcessProvider) Retrieve() (credentials.Value, error) {
+	if err := p.prepareCommand(); err != nil {
+		return credentials.Value{ProviderName: ProviderName}, err
+	}
+
+	var cacheFile string
+	if len(p.CacheDir) != 0 {
+		cacheFile = cacheFilename(p.CacheDir, p.commandString(), p.Env, p.EnvPassthrough)
+		if resp, err := readCache(cacheFile); err == nil && p.isCacheValid(resp) {
+			v := p.credentialsValue(resp)
+			v.Source.Filename = cacheFile
+			return v, nil
+		}
+	}
+
 	out, err := p.executeCredentialProcess()
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
 
-	// Serialize and validate response
+	resp, err := parseCredentialProcessResponse(out)
+	if err != nil {
+		return credentials.Value{ProviderName: ProviderName}, err
+	}
+
+	if resp.Expiration == nil && p.SessionLifetime > 0 {
+		expiration := p.now().Add(p.SessionLifetime)
+		resp.Expiration = &expiration
+	}
+
+	if len(cacheFile) != 0 && resp.Expiration != nil {
+		// Failing to cache the credentials does not fail their retrieval,
+		// the process will be executed again the next time instead.
+		writeCache(cacheFile, resp)
+	}
+
+	return p.credentialsValue(resp), nil
+}
+
+// parseCredentialProcessResponse parses and validates the output of the
+// process.
+func parseCredentialProcessResponse(out []byte) (*credentialProcessResponse, error) {
 	resp := &credentialProcessResponse{}
-	if err = json.Unmarshal(out, resp); err != nil {
-		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+	if err := json.Unmarshal(out, resp); err != nil {
+		return nil, awserr.New(
 			ErrCodeProcessProviderParse,
 			fmt.Sprintf("%s: %s", errMsgProcessProviderParse, string(out)),
 			err)
 	}
 
 	if resp.Version != 1 {
-		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+		return nil, awserr.New(
 			ErrCodeProcessProviderVersion,
 			errMsgProcessProviderVersion,
 			nil)
 	}
 
 	if len(resp.AccessKeyID) == 0 {
-		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+		return nil, awserr.New(
 			ErrCodeProcessProviderRequired,
 			errMsgProcessProviderMissKey,
 			nil)
 	}
 
 	if len(resp.SecretAccessKey) == 0 {
-		return credentials.Value{ProviderName: ProviderName}, awserr.New(
+		return nil, awserr.New(
 			ErrCodeProcessProviderRequired,
 			errMsgProcessProviderMissSecret,
 			nil)
 	}
 
+	return resp, nil
+}
+
+// credentialsValue returns the credentials of the response, and sets the
+// provider's expiration.
+func (p *ProcessProvider) credentialsValue(resp *credentialProcessResponse) credentials.Value {
 	// Handle expiration
 	p.staticCreds = resp.Expiration == nil
 	if resp.Expiration != nil {
@@ -282,7 +389,37 @@ func (p *ProcessProvider) Retrieve() (credentials.Value, error) {
 		AccessKeyID:     resp.AccessKeyID,
 		SecretAccessKey: resp.SecretAccessKey,
 		SessionToken:    resp.SessionToken,
-	}, nil
+		Source: credentials.Source{
+			Kind:    credentials.SourceProcess,
+			Command: p.commandString(),
+		},
+	}
+}
+
+// isCacheValid returns if the cached credentials are not expired, taking the
+// provider's ExpiryWindow into account.
+func (p *ProcessProvider) isCacheValid(resp *credentialProcessResponse) bool {
+	if resp.Expiration == nil {
+		return false
+	}
+
+	expiration := *resp.Expiration
+	if p.ExpiryWindow > 0 {
+		expiration = expiration.Add(-p.ExpiryWindow)
+	}
+	return p.now().Before(expiration)
+}
+
+func (p *ProcessProvider) now() time.Time {
+	if p.CurrentTime != nil {
+		return p.CurrentTime()
+	}
+	return time.Now()
+}
+
+// commandString returns the command the provider executes.
+func (p *ProcessProvider) commandString() string {
+	return strings.Join(p.originalCommand, " ")
 }
 
 // IsExpired returns true if the credentials retrieved are expired, or not yet
@@ -310,31 +447,145 @@ func (p *ProcessProvider) prepareCommand() error {
 
 		// check for empty command because it succeeds
 		if len(strings.TrimSpace(p.originalCommand[0])) < 1 {
-			return awserr.New(
-				ErrCodeProcessProviderExecution,
-				fmt.Sprintf(
-					"%s: %s",
-					errMsgProcessProviderPrepareCmd,
-					errMsgProcessProviderEmptyCmd),
-				nil)
+			return prepareCommandError(errMsgProcessProviderEmptyCmd)
 		}
 	}
 
 	cmdArgs = append(cmdArgs, p.originalCommand...)
 	p.command = exec.Command(cmdArgs[0], cmdArgs[1:]...)
-	p.command.Env = os.Environ()
+	p.command.Env = p.environ()
 
 	return nil
 }
 
+// prepareCommandError returns the error for the command failing to be
+// prepared.
+func prepareCommandError(msg string) error {
+	return awserr.New(
+		ErrCodeProcessProviderExecution,
+		fmt.Sprintf("%s: %s", errMsgProcessProviderPrepareCmd, msg),
+		nil)
+}
+
+// ValidateCommand returns the error the provider would fail to prepare the
+// command string with, such as for an empty command, or nil if the command
+// can be prepared. A command which cannot be split into arguments, such as
+// for an unterminated quote, is also reported, as the shell the command is
+// executed with would fail to run it. The command is not looked up or
+// executed.
+func ValidateCommand(command string) error {
+	if len(strings.TrimSpace(command)) == 0 {
+		return prepareCommandError(errMsgProcessProviderEmptyCmd)
+	}
+	if _, ok := splitCommand(command, runtime.GOOS == "windows"); !ok {
+		return prepareCommandError(errMsgProcessProviderSplitCmd)
+	}
+	return nil
+}
+
+// splitCommand splits the command string into its arguments the way the
+// shell the command is executed with would, returning false if a quote is
+// not terminated, or the command ends with an escape. Only double quotes
+// group arguments for Windows' cmd.exe.
+func splitCommand(command string, windows bool) ([]string, bool) {
+	var args []string
+	var arg []rune
+	var inArg bool
+	var quote rune
+	var escaped bool
+
+	for _, c := range command {
+		switch {
+		case escaped:
+			arg, escaped = append(arg, c), false
+		case c == '\\' && !windows && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				arg = append(arg, c)
+			}
+		case c == '"' || (c == '\'' && !windows):
+			quote, inArg = c, true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args, arg, inArg = append(args, string(arg)), nil, false
+			}
+		default:
+			arg, inArg = append(arg, c), true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, false
+	}
+	if inArg {
+		args = append(args, string(arg))
+	}
+
+	return args, true
+}
+
+// environ returns the environment variables of the process, the variables
+// inherited from the SDK's process and the provider's Env.
+func (p *ProcessProvider) environ() []string {
+	var passthrough map[string]struct{}
+	if len(p.EnvPassthrough) != 0 {
+		passthrough = make(map[string]struct{}, len(p.EnvPassthrough))
+		for _, name := range p.EnvPassthrough {
+			passthrough[envKey(name)] = struct{}{}
+		}
+	}
+
+	overrides := make(map[string]struct{}, len(p.Env))
+	for _, kv := range p.Env {
+		overrides[envKey(envName(kv))] = struct{}{}
+	}
+
+	var env []string
+	for _, kv := range os.Environ() {
+		key := envKey(envName(kv))
+		if _, ok := overrides[key]; ok {
+			continue
+		}
+		if passthrough != nil {
+			if _, ok := passthrough[key]; !ok {
+				continue
+			}
+		}
+		env = append(env, kv)
+	}
+
+	return append(env, p.Env...)
+}
+
+// envName returns the name of the "key=value" environment variable.
+func envName(kv string) string {
+	// The names of Windows' hidden per drive variables, such as "=C:",
+	// start with "=".
+	var start int
+	if strings.HasPrefix(kv, "=") {
+		start = 1
+	}
+	if i := strings.Index(kv[start:], "="); i >= 0 {
+		return kv[:start+i]
+	}
+	return kv
+}
+
+// envKey returns the key environment variables are compared by. Names of
+// environment variables are case insensitive on Windows.
+func envKey(name string) string {
+	if runtime.GOOS == "windows" {
+		return strings.ToUpper(name)
+	}
+	return name
+}
+
 // executeCredentialProcess starts the credential process on the OS and
 // returns the results or an error.
 func (p *ProcessProvider) executeCredentialProcess() ([]byte, error) {
 
-	if err := p.prepareCommand(); err != nil {
-		return nil, err
-	}
-
 	// Setup the pipes
 	outReadPipe, outWritePipe, err := os.Pipe()
 	if err != nil {
@@ -344,7 +595,14 @@ func (p *ProcessProvider) executeCredentialProcess() ([]byte, error) {
 			err)
 	}
 
-	p.command.Stderr = os.Stderr    // display stderr on console for MFA
+	// Display stderr on console for MFA, and capture it for errors.
+	var stderr io.Writer = os.Stderr
+	if p.Stderr != nil {
+		stderr = p.Stderr
+	}
+	stderrBuf := &limitedBuffer{max: p.MaxBufSize}
+
+	p.command.Stderr = io.MultiWriter(stderr, stderrBuf)
 	p.command.Stdout = outWritePipe // get creds json on process's stdout
 	p.command.Stdin = os.Stdin      // enable stdin for MFA
 
@@ -371,9 +629,13 @@ func (p *ProcessProvider) executeCredentialProcess() ([]byte, error) {
 			errors = appendError(errors, err)
 			errors = appendError(errors, execError)
 			if errors != nil {
+				msg := errMsgProcessProviderProcess
+				if out := strings.TrimSpace(stderrBuf.String()); len(out) != 0 {
+					msg = fmt.Sprintf("%s: %s", errMsgProcessProviderProcess, out)
+				}
 				return output.Bytes(), awserr.NewBatchError(
 					ErrCodeProcessProviderExecution,
-					errMsgProcessProviderProcess,
+					msg,
 					errors)
 			}
 		case <-time.After(p.Timeout):
@@ -413,6 +675,23 @@ func executeCommand(cmd exec.Cmd, exec chan error) {
 	exec <- err
 }
 
+// limitedBuffer is a writer buffering up to max bytes written to it. Bytes
+// written beyond max are discarded.
+type limitedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if n := b.max - b.Len(); n < len(p) {
+		if n > 0 {
+			b.Buffer.Write(p[:n])
+		}
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
 func readInput(r io.Reader, w io.Writer, read chan error) {
 	tee := io.TeeReader(r, w)
 
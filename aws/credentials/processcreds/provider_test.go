@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/internal/sdktesting"
@@ -248,6 +250,37 @@ func TestProcessProviderMoreEmptyCommands(t *testing.T) {
 
 }
 
+func TestValidateCommand(t *testing.T) {
+	cases := []struct {
+		Command   string
+		ExpectErr string
+	}{
+		{Command: `credential-helper --profile abc`},
+		{Command: `"/path with spaces/credential-helper" --profile "a b"`},
+		{Command: " \t", ExpectErr: "command must not be empty"},
+		{Command: `credential-helper "--profile abc`, ExpectErr: "command cannot be split into arguments"},
+	}
+
+	for i, c := range cases {
+		err := processcreds.ValidateCommand(c.Command)
+		if len(c.ExpectErr) == 0 {
+			if err != nil {
+				t.Errorf("%d, expect no error, got %v", i, err)
+			}
+			continue
+		}
+		if err == nil {
+			t.Fatalf("%d, expect error, got none", i)
+		}
+		if e, a := processcreds.ErrCodeProcessProviderExecution, err.(awserr.Error).Code(); e != a {
+			t.Errorf("%d, expect %v code, got %v", i, e, a)
+		}
+		if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+			t.Errorf("%d, expect %q in error, got %q", i, e, a)
+		}
+	}
+}
+
 func TestProcessProviderExpectErrors(t *testing.T) {
 	restoreEnvFn := sdktesting.StashEnv()
 	defer restoreEnvFn()
@@ -536,6 +569,272 @@ func TestProcessProviderAltConstruct(t *testing.T) {
 	}
 }
 
+func TestProcessProviderSessionLifetime(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	creds := processcreds.NewCredentials(
+		fmt.Sprintf(
+			"%s %s",
+			getOSCat(),
+			strings.Join(
+				[]string{"testdata", "static.json"},
+				string(os.PathSeparator))),
+		func(opt *processcreds.ProcessProvider) {
+			opt.SessionLifetime = 30 * time.Minute
+			opt.CurrentTime = func() time.Time { return now }
+		})
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	expiresAt, err := creds.ExpiresAt()
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := now.Add(30*time.Minute), expiresAt; !e.Equal(a) {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if creds.IsExpired() {
+		t.Errorf("expected %v, got %v", "not expired", "expired")
+	}
+
+	now = now.Add(31 * time.Minute)
+	if !creds.IsExpired() {
+		t.Errorf("expected %v, got %v", "expired", "not expired")
+	}
+}
+
+func TestProcessProviderCache(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	tmpDir, err := ioutil.TempDir("", "processcreds_cache")
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cacheDir := filepath.Join(tmpDir, "cache")
+	countFile := filepath.Join(tmpDir, "count")
+	credsFile := filepath.Join(tmpDir, "creds.json")
+
+	expiration := time.Now().Add(1 * time.Hour).UTC().Truncate(time.Second)
+	b, err := json.Marshal(&credentialTest{
+		Version:         1,
+		AccessKeyID:     "accesskey",
+		SecretAccessKey: "secretkey",
+		Expiration:      expiration.Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if err = ioutil.WriteFile(credsFile, b, 0600); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	command := fmt.Sprintf("echo run >> %s; cat %s", countFile, credsFile)
+	newCreds := func(now time.Time) *credentials.Credentials {
+		return processcreds.NewCredentials(command, func(opt *processcreds.ProcessProvider) {
+			opt.CacheDir = cacheDir
+			opt.CurrentTime = func() time.Time { return now }
+		})
+	}
+	runs := func() int {
+		b, _ := ioutil.ReadFile(countFile)
+		return strings.Count(string(b), "run")
+	}
+
+	v, err := newCreds(time.Now()).Get()
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := 1, runs(); e != a {
+		t.Errorf("expected %v runs, got %v", e, a)
+	}
+	if e, a := "", v.Source.Filename; e != a {
+		t.Errorf("expected %q source file, got %q", e, a)
+	}
+
+	// Credentials cached by the first provider are used by the second.
+	v, err = newCreds(time.Now()).Get()
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := 1, runs(); e != a {
+		t.Errorf("expected %v runs, got %v", e, a)
+	}
+	if e, a := "accesskey", v.AccessKeyID; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := cacheDir, filepath.Dir(v.Source.Filename); e != a {
+		t.Errorf("expected source file in %v, got %v", e, a)
+	}
+
+	fi, err := os.Stat(v.Source.Filename)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := os.FileMode(0600), fi.Mode().Perm(); e != a {
+		t.Errorf("expected %v cache file mode, got %v", e, a)
+	}
+
+	// Expired cached credentials are not used.
+	if _, err = newCreds(expiration.Add(time.Minute)).Get(); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := 2, runs(); e != a {
+		t.Errorf("expected %v runs, got %v", e, a)
+	}
+}
+
+func TestProcessProviderCacheEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	tmpDir, err := ioutil.TempDir("", "processcreds_cache")
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	cacheDir := filepath.Join(tmpDir, "cache")
+	expiration := time.Now().Add(1 * time.Hour).UTC().Format(time.RFC3339)
+	command := fmt.Sprintf(`echo '{"Version":1,"AccessKeyId":"'$HELPER_PROFILE'","SecretAccessKey":"secretkey","Expiration":"%s"}'`,
+		expiration)
+	newCreds := func(env ...string) *credentials.Credentials {
+		return processcreds.NewCredentials(command, func(opt *processcreds.ProcessProvider) {
+			opt.CacheDir = cacheDir
+			opt.Env = env
+		})
+	}
+
+	// Credentials cached for one environment are not used for another.
+	for _, profile := range []string{"dev", "prod", "dev"} {
+		v, err := newCreds("HELPER_PROFILE=" + profile).Get()
+		if err != nil {
+			t.Fatalf("expected %v, got %v", "no error", err)
+		}
+		if e, a := profile, v.AccessKeyID; e != a {
+			t.Errorf("expected %v, got %v", e, a)
+		}
+	}
+
+	files, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if e, a := 2, len(files); e != a {
+		t.Errorf("expected %v cache files, got %v", e, a)
+	}
+}
+
+func TestProcessProviderCacheStatic(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	cacheDir, err := ioutil.TempDir("", "processcreds_cache")
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	creds := processcreds.NewCredentials(
+		fmt.Sprintf(
+			"%s %s",
+			getOSCat(),
+			strings.Join(
+				[]string{"testdata", "static.json"},
+				string(os.PathSeparator))),
+		func(opt *processcreds.ProcessProvider) {
+			opt.CacheDir = cacheDir
+		})
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	files, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected credentials which do not expire not to be cached, got %v files", len(files))
+	}
+}
+
+func TestProcessProviderStderr(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	var stderr bytes.Buffer
+	creds := processcreds.NewCredentials("echo 'MFA device locked' 1>&2; exit 1",
+		func(opt *processcreds.ProcessProvider) {
+			opt.Stderr = &stderr
+		})
+	_, err := creds.Get()
+	if err == nil {
+		t.Fatalf("expected error, got none")
+	}
+	if e, a := processcreds.ErrCodeProcessProviderExecution, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "error in credential_process: MFA device locked", err.(awserr.Error).Message(); e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "MFA device locked\n", stderr.String(); e != a {
+		t.Errorf("expected %q written to stderr, got %q", e, a)
+	}
+}
+
+func TestProcessProviderEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires a POSIX shell")
+	}
+
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("PROCESSCREDS_PASSED", "passed")
+	os.Setenv("PROCESSCREDS_BLOCKED", "blocked")
+	os.Setenv("PROCESSCREDS_OVERRIDDEN", "inherited")
+
+	command := `echo "{\"Version\": 1, ` +
+		`\"AccessKeyId\": \"$PROCESSCREDS_PASSED$PROCESSCREDS_BLOCKED\", ` +
+		`\"SecretAccessKey\": \"$PROCESSCREDS_OVERRIDDEN\", ` +
+		`\"SessionToken\": \"$PROCESSCREDS_EXTRA\"}"`
+	creds := processcreds.NewCredentials(command, func(opt *processcreds.ProcessProvider) {
+		opt.EnvPassthrough = []string{"PATH", "PROCESSCREDS_PASSED", "PROCESSCREDS_OVERRIDDEN"}
+		opt.Env = []string{"PROCESSCREDS_OVERRIDDEN=overridden", "PROCESSCREDS_EXTRA=extra"}
+	})
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("expected %v, got %v", "no error", err)
+	}
+
+	if e, a := "passed", v.AccessKeyID; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "overridden", v.SecretAccessKey; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+	if e, a := "extra", v.SessionToken; e != a {
+		t.Errorf("expected %v, got %v", e, a)
+	}
+}
+
 func BenchmarkProcessProvider(b *testing.B) {
 	restoreEnvFn := sdktesting.StashEnv()
 	defer restoreEnvFn()
@@ -0,0 +1,73 @@
+package credentials
+
+import (
+	"time"
+)
+
+// RefreshEventType is the type of a RefreshEvent.
+type RefreshEventType int
+
+// The types of refresh events reported to Credentials.OnRefresh.
+const (
+	// RefreshStarted is reported before the provider's Retrieve is called.
+	RefreshStarted RefreshEventType = iota
+
+	// RefreshSucceeded is reported after the provider retrieved the
+	// credentials.
+	RefreshSucceeded
+
+	// RefreshFailed is reported after the provider failed to retrieve the
+	// credentials, including when the provider continued to return the
+	// previous credentials with an extended expiration.
+	RefreshFailed
+)
+
+// String returns the name of the refresh event type.
+func (t RefreshEventType) String() string {
+	switch t {
+	case RefreshStarted:
+		return "RefreshStarted"
+	case RefreshSucceeded:
+		return "RefreshSucceeded"
+	case RefreshFailed:
+		return "RefreshFailed"
+	default:
+		return "Unknown"
+	}
+}
+
+// A RefreshEvent is an event in the refresh of the credentials, reported to
+// Credentials.OnRefresh.
+type RefreshEvent struct {
+	Type RefreshEventType
+
+	// If the credentials are refreshed in the background, within the
+	// RefreshAhead window, while the cached credentials continue to be used.
+	Background bool
+
+	// Expiration is the expiration of the retrieved credentials for
+	// RefreshSucceeded events, and of the cached credentials still being used
+	// for RefreshStarted and RefreshFailed events of background refreshes.
+	// For Extended RefreshFailed events it is the extended expiration of the
+	// previous credentials. Zero if the expiration is not known, or the
+	// credentials do not expire.
+	Expiration time.Time
+
+	// If the provider failed to refresh the credentials, but continued to
+	// return the previous credentials with an extended expiration, for
+	// RefreshFailed events. The credentials are still updated, and callers of
+	// Get are not returned the error.
+	Extended bool
+
+	// The time taken by the provider to retrieve the credentials. Zero for
+	// RefreshStarted events.
+	Duration time.Duration
+
+	// The provider name and source of the retrieved credentials. Not set for
+	// RefreshStarted events, and may not be set for RefreshFailed events.
+	ProviderName string
+	Source       Source
+
+	// The error retrieving the credentials for RefreshFailed events.
+	Err error
+}
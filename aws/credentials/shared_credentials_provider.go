@@ -108,6 +108,11 @@ func loadProfile(filename, profile string) (Value, error) {
 		SecretAccessKey: secret,
 		SessionToken:    token,
 		ProviderName:    SharedCredsProviderName,
+		Source: Source{
+			Kind:     SourceSharedCredentialsFile,
+			Filename: filename,
+			Profile:  profile,
+		},
 	}, nil
 }
 
@@ -28,6 +28,15 @@ func TestSharedCredentialsProvider(t *testing.T) {
 	if e, a := "token", creds.SessionToken; e != a {
 		t.Errorf("expect %v, got %v", e, a)
 	}
+
+	expectSource := Source{
+		Kind:     SourceSharedCredentialsFile,
+		Filename: "example.ini",
+		Profile:  "default",
+	}
+	if e, a := expectSource, creds.Source; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestSharedCredentialsProviderIsExpired(t *testing.T) {
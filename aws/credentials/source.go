@@ -0,0 +1,116 @@
+package credentials
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of the sources credentials are retrieved from.
+const (
+	// SourceStatic is the kind of credentials provided statically by the
+	// application.
+	SourceStatic = "Static"
+
+	// SourceEnvironment is the kind of credentials read from the
+	// environment variables.
+	SourceEnvironment = "Environment"
+
+	// SourceSharedCredentialsFile is the kind of credentials read from the
+	// shared credentials file.
+	SourceSharedCredentialsFile = "SharedCredentialsFile"
+
+	// SourceSharedConfigFile is the kind of credentials read from the shared
+	// config file.
+	SourceSharedConfigFile = "SharedConfigFile"
+
+	// SourceProcess is the kind of credentials retrieved by running an
+	// external process.
+	SourceProcess = "Process"
+
+	// SourceSSO is the kind of credentials retrieved with an AWS SSO access
+	// token.
+	SourceSSO = "SSO"
+
+	// SourceWebIdentity is the kind of credentials retrieved by assuming a
+	// role with a web identity token.
+	SourceWebIdentity = "WebIdentity"
+
+	// SourceContainerEndpoint is the kind of credentials retrieved from a
+	// credentials endpoint, such as the ECS container credentials endpoint.
+	SourceContainerEndpoint = "ContainerEndpoint"
+
+	// SourceEC2InstanceMetadata is the kind of credentials of the EC2
+	// instance's role retrieved from the EC2 Instance Metadata Service.
+	SourceEC2InstanceMetadata = "EC2InstanceMetadata"
+
+	// SourceAssumeRole is the kind of credentials retrieved by assuming a
+	// role with other credentials.
+	SourceAssumeRole = "AssumeRole"
+)
+
+// A Source describes where credentials were retrieved from. Only the fields
+// relevant to the kind of the source are set.
+type Source struct {
+	// The kind of the source, such as SourceEnvironment.
+	Kind string
+
+	// The file the credentials were read from, or the web identity token
+	// file for SourceWebIdentity.
+	Filename string
+
+	// The profile of the shared credentials or config file.
+	Profile string
+
+	// The command run to retrieve the credentials, for SourceProcess.
+	Command string
+
+	// The URL of the endpoint the credentials were retrieved from, for
+	// SourceContainerEndpoint and SourceEC2InstanceMetadata.
+	Endpoint string
+
+	// The name of the EC2 instance's role, for SourceEC2InstanceMetadata.
+	RoleName string
+
+	// The ARN and session name of the role assumed, for SourceAssumeRole and
+	// SourceWebIdentity.
+	RoleARN         string
+	RoleSessionName string
+
+	// The source of the credentials the role was assumed with, for
+	// SourceAssumeRole. Nil if not known.
+	Parent *Source
+}
+
+// String returns a description of the source, and of the sources of the
+// credentials it was retrieved with, such as
+//
+//    AssumeRole(role=arn:aws:iam::123456789012:role/role) <- SharedCredentialsFile(file=~/.aws/credentials, profile=default)
+func (s Source) String() string {
+	if len(s.Kind) == 0 {
+		return "Unknown"
+	}
+
+	var fields []string
+	add := func(name, value string) {
+		if len(value) != 0 {
+			fields = append(fields, name+"="+value)
+		}
+	}
+	add("file", s.Filename)
+	add("profile", s.Profile)
+	add("command", s.Command)
+	add("endpoint", s.Endpoint)
+	add("role", s.RoleName)
+	add("role", s.RoleARN)
+	add("session", s.RoleSessionName)
+
+	str := s.Kind
+	if len(fields) != 0 {
+		str = fmt.Sprintf("%s(%s)", s.Kind, strings.Join(fields, ", "))
+	}
+	if s.Parent != nil {
+		str += " <- " + s.Parent.String()
+	}
+
+	return str
+}
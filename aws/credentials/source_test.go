@@ -0,0 +1,60 @@
+package credentials
+
+import "testing"
+
+func TestSourceString(t *testing.T) {
+	cases := map[string]struct {
+		Source Source
+		Expect string
+	}{
+		"unknown": {
+			Expect: "Unknown",
+		},
+		"environment": {
+			Source: Source{Kind: SourceEnvironment},
+			Expect: "Environment",
+		},
+		"shared credentials file": {
+			Source: Source{
+				Kind:     SourceSharedCredentialsFile,
+				Filename: "credentials",
+				Profile:  "default",
+			},
+			Expect: "SharedCredentialsFile(file=credentials, profile=default)",
+		},
+		"ec2 instance metadata": {
+			Source: Source{
+				Kind:     SourceEC2InstanceMetadata,
+				Endpoint: "http://169.254.169.254/latest",
+				RoleName: "role",
+			},
+			Expect: "EC2InstanceMetadata(endpoint=http://169.254.169.254/latest, role=role)",
+		},
+		"assume role chain": {
+			Source: Source{
+				Kind:            SourceAssumeRole,
+				RoleARN:         "arn:aws:iam::123456789012:role/child",
+				RoleSessionName: "session",
+				Parent: &Source{
+					Kind:            SourceAssumeRole,
+					RoleARN:         "arn:aws:iam::123456789012:role/parent",
+					RoleSessionName: "session",
+					Parent: &Source{
+						Kind:     SourceSharedConfigFile,
+						Filename: "config",
+						Profile:  "base",
+					},
+				},
+			},
+			Expect: "AssumeRole(role=arn:aws:iam::123456789012:role/child, session=session)" +
+				" <- AssumeRole(role=arn:aws:iam::123456789012:role/parent, session=session)" +
+				" <- SharedConfigFile(file=config, profile=base)",
+		},
+	}
+
+	for name, c := range cases {
+		if e, a := c.Expect, c.Source.String(); e != a {
+			t.Errorf("%s, expect %q, got %q", name, e, a)
+		}
+	}
+}
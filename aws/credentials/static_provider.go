@@ -44,6 +44,9 @@ func (s *StaticProvider) Retrieve() (Value, error) {
 	if len(s.Value.ProviderName) == 0 {
 		s.Value.ProviderName = StaticProviderName
 	}
+	if len(s.Value.Source.Kind) == 0 {
+		s.Value.Source.Kind = SourceStatic
+	}
 	return s.Value, nil
 }
 
@@ -87,6 +87,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/sdkrand"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
@@ -118,6 +119,12 @@ type AssumeRoler interface {
 	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
 }
 
+// assumeRolerWithContext is the context aware subset of the STS client API
+// used by this provider when the Client supports it.
+type assumeRolerWithContext interface {
+	AssumeRoleWithContext(aws.Context, *sts.AssumeRoleInput, ...request.Option) (*sts.AssumeRoleOutput, error)
+}
+
 // DefaultDuration is the default amount of time in minutes that the credentials
 // will be valid for.
 var DefaultDuration = time.Duration(15) * time.Minute
@@ -157,6 +164,11 @@ type AssumeRoleProvider struct {
 	// Optional ExternalID to pass along, defaults to nil if not set.
 	ExternalID *string
 
+	// Optional, the ARNs of IAM managed policies to use as managed session
+	// policies. The session's permissions are the intersection of the role's
+	// identity-based policies and the session policies.
+	PolicyArns []*sts.PolicyDescriptorType
+
 	// The policy plain text must be 2048 bytes or shorter. However, an internal
 	// conversion compresses it into a packed binary format with a separate limit.
 	// The PackedPolicySize response element indicates by percentage how close to
@@ -265,6 +277,12 @@ func NewCredentialsWithClient(svc AssumeRoler, roleARN string, options ...func(*
 
 // Retrieve generates a new set of temporary credentials using STS.
 func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
+	return p.RetrieveWithContext(aws.BackgroundContext())
+}
+
+// RetrieveWithContext generates a new set of temporary credentials using STS.
+// The context is only used if the Client implements AssumeRoleWithContext.
+func (p *AssumeRoleProvider) RetrieveWithContext(ctx credentials.Context) (credentials.Value, error) {
 	// Apply defaults where parameters are not set.
 	if p.RoleSessionName == "" {
 		// Try to work out a role name that will hopefully end up unique.
@@ -282,6 +300,7 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		ExternalId:        p.ExternalID,
 		Tags:              p.Tags,
 		TransitiveTagKeys: p.TransitiveTagKeys,
+		PolicyArns:        p.PolicyArns,
 	}
 	if p.Policy != nil {
 		input.Policy = p.Policy
@@ -304,7 +323,13 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		}
 	}
 
-	roleOutput, err := p.Client.AssumeRole(input)
+	var roleOutput *sts.AssumeRoleOutput
+	var err error
+	if c, ok := p.Client.(assumeRolerWithContext); ok {
+		roleOutput, err = c.AssumeRoleWithContext(ctx, input)
+	} else {
+		roleOutput, err = p.Client.AssumeRole(input)
+	}
 	if err != nil {
 		return credentials.Value{ProviderName: ProviderName}, err
 	}
@@ -317,5 +342,28 @@ func (p *AssumeRoleProvider) Retrieve() (credentials.Value, error) {
 		SecretAccessKey: *roleOutput.Credentials.SecretAccessKey,
 		SessionToken:    *roleOutput.Credentials.SessionToken,
 		ProviderName:    ProviderName,
+		Source: credentials.Source{
+			Kind:            credentials.SourceAssumeRole,
+			RoleARN:         p.RoleARN,
+			RoleSessionName: p.RoleSessionName,
+			Parent:          p.parentSource(ctx),
+		},
 	}, nil
 }
+
+// parentSource returns the source of the credentials the role was assumed
+// with, if the provider's client is an STS client. The client's credentials
+// were just retrieved to assume the role, and are read from its cache. No
+// source is returned if they have since expired, instead of refreshing them.
+func (p *AssumeRoleProvider) parentSource(ctx credentials.Context) *credentials.Source {
+	c, ok := p.Client.(*sts.STS)
+	if !ok || c.Config.Credentials == nil || c.Config.Credentials.IsExpired() {
+		return nil
+	}
+
+	v, err := c.Config.Credentials.GetWithContext(ctx)
+	if err != nil || len(v.Source.Kind) == 0 {
+		return nil
+	}
+	return &v.Source
+}
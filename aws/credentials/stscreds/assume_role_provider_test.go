@@ -2,10 +2,17 @@ package stscreds
 
 import (
 	"fmt"
+	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -223,3 +230,106 @@ func TestAssumeRoleProvider_WithTags(t *testing.T) {
 		t.Errorf("expect error")
 	}
 }
+
+func TestAssumeRoleProvider_WithPolicyArns(t *testing.T) {
+	stub := &stubSTS{
+		TestInput: func(in *sts.AssumeRoleInput) {
+			if len(in.PolicyArns) != 1 || *in.PolicyArns[0].Arn != "arn:aws:iam::aws:policy/ReadOnlyAccess" {
+				t.Errorf("PolicyArns not passed along")
+			}
+		},
+	}
+	p := &AssumeRoleProvider{
+		Client:  stub,
+		RoleARN: "roleARN",
+		PolicyArns: []*sts.PolicyDescriptorType{
+			{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")},
+		},
+	}
+	if _, err := p.Retrieve(); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+}
+
+// stsConfigProvider provides the config of an STS client for tests, without
+// a session.
+type stsConfigProvider struct{}
+
+func (stsConfigProvider) ClientConfig(serviceName string, cfgs ...*aws.Config) client.Config {
+	cfg := defaults.Config().WithRegion("us-east-1")
+	cfg.MergeIn(cfgs...)
+	return client.Config{
+		Config:        cfg,
+		Handlers:      defaults.Handlers(),
+		Endpoint:      "https://sts.amazonaws.com",
+		SigningRegion: "us-east-1",
+	}
+}
+
+type parentProvider struct {
+	retrieves int
+	expired   bool
+}
+
+func (p *parentProvider) Retrieve() (credentials.Value, error) {
+	p.retrieves++
+	return credentials.Value{
+		AccessKeyID:     "parentAKID",
+		SecretAccessKey: "parentSecret",
+		Source:          credentials.Source{Kind: credentials.SourceEnvironment},
+	}, nil
+}
+
+func (p *parentProvider) IsExpired() bool {
+	return p.retrieves == 0 || p.expired
+}
+
+func TestAssumeRoleProvider_ParentSource(t *testing.T) {
+	cases := map[string]struct {
+		ParentExpired bool
+		ExpectParent  *credentials.Source
+	}{
+		"cached": {
+			ExpectParent: &credentials.Source{Kind: credentials.SourceEnvironment},
+		},
+		"expired": {
+			ParentExpired: true,
+		},
+	}
+
+	for name, c := range cases {
+		parent := &parentProvider{expired: c.ParentExpired}
+		svc := sts.New(stsConfigProvider{}, &aws.Config{
+			Credentials: credentials.NewCredentials(parent),
+		})
+		svc.Handlers.Send.Swap(corehandlers.SendHandler.Name, request.NamedHandler{
+			Name: "custom send stub handler",
+			Fn: func(r *request.Request) {
+				r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}}
+				r.Data.(*sts.AssumeRoleOutput).Credentials = &sts.Credentials{
+					AccessKeyId:     aws.String("assumedAKID"),
+					SecretAccessKey: aws.String("assumedSecret"),
+					SessionToken:    aws.String("assumedToken"),
+					Expiration:      aws.Time(time.Now().Add(time.Hour)),
+				}
+			},
+		})
+		svc.Handlers.UnmarshalMeta.Clear()
+		svc.Handlers.Unmarshal.Clear()
+		svc.Handlers.UnmarshalError.Clear()
+
+		p := &AssumeRoleProvider{Client: svc, RoleARN: "roleARN"}
+		v, err := p.Retrieve()
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if e, a := c.ExpectParent, v.Source.Parent; !reflect.DeepEqual(e, a) {
+			t.Errorf("%s, expect %v parent source, got %v", name, e, a)
+		}
+		// The parent credentials are only retrieved to sign the request.
+		if e, a := 1, parent.retrieves; e != a {
+			t.Errorf("%s, expect %v parent retrievals, got %v", name, e, a)
+		}
+	}
+}
@@ -55,6 +55,12 @@ func TestWebIdentityProviderRetrieve(t *testing.T) {
 				SecretAccessKey: "secret-access-key",
 				SessionToken:    "session-token",
 				ProviderName:    stscreds.WebIdentityProviderName,
+				Source: credentials.Source{
+					Kind:            credentials.SourceWebIdentity,
+					Filename:        "testdata/token.jwt",
+					RoleARN:         "arn01234567890123456789",
+					RoleSessionName: "foo",
+				},
 			},
 		},
 		{
@@ -90,6 +96,12 @@ func TestWebIdentityProviderRetrieve(t *testing.T) {
 				SecretAccessKey: "secret-access-key",
 				SessionToken:    "session-token",
 				ProviderName:    stscreds.WebIdentityProviderName,
+				Source: credentials.Source{
+					Kind:            credentials.SourceWebIdentity,
+					Filename:        "testdata/token.jwt",
+					RoleARN:         "arn01234567890123456789",
+					RoleSessionName: "foo",
+				},
 			},
 		},
 	}
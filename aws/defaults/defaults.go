@@ -172,9 +172,12 @@ func localHTTPCredProvider(cfg aws.Config, handlers request.Handlers, u string)
 	}
 
 	if len(errMsg) > 0 {
-		if cfg.Logger != nil {
-			cfg.Logger.Log("Ignoring, HTTP credential provider", errMsg, err)
+		fields := []aws.LogField{{Key: "reason", Value: errMsg}}
+		if err != nil {
+			fields = append(fields, aws.LogField{Key: aws.LogFieldError, Value: err})
 		}
+		aws.LogWithFields(cfg.Logger, aws.LogSeverityWarn,
+			"Ignoring HTTP credential provider", fields...)
 		return credentials.ErrorProvider{
 			Err:          awserr.New("CredentialsEndpointError", errMsg, err),
 			ProviderName: endpointcreds.ProviderName,
@@ -199,7 +202,12 @@ func ec2RoleProvider(cfg aws.Config, handlers request.Handlers) credentials.Prov
 		resolver = endpoints.DefaultResolver()
 	}
 
-	e, _ := resolver.EndpointFor(endpoints.Ec2metadataServiceID, "")
+	e, _ := resolver.EndpointFor(endpoints.Ec2metadataServiceID, "",
+		func(opt *endpoints.Options) {
+			opt.EC2MetadataEndpointMode = cfg.EC2MetadataServiceEndpointMode
+			opt.EC2MetadataEndpoint = aws.StringValue(cfg.EC2MetadataServiceEndpoint)
+		})
+
 	return &ec2rolecreds.EC2RoleProvider{
 		Client:       ec2metadata.NewClient(cfg, handlers, e.URL, e.SigningRegion),
 		ExpiryWindow: 5 * time.Minute,
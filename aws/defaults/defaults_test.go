@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/sdktesting"
 	"github.com/aws/aws-sdk-go/internal/shareddefaults"
@@ -124,3 +125,33 @@ func TestDefaultEC2RoleProvider(t *testing.T) {
 		t.Errorf("expect %q endpoint, got %q", e, a)
 	}
 }
+
+func TestDefaultEC2RoleProvider_EndpointConfig(t *testing.T) {
+	cases := map[string]struct {
+		Config         aws.Config
+		ExpectEndpoint string
+	}{
+		"IPv6 mode": {
+			Config: aws.Config{
+				EC2MetadataServiceEndpointMode: endpoints.EC2IMDSEndpointModeStateIPv6,
+			},
+			ExpectEndpoint: "http://[fd00:ec2::254]/latest",
+		},
+		"custom endpoint": {
+			Config: aws.Config{
+				EC2MetadataServiceEndpoint:     aws.String("http://[::1]:1338"),
+				EC2MetadataServiceEndpointMode: endpoints.EC2IMDSEndpointModeStateIPv6,
+			},
+			ExpectEndpoint: "http://[::1]:1338/latest",
+		},
+	}
+
+	for name, c := range cases {
+		provider := RemoteCredProvider(c.Config, request.Handlers{})
+
+		ec2Provider := provider.(*ec2rolecreds.EC2RoleProvider)
+		if e, a := c.ExpectEndpoint, ec2Provider.Client.Endpoint; e != a {
+			t.Errorf("%s: expect %q endpoint, got %q", name, e, a)
+		}
+	}
+}
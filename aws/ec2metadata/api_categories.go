@@ -0,0 +1,424 @@
+package ec2metadata
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// GetNetworkInterfaces returns the network interfaces attached to the
+// instance, ordered by their device number.
+func (c *EC2Metadata) GetNetworkInterfaces() ([]EC2NetworkInterface, error) {
+	return c.GetNetworkInterfacesWithContext(aws.BackgroundContext())
+}
+
+// GetNetworkInterfacesWithContext returns the network interfaces attached to
+// the instance, ordered by their device number.
+func (c *EC2Metadata) GetNetworkInterfacesWithContext(ctx aws.Context) ([]EC2NetworkInterface, error) {
+	const macsPath = "network/interfaces/macs"
+
+	ifaces := map[string]*EC2NetworkInterface{}
+	var order []string
+	err := c.WalkMetadataPagesWithContext(ctx, macsPath, func(page []MetadataEntry) bool {
+		for _, entry := range page {
+			if entry.IsDir {
+				continue
+			}
+
+			parts := strings.Split(strings.TrimPrefix(entry.Path, macsPath+"/"), "/")
+			mac := parts[0]
+			iface, ok := ifaces[mac]
+			if !ok {
+				iface = &EC2NetworkInterface{MAC: mac}
+				ifaces[mac] = iface
+				order = append(order, mac)
+			}
+			iface.setField(parts[1:], entry.Value)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance network interfaces", err)
+	}
+
+	list := make([]EC2NetworkInterface, 0, len(order))
+	for _, mac := range order {
+		list = append(list, *ifaces[mac])
+	}
+	sort.Stable(byDeviceNumber(list))
+
+	return list, nil
+}
+
+// GetBlockDeviceMapping returns the block devices of the instance, keyed by
+// their virtual device name, such as "ami", "root", or "ebs1", with the
+// device's name as the value.
+func (c *EC2Metadata) GetBlockDeviceMapping() (map[string]string, error) {
+	return c.GetBlockDeviceMappingWithContext(aws.BackgroundContext())
+}
+
+// GetBlockDeviceMappingWithContext returns the block devices of the instance,
+// keyed by their virtual device name, such as "ami", "root", or "ebs1", with
+// the device's name as the value.
+func (c *EC2Metadata) GetBlockDeviceMappingWithContext(ctx aws.Context) (map[string]string, error) {
+	mapping, err := c.listMetadataValues(ctx, "block-device-mapping")
+	if err != nil {
+		return nil, awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance block device mapping", err)
+	}
+
+	return mapping, nil
+}
+
+// GetPlacement returns the placement of the instance.
+func (c *EC2Metadata) GetPlacement() (EC2Placement, error) {
+	return c.GetPlacementWithContext(aws.BackgroundContext())
+}
+
+// GetPlacementWithContext returns the placement of the instance.
+func (c *EC2Metadata) GetPlacementWithContext(ctx aws.Context) (EC2Placement, error) {
+	values, err := c.listMetadataValues(ctx, "placement")
+	if err != nil {
+		return EC2Placement{}, awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance placement", err)
+	}
+
+	placement := EC2Placement{
+		AvailabilityZone:   values["availability-zone"],
+		AvailabilityZoneID: values["availability-zone-id"],
+		GroupName:          values["group-name"],
+		HostID:             values["host-id"],
+		Region:             values["region"],
+	}
+	if v, ok := values["partition-number"]; ok {
+		if placement.PartitionNumber, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return EC2Placement{}, awserr.New(request.ErrCodeSerialization,
+				"failed to decode EC2 instance placement partition number", err)
+		}
+	}
+
+	return placement, nil
+}
+
+// GetInstanceTags returns the tags of the instance. Access to the instance's
+// tags must be enabled in the instance's metadata options.
+func (c *EC2Metadata) GetInstanceTags() (map[string]string, error) {
+	return c.GetInstanceTagsWithContext(aws.BackgroundContext())
+}
+
+// GetInstanceTagsWithContext returns the tags of the instance. Access to the
+// instance's tags must be enabled in the instance's metadata options.
+func (c *EC2Metadata) GetInstanceTagsWithContext(ctx aws.Context) (map[string]string, error) {
+	tags, err := c.listMetadataValues(ctx, "tags/instance")
+	if err != nil {
+		return nil, awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance tags", err)
+	}
+
+	return tags, nil
+}
+
+// GetSpotInstanceAction returns the action scheduled for the spot instance,
+// such as the instance being stopped or terminated. Returns nil if no action
+// is scheduled.
+func (c *EC2Metadata) GetSpotInstanceAction() (*EC2SpotInstanceAction, error) {
+	return c.GetSpotInstanceActionWithContext(aws.BackgroundContext())
+}
+
+// GetSpotInstanceActionWithContext returns the action scheduled for the spot
+// instance, such as the instance being stopped or terminated. Returns nil if
+// no action is scheduled.
+func (c *EC2Metadata) GetSpotInstanceActionWithContext(ctx aws.Context) (*EC2SpotInstanceAction, error) {
+	var action EC2SpotInstanceAction
+	if ok, err := c.getOptionalJSON(ctx, "spot/instance-action", &action); !ok || err != nil {
+		if err != nil {
+			err = awserr.New("EC2MetadataRequestError",
+				"failed to get EC2 spot instance action", err)
+		}
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// GetRebalanceRecommendation returns the rebalance recommendation signaling
+// the spot instance is at elevated risk of interruption. Returns nil if there
+// is no recommendation.
+func (c *EC2Metadata) GetRebalanceRecommendation() (*EC2RebalanceRecommendation, error) {
+	return c.GetRebalanceRecommendationWithContext(aws.BackgroundContext())
+}
+
+// GetRebalanceRecommendationWithContext returns the rebalance recommendation
+// signaling the spot instance is at elevated risk of interruption. Returns nil
+// if there is no recommendation.
+func (c *EC2Metadata) GetRebalanceRecommendationWithContext(ctx aws.Context) (*EC2RebalanceRecommendation, error) {
+	var rec EC2RebalanceRecommendation
+	if ok, err := c.getOptionalJSON(ctx, "events/recommendations/rebalance", &rec); !ok || err != nil {
+		if err != nil {
+			err = awserr.New("EC2MetadataRequestError",
+				"failed to get EC2 rebalance recommendation", err)
+		}
+		return nil, err
+	}
+
+	return &rec, nil
+}
+
+// GetTargetLifecycleState returns the Auto Scaling lifecycle state the
+// instance is transitioning to, such as "InService" or "Terminated".
+func (c *EC2Metadata) GetTargetLifecycleState() (string, error) {
+	return c.GetTargetLifecycleStateWithContext(aws.BackgroundContext())
+}
+
+// GetTargetLifecycleStateWithContext returns the Auto Scaling lifecycle state
+// the instance is transitioning to, such as "InService" or "Terminated".
+func (c *EC2Metadata) GetTargetLifecycleStateWithContext(ctx aws.Context) (string, error) {
+	state, err := c.GetMetadataWithContext(ctx, "autoscaling/target-lifecycle-state")
+	if err != nil {
+		return "", awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance target lifecycle state", err)
+	}
+
+	return strings.TrimSpace(state), nil
+}
+
+// GetScheduledMaintenanceEvents returns the maintenance events scheduled for
+// the instance.
+func (c *EC2Metadata) GetScheduledMaintenanceEvents() ([]EC2MaintenanceEvent, error) {
+	return c.GetScheduledMaintenanceEventsWithContext(aws.BackgroundContext())
+}
+
+// GetScheduledMaintenanceEventsWithContext returns the maintenance events
+// scheduled for the instance.
+func (c *EC2Metadata) GetScheduledMaintenanceEventsWithContext(ctx aws.Context) ([]EC2MaintenanceEvent, error) {
+	return c.getMaintenanceEvents(ctx, "scheduled")
+}
+
+// GetMaintenanceEventHistory returns the completed or canceled maintenance
+// events of the instance.
+func (c *EC2Metadata) GetMaintenanceEventHistory() ([]EC2MaintenanceEvent, error) {
+	return c.GetMaintenanceEventHistoryWithContext(aws.BackgroundContext())
+}
+
+// GetMaintenanceEventHistoryWithContext returns the completed or canceled
+// maintenance events of the instance.
+func (c *EC2Metadata) GetMaintenanceEventHistoryWithContext(ctx aws.Context) ([]EC2MaintenanceEvent, error) {
+	return c.getMaintenanceEvents(ctx, "history")
+}
+
+func (c *EC2Metadata) getMaintenanceEvents(ctx aws.Context, kind string) ([]EC2MaintenanceEvent, error) {
+	var events []EC2MaintenanceEvent
+	if _, err := c.getOptionalJSON(ctx, "events/maintenance/"+kind, &events); err != nil {
+		return nil, awserr.New("EC2MetadataRequestError",
+			"failed to get EC2 instance "+kind+" maintenance events", err)
+	}
+
+	return events, nil
+}
+
+// getOptionalJSON decodes the JSON document at the metadata path into v.
+// Returns false if the document does not exist.
+func (c *EC2Metadata) getOptionalJSON(ctx aws.Context, p string, v interface{}) (bool, error) {
+	resp, err := c.GetMetadataWithContext(ctx, p)
+	if err != nil {
+		if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if err := json.NewDecoder(strings.NewReader(resp)).Decode(v); err != nil {
+		return false, awserr.New(request.ErrCodeSerialization,
+			"failed to decode EC2 instance metadata "+p, err)
+	}
+
+	return true, nil
+}
+
+// An EC2NetworkInterface provides the shape for the metadata of a network
+// interface attached to the instance.
+type EC2NetworkInterface struct {
+	MAC                  string
+	DeviceNumber         int64
+	InterfaceID          string
+	OwnerID              string
+	LocalHostname        string
+	LocalIPv4s           []string
+	PublicHostname       string
+	PublicIPv4s          []string
+	IPv6s                []string
+	SecurityGroups       []string
+	SecurityGroupIDs     []string
+	SubnetID             string
+	SubnetIPv4CIDRBlock  string
+	SubnetIPv6CIDRBlocks []string
+	VPCID                string
+	VPCIPv4CIDRBlocks    []string
+	VPCIPv6CIDRBlocks    []string
+
+	// The private IPv4 addresses associated with each public IPv4 address
+	// of the interface.
+	IPv4Associations map[string]string
+}
+
+// setField sets the interface's field for the metadata path relative to the
+// interface's directory. Unknown fields are ignored.
+func (i *EC2NetworkInterface) setField(path []string, value string) {
+	if len(path) == 2 && path[0] == "ipv4-associations" {
+		if i.IPv4Associations == nil {
+			i.IPv4Associations = map[string]string{}
+		}
+		i.IPv4Associations[path[1]] = strings.TrimSpace(value)
+		return
+	}
+	if len(path) != 1 {
+		return
+	}
+
+	switch path[0] {
+	case "device-number":
+		i.DeviceNumber, _ = strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	case "interface-id":
+		i.InterfaceID = strings.TrimSpace(value)
+	case "owner-id":
+		i.OwnerID = strings.TrimSpace(value)
+	case "local-hostname":
+		i.LocalHostname = strings.TrimSpace(value)
+	case "local-ipv4s":
+		i.LocalIPv4s = metadataLines(value)
+	case "public-hostname":
+		i.PublicHostname = strings.TrimSpace(value)
+	case "public-ipv4s":
+		i.PublicIPv4s = metadataLines(value)
+	case "ipv6s":
+		i.IPv6s = metadataLines(value)
+	case "security-groups":
+		i.SecurityGroups = metadataLines(value)
+	case "security-group-ids":
+		i.SecurityGroupIDs = metadataLines(value)
+	case "subnet-id":
+		i.SubnetID = strings.TrimSpace(value)
+	case "subnet-ipv4-cidr-block":
+		i.SubnetIPv4CIDRBlock = strings.TrimSpace(value)
+	case "subnet-ipv6-cidr-blocks":
+		i.SubnetIPv6CIDRBlocks = metadataLines(value)
+	case "vpc-id":
+		i.VPCID = strings.TrimSpace(value)
+	case "vpc-ipv4-cidr-blocks":
+		i.VPCIPv4CIDRBlocks = metadataLines(value)
+	case "vpc-ipv6-cidr-blocks":
+		i.VPCIPv6CIDRBlocks = metadataLines(value)
+	}
+}
+
+type byDeviceNumber []EC2NetworkInterface
+
+func (b byDeviceNumber) Len() int           { return len(b) }
+func (b byDeviceNumber) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byDeviceNumber) Less(i, j int) bool { return b[i].DeviceNumber < b[j].DeviceNumber }
+
+// metadataLines returns the non-empty lines of a multi-valued metadata item.
+func metadataLines(value string) []string {
+	var lines []string
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// An EC2Placement provides the shape for the placement metadata of the
+// instance.
+type EC2Placement struct {
+	AvailabilityZone   string
+	AvailabilityZoneID string
+	GroupName          string
+	HostID             string
+	PartitionNumber    int64
+	Region             string
+}
+
+// An EC2SpotInstanceAction provides the shape for unmarshaling the action
+// scheduled for a spot instance.
+type EC2SpotInstanceAction struct {
+	// The action, "stop", "terminate", or "hibernate".
+	Action string `json:"action"`
+
+	// The time the action will be taken at.
+	Time time.Time `json:"time"`
+}
+
+// An EC2RebalanceRecommendation provides the shape for unmarshaling a spot
+// instance rebalance recommendation.
+type EC2RebalanceRecommendation struct {
+	// The time the recommendation was made at.
+	NoticeTime time.Time `json:"noticeTime"`
+}
+
+// maintenanceEventTimeFormat is the format of the times of maintenance events.
+const maintenanceEventTimeFormat = "2 Jan 2006 15:04:05 GMT"
+
+// An EC2MaintenanceEvent provides the shape for unmarshaling a maintenance
+// event of the instance.
+type EC2MaintenanceEvent struct {
+	Code              string
+	Description       string
+	EventID           string
+	State             string
+	NotBefore         time.Time
+	NotAfter          time.Time
+	NotBeforeDeadline time.Time
+}
+
+// UnmarshalJSON unmarshals the maintenance event from the instance metadata
+// JSON document.
+func (e *EC2MaintenanceEvent) UnmarshalJSON(b []byte) error {
+	var doc struct {
+		Code              string
+		Description       string
+		EventID           string `json:"EventId"`
+		State             string
+		NotBefore         string
+		NotAfter          string
+		NotBeforeDeadline string
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return err
+	}
+
+	*e = EC2MaintenanceEvent{
+		Code:        doc.Code,
+		Description: doc.Description,
+		EventID:     doc.EventID,
+		State:       doc.State,
+	}
+	times := []struct {
+		v   string
+		dst *time.Time
+	}{
+		{doc.NotBefore, &e.NotBefore},
+		{doc.NotAfter, &e.NotAfter},
+		{doc.NotBeforeDeadline, &e.NotBeforeDeadline},
+	}
+	for _, t := range times {
+		if len(t.v) == 0 {
+			continue
+		}
+		v, err := time.Parse(maintenanceEventTimeFormat, t.v)
+		if err != nil {
+			return err
+		}
+		*t.dst = v
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+// +build go1.7
+
+package ec2metadata_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+// newMetadataTreeServer returns a test server serving the content of each
+// instance metadata path, relative to "/latest/meta-data/".
+func newMetadataTreeServer(tree map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := tree[strings.TrimPrefix(r.URL.Path, "/latest/meta-data/")]
+		if !ok || r.Method != "GET" {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+}
+
+func newMetadataTreeClient(server *httptest.Server) *ec2metadata.EC2Metadata {
+	return ec2metadata.New(unit.Session, &aws.Config{
+		Endpoint:   aws.String(server.URL + "/latest"),
+		MaxRetries: aws.Int(0),
+	})
+}
+
+func TestWalkMetadataPages(t *testing.T) {
+	server := newMetadataTreeServer(map[string]string{
+		"placement/":                  "availability-zone\nregion\n",
+		"placement/availability-zone": "us-west-2a",
+		"placement/region":            "us-west-2",
+		"public-keys/":                "0=my-key",
+		"public-keys/0/":              "openssh-key",
+		"public-keys/0/openssh-key":   "ssh-rsa AAAA my-key",
+		"root/":                       "placement/\npublic-keys/\ninstance-id",
+		"root/placement/":             "region",
+		"root/placement/region":       "us-west-2",
+		"root/public-keys/":           "",
+		"root/instance-id":            "i-1234567890abcdef0",
+	})
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	cases := map[string]struct {
+		Path        string
+		StopAfter   int
+		ExpectPages [][]ec2metadata.MetadataEntry
+	}{
+		"leaves": {
+			Path: "placement",
+			ExpectPages: [][]ec2metadata.MetadataEntry{
+				{
+					{Path: "placement/availability-zone", Value: "us-west-2a"},
+					{Path: "placement/region", Value: "us-west-2"},
+				},
+			},
+		},
+		"indexed directories": {
+			Path: "public-keys/",
+			ExpectPages: [][]ec2metadata.MetadataEntry{
+				{{Path: "public-keys/0", IsDir: true}},
+				{{Path: "public-keys/0/openssh-key", Value: "ssh-rsa AAAA my-key"}},
+			},
+		},
+		"nested directories": {
+			Path: "root",
+			ExpectPages: [][]ec2metadata.MetadataEntry{
+				{
+					{Path: "root/placement", IsDir: true},
+					{Path: "root/public-keys", IsDir: true},
+					{Path: "root/instance-id", Value: "i-1234567890abcdef0"},
+				},
+				{{Path: "root/placement/region", Value: "us-west-2"}},
+				nil,
+			},
+		},
+		"stop walk": {
+			Path:      "root",
+			StopAfter: 2,
+			ExpectPages: [][]ec2metadata.MetadataEntry{
+				{
+					{Path: "root/placement", IsDir: true},
+					{Path: "root/public-keys", IsDir: true},
+					{Path: "root/instance-id", Value: "i-1234567890abcdef0"},
+				},
+				{{Path: "root/placement/region", Value: "us-west-2"}},
+			},
+		},
+	}
+
+	for name, c2 := range cases {
+		t.Run(name, func(t *testing.T) {
+			var pages [][]ec2metadata.MetadataEntry
+			err := c.WalkMetadataPages(c2.Path, func(page []ec2metadata.MetadataEntry) bool {
+				pages = append(pages, page)
+				return c2.StopAfter == 0 || len(pages) < c2.StopAfter
+			})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c2.ExpectPages, pages; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect pages\n%v\ngot\n%v", e, a)
+			}
+		})
+	}
+}
+
+func TestWalkMetadataPages_NotFound(t *testing.T) {
+	server := newMetadataTreeServer(map[string]string{})
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	err := c.WalkMetadataPages("missing", func([]ec2metadata.MetadataEntry) bool {
+		t.Errorf("expect no pages")
+		return true
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
+
+func TestGetNetworkInterfaces(t *testing.T) {
+	const macs = "network/interfaces/macs/"
+	server := newMetadataTreeServer(map[string]string{
+		macs:                                                  "0e:00:00:00:00:02/\n0e:00:00:00:00:01/",
+		macs + "0e:00:00:00:00:01/":                           "device-number\ninterface-id\nlocal-ipv4s\nipv4-associations/\nsecurity-group-ids\nvpc-id",
+		macs + "0e:00:00:00:00:01/device-number":              "0",
+		macs + "0e:00:00:00:00:01/interface-id":               "eni-1",
+		macs + "0e:00:00:00:00:01/local-ipv4s":                "10.0.0.1\n10.0.0.2",
+		macs + "0e:00:00:00:00:01/security-group-ids":         "sg-1\nsg-2\n",
+		macs + "0e:00:00:00:00:01/vpc-id":                     "vpc-1",
+		macs + "0e:00:00:00:00:01/ipv4-associations/":         "54.0.0.1",
+		macs + "0e:00:00:00:00:01/ipv4-associations/54.0.0.1": "10.0.0.1",
+		macs + "0e:00:00:00:00:02/":                           "device-number\ninterface-id\nipv6s",
+		macs + "0e:00:00:00:00:02/device-number":              "1",
+		macs + "0e:00:00:00:00:02/interface-id":               "eni-2",
+		macs + "0e:00:00:00:00:02/ipv6s":                      "2001:db8::1",
+	})
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	ifaces, err := c.GetNetworkInterfaces()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := []ec2metadata.EC2NetworkInterface{
+		{
+			MAC:              "0e:00:00:00:00:01",
+			DeviceNumber:     0,
+			InterfaceID:      "eni-1",
+			LocalIPv4s:       []string{"10.0.0.1", "10.0.0.2"},
+			SecurityGroupIDs: []string{"sg-1", "sg-2"},
+			VPCID:            "vpc-1",
+			IPv4Associations: map[string]string{"54.0.0.1": "10.0.0.1"},
+		},
+		{
+			MAC:          "0e:00:00:00:00:02",
+			DeviceNumber: 1,
+			InterfaceID:  "eni-2",
+			IPv6s:        []string{"2001:db8::1"},
+		},
+	}
+	if e, a := expect, ifaces; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %+v, got %+v", e, a)
+	}
+}
+
+func TestGetPlacementAndTags(t *testing.T) {
+	server := newMetadataTreeServer(map[string]string{
+		"placement/":                         "availability-zone\navailability-zone-id\npartition-number\nregion",
+		"placement/availability-zone":        "us-west-2a",
+		"placement/availability-zone-id":     "usw2-az1",
+		"placement/partition-number":         "3",
+		"placement/region":                   "us-west-2",
+		"block-device-mapping/":              "ami\nroot\nebs1",
+		"block-device-mapping/ami":           "/dev/xvda",
+		"block-device-mapping/root":          "/dev/xvda",
+		"block-device-mapping/ebs1":          "sdb",
+		"tags/instance/":                     "Name\nteam",
+		"tags/instance/Name":                 "web",
+		"tags/instance/team":                 "infra",
+		"autoscaling/target-lifecycle-state": "InService",
+	})
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	placement, err := c.GetPlacement()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectPlacement := ec2metadata.EC2Placement{
+		AvailabilityZone:   "us-west-2a",
+		AvailabilityZoneID: "usw2-az1",
+		PartitionNumber:    3,
+		Region:             "us-west-2",
+	}
+	if e, a := expectPlacement, placement; e != a {
+		t.Errorf("expect %+v, got %+v", e, a)
+	}
+
+	mapping, err := c.GetBlockDeviceMapping()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expectMapping := map[string]string{"ami": "/dev/xvda", "root": "/dev/xvda", "ebs1": "sdb"}
+	if e, a := expectMapping, mapping; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	tags, err := c.GetInstanceTags()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := map[string]string{"Name": "web", "team": "infra"}, tags; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	state, err := c.GetTargetLifecycleState()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "InService", state; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestGetSpotInstanceAction(t *testing.T) {
+	tree := map[string]string{}
+	server := newMetadataTreeServer(tree)
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	action, err := c.GetSpotInstanceAction()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if action != nil {
+		t.Errorf("expect no action, got %v", action)
+	}
+
+	tree["spot/instance-action"] = `{"action": "terminate", "time": "2017-09-18T08:22:00Z"}`
+	action, err = c.GetSpotInstanceAction()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expect := &ec2metadata.EC2SpotInstanceAction{
+		Action: "terminate",
+		Time:   time.Date(2017, 9, 18, 8, 22, 0, 0, time.UTC),
+	}
+	if e, a := expect, action; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	tree["spot/instance-action"] = `{"action": `
+	if _, err = c.GetSpotInstanceAction(); err == nil {
+		t.Errorf("expect error, got none")
+	}
+}
+
+func TestGetMaintenanceEvents(t *testing.T) {
+	server := newMetadataTreeServer(map[string]string{
+		"events/maintenance/scheduled": `[{
+  "NotBefore" : "21 Jan 2019 09:00:43 GMT",
+  "Code" : "system-reboot",
+  "Description" : "scheduled reboot",
+  "EventId" : "instance-event-0d59937288b749b32",
+  "NotAfter" : "21 Jan 2019 09:17:23 GMT",
+  "State" : "active"
+}]`,
+		"events/maintenance/history": `[]`,
+	})
+	defer server.Close()
+	c := newMetadataTreeClient(server)
+
+	events, err := c.GetScheduledMaintenanceEvents()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expect := []ec2metadata.EC2MaintenanceEvent{
+		{
+			Code:        "system-reboot",
+			Description: "scheduled reboot",
+			EventID:     "instance-event-0d59937288b749b32",
+			State:       "active",
+			NotBefore:   time.Date(2019, 1, 21, 9, 0, 43, 0, time.UTC),
+			NotAfter:    time.Date(2019, 1, 21, 9, 17, 23, 0, time.UTC),
+		},
+	}
+	if e, a := expect, events; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %+v, got %+v", e, a)
+	}
+
+	events, err = c.GetMaintenanceEventHistory()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 0, len(events); e != a {
+		t.Errorf("expect %v events, got %v", e, a)
+	}
+}
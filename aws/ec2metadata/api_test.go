@@ -918,6 +918,44 @@ func TestExhaustiveRetryToFetchToken(t *testing.T) {
 	}
 }
 
+func TestDisableFallbackToFetchToken(t *testing.T) {
+	ts := &testServer{
+		t:      t,
+		tokens: []string{"firstToken", "secondToken"},
+		data:   "IMDSProfileForSDKGo",
+	}
+
+	server := newTestServer(t, pageNotFoundForTokenTestType, ts)
+	defer server.Close()
+
+	op := &operationListProvider{}
+
+	c := ec2metadata.New(unit.Session, &aws.Config{
+		Endpoint:                  aws.String(server.URL + "/latest"),
+		EC2MetadataEnableFallback: aws.Bool(false),
+	})
+	c.Handlers.Complete.PushBack(op.addToOperationPerformedList)
+
+	for i := 0; i < 2; i++ {
+		resp, err := c.GetMetadata("/some/path")
+		if err == nil {
+			t.Fatalf("expect error, got none")
+		}
+		if e, a := "EC2MetadataTokenError", err.(awserr.Error).Code(); e != a {
+			t.Errorf("expect %v error code, got %v", e, a)
+		}
+		if e, a := "", resp; e != a {
+			t.Errorf("expect %v, got %v", e, a)
+		}
+	}
+
+	// The token is fetched again, as the token provider is not disabled.
+	expectedOperationsPerformed := []string{"GetToken", "GetMetadata", "GetToken", "GetMetadata"}
+	if e, a := expectedOperationsPerformed, op.operationsPerformed; !reflect.DeepEqual(e, a) {
+		t.Fatalf("expect %v operations, got %v", e, a)
+	}
+}
+
 func TestExhaustiveRetryWith401(t *testing.T) {
 	ts := &testServer{
 		t:      t,
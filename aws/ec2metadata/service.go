@@ -5,6 +5,13 @@
 // variable "AWS_EC2_METADATA_DISABLED=true". This environment variable set to
 // true instructs the SDK to disable the EC2 Metadata client. The client cannot
 // be used while the environment variable is set to true, (case insensitive).
+//
+// The client's endpoint is the IPv4 endpoint of the EC2 Metadata service by
+// default. The IPv6 endpoint is selected with the aws.Config
+// EC2MetadataServiceEndpointMode field, or with the
+// "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE=IPv6" environment variable. A custom
+// endpoint can be set with the EC2MetadataServiceEndpoint field, or the
+// "AWS_EC2_METADATA_SERVICE_ENDPOINT" environment variable.
 package ec2metadata
 
 import (
@@ -101,6 +108,8 @@ func NewClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
 
 	// token provider instance
 	tp := newTokenProvider(svc, defaultTTL)
+	tp.disableFallback = cfg.EC2MetadataEnableFallback != nil &&
+		!*cfg.EC2MetadataEnableFallback
 
 	// NamedHandler for fetching token
 	svc.Handlers.Sign.PushBackNamed(request.NamedHandler{
@@ -122,6 +131,9 @@ func NewClient(cfg aws.Config, handlers request.Handlers, endpoint, signingRegio
 	// This short-circuits the service's functionality to always fail to send
 	// requests.
 	if strings.ToLower(os.Getenv(disableServiceEnvVar)) == "true" {
+		// There is no need to fetch a token for requests that will not be
+		// sent.
+		tp.disabled = 1
 		svc.Handlers.Send.SwapNamed(request.NamedHandler{
 			Name: corehandlers.SendHandler.Name,
 			Fn: func(r *request.Request) {
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
 	"strings"
 	"sync"
 	"testing"
@@ -92,6 +93,10 @@ func TestClientDisableIMDS(t *testing.T) {
 	svc := ec2metadata.New(unit.Session, &aws.Config{
 		LogLevel: aws.LogLevel(aws.LogDebugWithHTTPBody),
 	})
+	var ops []string
+	svc.Handlers.Complete.PushBack(func(r *request.Request) {
+		ops = append(ops, r.Operation.Name)
+	})
 	resp, err := svc.GetUserData()
 	if err == nil {
 		t.Fatalf("expect error, got none")
@@ -107,6 +112,11 @@ func TestClientDisableIMDS(t *testing.T) {
 	if e, a := "AWS_EC2_METADATA_DISABLED", aerr.Message(); !strings.Contains(a, e) {
 		t.Errorf("expect %v in error message, got %v", e, a)
 	}
+
+	// The token is not fetched for requests that will not be sent.
+	if e, a := []string{"GetUserData"}, ops; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations, got %v", e, a)
+	}
 }
 
 func runEC2MetadataClients(t *testing.T, cfg *aws.Config, atOnce int) {
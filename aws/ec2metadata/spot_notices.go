@@ -0,0 +1,124 @@
+package ec2metadata
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// Types of the notices delivered by WatchSpotNotices.
+const (
+	// SpotInstanceActionNotice is the type of notices of an action scheduled
+	// for the spot instance, such as the instance being interrupted.
+	SpotInstanceActionNotice = "instance-action"
+
+	// RebalanceRecommendationNotice is the type of notices of the spot
+	// instance being at elevated risk of interruption.
+	RebalanceRecommendationNotice = "rebalance-recommendation"
+)
+
+// DefaultSpotNoticePollInterval is the interval WatchSpotNotices polls the
+// instance metadata at if no interval is provided.
+const DefaultSpotNoticePollInterval = 5 * time.Second
+
+// A SpotNotice is a notice of a spot instance interruption or rebalance
+// recommendation delivered by WatchSpotNotices.
+type SpotNotice struct {
+	// The type of the notice, SpotInstanceActionNotice or
+	// RebalanceRecommendationNotice.
+	Type string
+
+	// The action scheduled for the instance, set for SpotInstanceActionNotice
+	// notices.
+	InstanceAction *EC2SpotInstanceAction
+
+	// The rebalance recommendation, set for RebalanceRecommendationNotice
+	// notices.
+	RebalanceRecommendation *EC2RebalanceRecommendation
+}
+
+// WatchSpotNotices polls the instance metadata for spot instance actions and
+// rebalance recommendations at the interval provided, delivering each new
+// notice on the returned channel. A notice is delivered once, until the
+// action or recommendation changes. If pollInterval is zero or less,
+// DefaultSpotNoticePollInterval will be used.
+//
+// Polling stops, and the channel is closed, once the context is canceled.
+// Errors polling the instance metadata are logged as warnings to the
+// client's Logger, and polling continues.
+//
+// Example:
+//     ctx, cancel := context.WithCancel(context.Background())
+//     defer cancel()
+//
+//     for notice := range svc.WatchSpotNotices(ctx, 0) {
+//         if notice.Type == ec2metadata.SpotInstanceActionNotice {
+//             fmt.Println("instance will", notice.InstanceAction.Action,
+//                 "at", notice.InstanceAction.Time)
+//         }
+//     }
+func (c *EC2Metadata) WatchSpotNotices(ctx aws.Context, pollInterval time.Duration) <-chan SpotNotice {
+	if pollInterval <= 0 {
+		pollInterval = DefaultSpotNoticePollInterval
+	}
+
+	notices := make(chan SpotNotice)
+	go c.watchSpotNotices(ctx, pollInterval, notices)
+
+	return notices
+}
+
+func (c *EC2Metadata) watchSpotNotices(ctx aws.Context, pollInterval time.Duration, notices chan<- SpotNotice) {
+	defer close(notices)
+
+	var lastAction EC2SpotInstanceAction
+	var lastRebalance EC2RebalanceRecommendation
+
+	for {
+		var pending []SpotNotice
+
+		action, err := c.GetSpotInstanceActionWithContext(ctx)
+		if err != nil {
+			c.logSpotNoticeError(ctx, err)
+		} else if action != nil && (action.Action != lastAction.Action || !action.Time.Equal(lastAction.Time)) {
+			lastAction = *action
+			pending = append(pending, SpotNotice{
+				Type:           SpotInstanceActionNotice,
+				InstanceAction: action,
+			})
+		}
+
+		rec, err := c.GetRebalanceRecommendationWithContext(ctx)
+		if err != nil {
+			c.logSpotNoticeError(ctx, err)
+		} else if rec != nil && !rec.NoticeTime.Equal(lastRebalance.NoticeTime) {
+			lastRebalance = *rec
+			pending = append(pending, SpotNotice{
+				Type:                    RebalanceRecommendationNotice,
+				RebalanceRecommendation: rec,
+			})
+		}
+
+		for _, notice := range pending {
+			select {
+			case notices <- notice:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := aws.SleepWithContext(ctx, pollInterval); err != nil {
+			return
+		}
+	}
+}
+
+func (c *EC2Metadata) logSpotNoticeError(ctx aws.Context, err error) {
+	if ctx.Err() != nil {
+		// Errors caused by the watch being stopped are expected.
+		return
+	}
+	aws.LogWithFields(c.Config.Logger, aws.LogSeverityWarn,
+		"Failed to poll EC2 instance metadata for spot notices",
+		aws.LogField{Key: aws.LogFieldError, Value: err})
+}
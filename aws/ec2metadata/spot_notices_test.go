@@ -0,0 +1,90 @@
+// +build go1.7
+
+package ec2metadata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+func TestWatchSpotNotices(t *testing.T) {
+	var m sync.Mutex
+	tree := map[string]string{}
+	setPath := func(p, v string) {
+		m.Lock()
+		defer m.Unlock()
+		tree[p] = v
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.Lock()
+		content, ok := tree[r.URL.Path]
+		m.Unlock()
+		if !ok || r.Method != "GET" {
+			http.Error(w, "Not Found", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(content))
+	}))
+	defer server.Close()
+
+	var logs []string
+	c := ec2metadata.New(unit.Session, &aws.Config{
+		Endpoint:   aws.String(server.URL + "/latest"),
+		MaxRetries: aws.Int(0),
+		Logger: aws.LoggerFunc(func(args ...interface{}) {
+			m.Lock()
+			defer m.Unlock()
+			logs = append(logs, args[0].(string))
+		}),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	setPath("/latest/meta-data/events/recommendations/rebalance", `{"noticeTime": "2020-10-27T08:22:00Z"}`)
+	notices := c.WatchSpotNotices(ctx, time.Millisecond)
+
+	notice := <-notices
+	if e, a := ec2metadata.RebalanceRecommendationNotice, notice.Type; e != a {
+		t.Fatalf("expect %v notice, got %v", e, a)
+	}
+	if e, a := time.Date(2020, 10, 27, 8, 22, 0, 0, time.UTC), notice.RebalanceRecommendation.NoticeTime; !e.Equal(a) {
+		t.Errorf("expect %v notice time, got %v", e, a)
+	}
+
+	// The rebalance recommendation is not delivered again.
+	setPath("/latest/meta-data/spot/instance-action", `{"action": "stop", "time": "2020-10-27T08:30:00Z"}`)
+	notice = <-notices
+	if e, a := ec2metadata.SpotInstanceActionNotice, notice.Type; e != a {
+		t.Fatalf("expect %v notice, got %v", e, a)
+	}
+	if e, a := "stop", notice.InstanceAction.Action; e != a {
+		t.Errorf("expect %v action, got %v", e, a)
+	}
+
+	// Errors are logged, and polling continues.
+	setPath("/latest/meta-data/spot/instance-action", `{`)
+	setPath("/latest/meta-data/events/recommendations/rebalance", `{"noticeTime": "2020-10-27T08:40:00Z"}`)
+	notice = <-notices
+	if e, a := ec2metadata.RebalanceRecommendationNotice, notice.Type; e != a {
+		t.Fatalf("expect %v notice, got %v", e, a)
+	}
+	m.Lock()
+	if len(logs) == 0 {
+		t.Errorf("expect error to be logged")
+	}
+	m.Unlock()
+
+	cancel()
+	for range notices {
+	}
+}
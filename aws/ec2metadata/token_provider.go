@@ -15,11 +15,16 @@ import (
 // tokenProvider also provides an atomic flag to disable the
 // fetch token operation.
 // The disabled member will use 0 as false, and 1 as true.
+//
+// If disableFallback is set the tokenProvider will not fallback to the
+// insecure data flow when it fails to fetch a token, failing the request
+// instead.
 type tokenProvider struct {
-	client        *EC2Metadata
-	token         atomic.Value
-	configuredTTL time.Duration
-	disabled      uint32
+	client          *EC2Metadata
+	token           atomic.Value
+	configuredTTL   time.Duration
+	disabled        uint32
+	disableFallback bool
 }
 
 // A ec2Token struct helps use of token in EC2 Metadata service ops
@@ -46,9 +51,14 @@ func (t *tokenProvider) fetchTokenHandler(r *request.Request) {
 		return
 	}
 
-	output, err := t.client.getToken(t.configuredTTL)
+	output, err := t.client.getToken(r.Context(), t.configuredTTL)
 
 	if err != nil {
+		if t.disableFallback {
+			r.Error = awserr.New("EC2MetadataTokenError",
+				"failed to fetch EC2 IMDS token, and fallback to insecure requests is disabled", err)
+			return
+		}
 
 		// change the disabled flag on token provider to true,
 		// when error is request timeout error.
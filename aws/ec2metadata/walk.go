@@ -0,0 +1,140 @@
+package ec2metadata
+
+import (
+	"bufio"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// A MetadataEntry is an entry of an EC2 instance metadata directory.
+type MetadataEntry struct {
+	// The path of the entry relative to the instance metadata root, such
+	// as "placement/region".
+	Path string
+
+	// If the entry is a directory. Directories are visited as pages of their
+	// own after the page they are an entry of.
+	IsDir bool
+
+	// The content of the entry. Empty for directories.
+	Value string
+}
+
+// WalkMetadataPages walks the EC2 instance metadata directory tree rooted at
+// the path provided, calling fn with each directory's entries as a page. The
+// values of the directory's entries are retrieved before the page is passed
+// to fn. Directories are walked depth first, in the order they are listed.
+//
+// Return false from fn to stop walking the tree.
+//
+// Example walking the metadata of the instance's network interfaces:
+//
+//     err := svc.WalkMetadataPages("network/interfaces/macs",
+//         func(page []ec2metadata.MetadataEntry) bool {
+//             for _, entry := range page {
+//                 fmt.Println(entry.Path, entry.Value)
+//             }
+//             return true
+//         })
+func (c *EC2Metadata) WalkMetadataPages(p string, fn func([]MetadataEntry) bool) error {
+	return c.WalkMetadataPagesWithContext(aws.BackgroundContext(), p, fn)
+}
+
+// WalkMetadataPagesWithContext is the same as WalkMetadataPages with the
+// addition of the ability to pass a context to the requests retrieving the
+// metadata.
+func (c *EC2Metadata) WalkMetadataPagesWithContext(ctx aws.Context, p string, fn func([]MetadataEntry) bool) error {
+	_, err := c.walkMetadata(ctx, strings.Trim(p, "/"), fn)
+	return err
+}
+
+// walkMetadata walks the directory at the path dir, returning false if the
+// walk was stopped by fn.
+func (c *EC2Metadata) walkMetadata(ctx aws.Context, dir string, fn func([]MetadataEntry) bool) (bool, error) {
+	page, err := c.listMetadata(ctx, dir)
+	if err != nil {
+		return false, err
+	}
+
+	for i, entry := range page {
+		if entry.IsDir {
+			continue
+		}
+		if page[i].Value, err = c.GetMetadataWithContext(ctx, entry.Path); err != nil {
+			return false, err
+		}
+	}
+
+	if !fn(page) {
+		return false, nil
+	}
+
+	for _, entry := range page {
+		if !entry.IsDir {
+			continue
+		}
+		if cont, err := c.walkMetadata(ctx, entry.Path, fn); !cont || err != nil {
+			return cont, err
+		}
+	}
+
+	return true, nil
+}
+
+// listMetadata returns the entries of the metadata directory, without their
+// values.
+func (c *EC2Metadata) listMetadata(ctx aws.Context, dir string) ([]MetadataEntry, error) {
+	resp, err := c.GetMetadataWithContext(ctx, dir+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []MetadataEntry
+	s := bufio.NewScanner(strings.NewReader(resp))
+	for s.Scan() {
+		name := strings.TrimSpace(s.Text())
+		if len(name) == 0 {
+			continue
+		}
+
+		var entry MetadataEntry
+		// Directories are listed with a trailing slash, or as index=name
+		// such as the entries of public-keys.
+		if i := strings.Index(name, "="); i >= 0 {
+			name, entry.IsDir = name[:i], true
+		} else if strings.HasSuffix(name, "/") {
+			name, entry.IsDir = strings.TrimSuffix(name, "/"), true
+		}
+
+		entry.Path = name
+		if len(dir) != 0 {
+			entry.Path = dir + "/" + name
+		}
+		entries = append(entries, entry)
+	}
+	if err := s.Err(); err != nil {
+		return nil, awserr.New(request.ErrCodeSerialization,
+			"failed to read EC2 instance metadata directory "+dir, err)
+	}
+
+	return entries, nil
+}
+
+// listMetadataValues returns the values of the entries of the metadata
+// directory keyed by the entry's name. Subdirectories are not included.
+func (c *EC2Metadata) listMetadataValues(ctx aws.Context, dir string) (map[string]string, error) {
+	values := map[string]string{}
+	err := c.WalkMetadataPagesWithContext(ctx, dir, func(page []MetadataEntry) bool {
+		for _, entry := range page {
+			if !entry.IsDir {
+				values[entry.Path[strings.LastIndex(entry.Path, "/")+1:]] = entry.Value
+			}
+		}
+		return false
+	})
+
+	return values, err
+}
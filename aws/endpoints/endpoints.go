@@ -53,6 +53,16 @@ type Options struct {
 
 	// S3 Regional Endpoint flag helps with resolving the S3 endpoint
 	S3UsEast1RegionalEndpoint S3UsEast1RegionalEndpoint
+
+	// EC2 IMDS Endpoint Mode flag helps with resolving the EC2 instance
+	// metadata service endpoint as either the IPv4 or IPv6 endpoint.
+	EC2MetadataEndpointMode EC2IMDSEndpointModeState
+
+	// EC2 IMDS Endpoint flag overrides the EC2 instance metadata service
+	// endpoint with a custom endpoint, such as a host and port. The endpoint
+	// is resolved with the HTTP scheme if it has none, and the "/latest"
+	// path. Takes precedence over EC2MetadataEndpointMode.
+	EC2MetadataEndpoint string
 }
 
 // STSRegionalEndpoint is an enum for the states of the STS Regional Endpoint
@@ -151,6 +161,55 @@ func GetS3UsEast1RegionalEndpoint(s string) (S3UsEast1RegionalEndpoint, error) {
 	}
 }
 
+// EC2IMDSEndpointModeState is an enum for the states of the EC2 instance
+// metadata service endpoint mode options.
+type EC2IMDSEndpointModeState int
+
+func (e EC2IMDSEndpointModeState) String() string {
+	switch e {
+	case EC2IMDSEndpointModeStateIPv4:
+		return "IPv4"
+	case EC2IMDSEndpointModeStateIPv6:
+		return "IPv6"
+	case EC2IMDSEndpointModeStateUnset:
+		return ""
+	default:
+		return "unknown"
+	}
+}
+
+const (
+
+	// EC2IMDSEndpointModeStateUnset represents that the EC2 IMDS endpoint
+	// mode flag is not specified. The IPv4 endpoint will be used.
+	EC2IMDSEndpointModeStateUnset EC2IMDSEndpointModeState = iota
+
+	// EC2IMDSEndpointModeStateIPv4 represents when the EC2 IMDS endpoint mode
+	// flag is specified to use the IPv4 endpoint.
+	EC2IMDSEndpointModeStateIPv4
+
+	// EC2IMDSEndpointModeStateIPv6 represents when the EC2 IMDS endpoint mode
+	// flag is specified to use the IPv6 endpoint.
+	EC2IMDSEndpointModeStateIPv6
+)
+
+// GetEC2IMDSEndpointMode function returns the EC2IMDSEndpointModeState based
+// on the input string provided in env config or shared config by the user.
+//
+// `IPv4`, `IPv6` are the only case-insensitive valid strings for resolving
+// the EC2 IMDS endpoint mode flag.
+func GetEC2IMDSEndpointMode(s string) (EC2IMDSEndpointModeState, error) {
+	switch {
+	case strings.EqualFold(s, "IPv4"):
+		return EC2IMDSEndpointModeStateIPv4, nil
+	case strings.EqualFold(s, "IPv6"):
+		return EC2IMDSEndpointModeStateIPv6, nil
+	default:
+		return EC2IMDSEndpointModeStateUnset,
+			fmt.Errorf("unable to resolve the value of EC2IMDSEndpointMode for %v", s)
+	}
+}
+
 // Set combines all of the option functions together.
 func (o *Options) Set(optFns ...func(*Options)) {
 	for _, fn := range optFns {
@@ -99,6 +99,15 @@ func (p partition) EndpointFor(service, region string, opts ...func(*Options)) (
 	var opt Options
 	opt.Set(opts...)
 
+	if service == Ec2metadataServiceID && len(opt.EC2MetadataEndpoint) != 0 {
+		// The EC2 Instance Metadata Service only supports HTTP.
+		return ResolvedEndpoint{
+			URL:           strings.TrimRight(AddScheme(opt.EC2MetadataEndpoint, true), "/") + "/latest",
+			PartitionID:   p.ID,
+			SigningRegion: region,
+		}, nil
+	}
+
 	s, hasService := p.Services[service]
 	if len(service) == 0 || !(hasService || opt.ResolveUnknownService) {
 		// Only return error if the resolver will not fallback to creating
@@ -122,11 +131,20 @@ func (p partition) EndpointFor(service, region string, opts ...func(*Options)) (
 		return resolved, NewUnknownEndpointError(p.ID, service, region, endpointList(s.Endpoints))
 	}
 
+	if service == Ec2metadataServiceID && opt.EC2MetadataEndpointMode == EC2IMDSEndpointModeStateIPv6 {
+		e.Hostname = ec2MetadataEndpointIPv6
+	}
+
 	defs := []endpoint{p.Defaults, s.Defaults}
 
 	return e.resolve(service, p.ID, region, p.DNSSuffix, defs, opt), nil
 }
 
+// ec2MetadataEndpointIPv6 is the hostname of the EC2 instance metadata
+// service's IPv6 endpoint, used in place of the IPv4 link-local endpoint
+// when the IPv6 endpoint mode is selected.
+const ec2MetadataEndpointIPv6 = "[fd00:ec2::254]/latest"
+
 func serviceList(ss services) []string {
 	list := make([]string, 0, len(ss))
 	for k := range ss {
@@ -598,3 +598,78 @@ func TestEndpointFor_EmptyRegion(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointFor_EC2MetadataEndpointMode(t *testing.T) {
+	cases := map[string]struct {
+		Mode      EC2IMDSEndpointModeState
+		Endpoint  string
+		ExpectURL string
+	}{
+		"unset": {
+			ExpectURL: "http://169.254.169.254/latest",
+		},
+		"IPv4": {
+			Mode:      EC2IMDSEndpointModeStateIPv4,
+			ExpectURL: "http://169.254.169.254/latest",
+		},
+		"IPv6": {
+			Mode:      EC2IMDSEndpointModeStateIPv6,
+			ExpectURL: "http://[fd00:ec2::254]/latest",
+		},
+		"custom endpoint": {
+			Mode:      EC2IMDSEndpointModeStateIPv6,
+			Endpoint:  "127.0.0.1:1338/",
+			ExpectURL: "http://127.0.0.1:1338/latest",
+		},
+		"custom endpoint with scheme": {
+			Endpoint:  "http://[::1]:1338",
+			ExpectURL: "http://[::1]:1338/latest",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := AwsPartition().EndpointFor(Ec2metadataServiceID, "",
+				func(o *Options) {
+					o.EC2MetadataEndpointMode = c.Mode
+					o.EC2MetadataEndpoint = c.Endpoint
+				})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.ExpectURL, actual.URL; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestGetEC2IMDSEndpointMode(t *testing.T) {
+	cases := map[string]struct {
+		Value     string
+		Expect    EC2IMDSEndpointModeState
+		ExpectErr bool
+	}{
+		"IPv4":    {Value: "IPv4", Expect: EC2IMDSEndpointModeStateIPv4},
+		"ipv6":    {Value: "ipv6", Expect: EC2IMDSEndpointModeStateIPv6},
+		"invalid": {Value: "IPv5", ExpectErr: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := GetEC2IMDSEndpointMode(c.Value)
+			if c.ExpectErr {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, actual; e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package aws
 
 import (
+	"bytes"
+	"fmt"
 	"log"
 	"os"
+	"strings"
 )
 
 // A LogLevelType defines the level logging should be performed at. Used to instruct
@@ -116,3 +119,140 @@ type defaultLogger struct {
 func (l defaultLogger) Log(args ...interface{}) {
 	l.logger.Println(args...)
 }
+
+// A LogSeverity is the severity of a single structured log entry. Unlike
+// LogLevelType, which selects what the SDK should log, LogSeverity describes
+// how important an individual entry is.
+type LogSeverity uint
+
+// Severities of structured log entries emitted by the SDK.
+const (
+	// LogSeverityDebug is used for diagnostic entries such as wire dumps and
+	// retry attempts.
+	LogSeverityDebug LogSeverity = iota
+
+	// LogSeverityInfo is used for informational entries.
+	LogSeverityInfo
+
+	// LogSeverityWarn is used for unexpected but recoverable conditions.
+	LogSeverityWarn
+
+	// LogSeverityError is used for failures the SDK could not recover from.
+	LogSeverityError
+)
+
+// String returns the upper case name of the severity.
+func (s LogSeverity) String() string {
+	switch s {
+	case LogSeverityDebug:
+		return "DEBUG"
+	case LogSeverityInfo:
+		return "INFO"
+	case LogSeverityWarn:
+		return "WARNING"
+	case LogSeverityError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LogSeverity(%d)", uint(s))
+	}
+}
+
+// Keys of the fields the SDK attaches to request scoped structured log
+// entries.
+const (
+	LogFieldService   = "service"
+	LogFieldOperation = "operation"
+	LogFieldRequestID = "request_id"
+	LogFieldAttempt   = "attempt"
+	LogFieldLatency   = "latency"
+	LogFieldError     = "error"
+	LogFieldHandler   = "handler"
+)
+
+// A LogField is a key/value pair attached to a structured log entry.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// A StructuredLogger is a logger that receives the severity, message and
+// key/value fields of a log entry separately instead of a pre-formatted
+// string. A Logger set on aws.Config which also implements StructuredLogger
+// will be used by the SDK to emit structured entries.
+type StructuredLogger interface {
+	LogWithFields(severity LogSeverity, msg string, fields ...LogField)
+}
+
+// A StructuredLoggerFunc is a convenience type to wrap a function so the
+// StructuredLogger interface can be used. StructuredLoggerFunc also satisfies
+// the Logger interface so it can be set as the aws.Config.Logger.
+//
+// Example:
+//     s3.New(sess, &aws.Config{Logger: aws.StructuredLoggerFunc(
+//         func(s aws.LogSeverity, msg string, fields ...aws.LogField) {
+//             fmt.Fprintln(os.Stdout, s, msg, fields)
+//         })})
+type StructuredLoggerFunc func(LogSeverity, string, ...LogField)
+
+// Log calls the wrapped function with the arguments formatted as the message
+// of a LogSeverityInfo entry without fields.
+func (f StructuredLoggerFunc) Log(args ...interface{}) {
+	f(LogSeverityInfo, strings.TrimSuffix(fmt.Sprintln(args...), "\n"))
+}
+
+// LogWithFields calls the wrapped function with the arguments provided.
+func (f StructuredLoggerFunc) LogWithFields(severity LogSeverity, msg string, fields ...LogField) {
+	f(severity, msg, fields...)
+}
+
+// NewStructuredLogger returns a StructuredLogger for the Logger provided. If
+// the logger already implements StructuredLogger it will be returned directly,
+// otherwise it will be wrapped with an adapter formatting each entry as a
+// single free text log message. Returns nil if the logger is nil.
+//
+// The adapter formats entries as the severity and message followed by the
+// fields as key=value pairs. If the message spans multiple lines the fields
+// are placed at the end of the first line.
+func NewStructuredLogger(logger Logger) StructuredLogger {
+	switch l := logger.(type) {
+	case nil:
+		return nil
+	case StructuredLogger:
+		return l
+	default:
+		return loggerAdapter{logger: l}
+	}
+}
+
+// LogWithFields writes the structured entry to the logger. The entry is
+// dropped if the logger is nil.
+func LogWithFields(logger Logger, severity LogSeverity, msg string, fields ...LogField) {
+	if l := NewStructuredLogger(logger); l != nil {
+		l.LogWithFields(severity, msg, fields...)
+	}
+}
+
+// A loggerAdapter formats structured log entries for a Logger.
+type loggerAdapter struct {
+	logger Logger
+}
+
+// LogWithFields formats the log entry and writes it to the wrapped Logger.
+func (a loggerAdapter) LogWithFields(severity LogSeverity, msg string, fields ...LogField) {
+	var buf bytes.Buffer
+
+	head, rest := msg, ""
+	if i := strings.IndexByte(msg, '\n'); i >= 0 {
+		head, rest = msg[:i], msg[i:]
+	}
+
+	buf.WriteString(severity.String())
+	buf.WriteString(": ")
+	buf.WriteString(head)
+	for _, f := range fields {
+		fmt.Fprintf(&buf, " %s=%v", f.Key, f.Value)
+	}
+	buf.WriteString(rest)
+
+	a.logger.Log(buf.String())
+}
@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestNewStructuredLogger(t *testing.T) {
+	var msgs []string
+	logger := NewStructuredLogger(LoggerFunc(func(args ...interface{}) {
+		msgs = append(msgs, args[0].(string))
+	}))
+
+	logger.LogWithFields(LogSeverityDebug, "Retrying Request",
+		LogField{Key: LogFieldService, Value: "mock"},
+		LogField{Key: LogFieldAttempt, Value: 2},
+	)
+	logger.LogWithFields(LogSeverityError, "Request Details:\nbody",
+		LogField{Key: LogFieldOperation, Value: "Op"},
+	)
+
+	expect := []string{
+		"DEBUG: Retrying Request service=mock attempt=2",
+		"ERROR: Request Details: operation=Op\nbody",
+	}
+	if e, a := len(expect), len(msgs); e != a {
+		t.Fatalf("expect %v messages, got %v", e, a)
+	}
+	for i := range expect {
+		if e, a := expect[i], msgs[i]; e != a {
+			t.Errorf("%d, expect %q, got %q", i, e, a)
+		}
+	}
+}
+
+type mockStructuredLogger struct {
+	severity LogSeverity
+	msg      string
+	fields   []LogField
+}
+
+func (l *mockStructuredLogger) Log(...interface{}) {}
+func (l *mockStructuredLogger) LogWithFields(severity LogSeverity, msg string, fields ...LogField) {
+	l.severity, l.msg, l.fields = severity, msg, fields
+}
+
+func TestLogWithFields_StructuredLogger(t *testing.T) {
+	logger := &mockStructuredLogger{}
+
+	LogWithFields(logger, LogSeverityWarn, "msg", LogField{Key: "key", Value: "value"})
+
+	if e, a := LogSeverityWarn, logger.severity; e != a {
+		t.Errorf("expect %v severity, got %v", e, a)
+	}
+	if e, a := "msg", logger.msg; e != a {
+		t.Errorf("expect %v message, got %v", e, a)
+	}
+	if e, a := 1, len(logger.fields); e != a {
+		t.Fatalf("expect %v fields, got %v", e, a)
+	}
+	if e, a := (LogField{Key: "key", Value: "value"}), logger.fields[0]; e != a {
+		t.Errorf("expect %v field, got %v", e, a)
+	}
+}
+
+func TestLogWithFields_NilLogger(t *testing.T) {
+	// Must not panic
+	LogWithFields(nil, LogSeverityError, "msg")
+}
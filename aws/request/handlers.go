@@ -3,6 +3,8 @@ package request
 import (
 	"fmt"
 	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
 )
 
 // A Handlers provides a collection of request handlers for various
@@ -278,11 +280,18 @@ func (l *HandlerList) Run(r *Request) {
 // request's Error value. Always returns true to continue iterating
 // request handlers in a HandlerList.
 func HandlerListLogItem(item HandlerListRunItem) bool {
-	if item.Request.Config.Logger == nil {
+	r := item.Request
+	if r.Config.Logger == nil {
 		return true
 	}
-	item.Request.Config.Logger.Log("DEBUG: RequestHandler",
-		item.Index, item.Handler.Name, item.Request.Error)
+
+	fields := append(r.LogFields(),
+		aws.LogField{Key: aws.LogFieldHandler, Value: item.Handler.Name},
+		aws.LogField{Key: "handler_index", Value: item.Index})
+	if r.Error != nil {
+		fields = append(fields, aws.LogField{Key: aws.LogFieldError, Value: r.Error})
+	}
+	aws.LogWithFields(r.Config.Logger, aws.LogSeverityDebug, "Request handler", fields...)
 
 	return true
 }
@@ -157,20 +157,35 @@ func TestLoggedHandlers(t *testing.T) {
 	l := request.HandlerList{}
 	loggedHandlers := []string{}
 	l.AfterEachFn = request.HandlerListLogItem
-	cfg := aws.Config{Logger: aws.LoggerFunc(func(args ...interface{}) {
-		loggedHandlers = append(loggedHandlers, args[2].(string))
-	})}
+	var operations []interface{}
+	cfg := aws.Config{Logger: aws.StructuredLoggerFunc(
+		func(s aws.LogSeverity, msg string, fields ...aws.LogField) {
+			if e, a := aws.LogSeverityDebug, s; e != a {
+				t.Errorf("expect %v severity, got %v", e, a)
+			}
+			for _, f := range fields {
+				switch f.Key {
+				case aws.LogFieldHandler:
+					loggedHandlers = append(loggedHandlers, f.Value.(string))
+				case aws.LogFieldOperation:
+					operations = append(operations, f.Value)
+				}
+			}
+		})}
 
 	named1 := request.NamedHandler{Name: "name1", Fn: func(r *request.Request) {}}
 	named2 := request.NamedHandler{Name: "name2", Fn: func(r *request.Request) {}}
 	l.PushBackNamed(named1)
 	l.PushBackNamed(named2)
-	l.Run(&request.Request{Config: cfg})
+	l.Run(&request.Request{Config: cfg, Operation: &request.Operation{Name: "Op"}})
 
 	if !reflect.DeepEqual(expectedHandlers, loggedHandlers) {
 		t.Errorf("expect handlers executed %v to match logged handlers, %v",
 			expectedHandlers, loggedHandlers)
 	}
+	if e, a := []interface{}{"Op", "Op"}, operations; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v logged operations, got %v", e, a)
+	}
 }
 
 func TestStopHandlers(t *testing.T) {
@@ -253,6 +253,25 @@ func (r *Request) WillRetry() bool {
 	return r.Error != nil && aws.BoolValue(r.Retryable) && r.RetryCount < r.MaxRetries()
 }
 
+// LogFields returns the request scoped fields that should be attached to
+// structured log entries about the request. The attempt is one based, and the
+// latency is only included once the current attempt has been sent.
+func (r *Request) LogFields() []aws.LogField {
+	fields := []aws.LogField{
+		{Key: aws.LogFieldService, Value: r.ClientInfo.ServiceName},
+		{Key: aws.LogFieldOperation, Value: r.Operation.Name},
+		{Key: aws.LogFieldAttempt, Value: r.RetryCount + 1},
+	}
+	if len(r.RequestID) != 0 {
+		fields = append(fields, aws.LogField{Key: aws.LogFieldRequestID, Value: r.RequestID})
+	}
+	if !r.AttemptTime.IsZero() {
+		fields = append(fields, aws.LogField{Key: aws.LogFieldLatency, Value: time.Since(r.AttemptTime)})
+	}
+
+	return fields
+}
+
 func fmtAttemptCount(retryCount, maxRetries int) string {
 	return fmt.Sprintf("attempt %v/%v", retryCount, maxRetries)
 }
@@ -383,8 +402,9 @@ func debugLogReqError(r *Request, stage, retryStr string, err error) {
 		return
 	}
 
-	r.Config.Logger.Log(fmt.Sprintf("DEBUG: %s %s/%s failed, %s, error %v",
-		stage, r.ClientInfo.ServiceName, r.Operation.Name, retryStr, err))
+	aws.LogWithFields(r.Config.Logger, aws.LogSeverityDebug,
+		fmt.Sprintf("%s failed, %s", stage, retryStr),
+		append(r.LogFields(), aws.LogField{Key: aws.LogFieldError, Value: err})...)
 }
 
 // Build will build the request's object so it can be signed and sent
@@ -547,8 +567,9 @@ func (r *Request) Send() error {
 
 func (r *Request) prepareRetry() error {
 	if r.Config.LogLevel.Matches(aws.LogDebugWithRequestRetries) {
-		r.Config.Logger.Log(fmt.Sprintf("DEBUG: Retrying Request %s/%s, attempt %d",
-			r.ClientInfo.ServiceName, r.Operation.Name, r.RetryCount))
+		aws.LogWithFields(r.Config.Logger, aws.LogSeverityDebug,
+			"Retrying Request", append(r.LogFields(),
+				aws.LogField{Key: "retry_delay", Value: r.RetryDelay})...)
 	}
 
 	// The previous http.Request will have a reference to the r.Body
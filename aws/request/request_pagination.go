@@ -1,10 +1,12 @@
 package request
 
 import (
+	"fmt"
 	"reflect"
 	"sync/atomic"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 )
 
@@ -117,6 +119,92 @@ func (p *Pagination) Next() bool {
 	return true
 }
 
+const (
+	// ErrCodeNoMorePages is the error code of the error returned when the next
+	// page is requested from a PageIterator without more pages.
+	ErrCodeNoMorePages = "NoMorePages"
+
+	// ErrCodeRepeatedPageToken is the error code of the error returned when an
+	// API operation returns the same pagination tokens it was requested with.
+	// Continuing to request pages with the same tokens would not end.
+	ErrCodeRepeatedPageToken = "RepeatedPageToken"
+)
+
+// A PageIterator retrieves the pages of a paginated API operation one page at
+// a time. Generally you should not use this type directly, but use the
+// generated paginator types of the service clients, such as
+// "dynamodb.ListTablesPaginator".
+//
+// The zero value of PageIterator is ready to retrieve the first page.
+type PageIterator struct {
+	// EndPageOnSameToken, when enabled, ends the pagination when the API
+	// operation returns the same tokens as the page was requested with,
+	// instead of returning an error.
+	EndPageOnSameToken bool
+
+	started    bool
+	done       bool
+	pages      int
+	nextTokens []interface{}
+}
+
+// HasMorePages returns if the API operation has more pages. The limit is the
+// maximum number of pages to retrieve, zero for no limit. Will always return
+// true if a page has not been retrieved, and the limit allows it.
+func (it *PageIterator) HasMorePages(limit int) bool {
+	if limit > 0 && it.pages >= limit {
+		return false
+	}
+	return !it.started || (!it.done && len(it.nextTokens) != 0)
+}
+
+// Next sends the request of the next page returned by newRequest, and returns
+// the page's output. The request's input tokens are set to the tokens of the
+// previous page.
+//
+// If the request fails, the error is returned and Next can be called again
+// to retry the page. Returns an error with the code ErrCodeNoMorePages if
+// there are no more pages. If the API operation returned the same tokens as
+// the page was requested with, the page is returned along with an error with
+// the code ErrCodeRepeatedPageToken.
+func (it *PageIterator) Next(limit int, newRequest func() (*Request, error)) (interface{}, error) {
+	if !it.HasMorePages(limit) {
+		return nil, awserr.New(ErrCodeNoMorePages, "no more pages available", nil)
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	if it.started {
+		for i, intok := range req.Operation.InputTokens {
+			awsutil.SetValueAtPath(req.Params, intok, it.nextTokens[i])
+		}
+	}
+
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+
+	prevTokens := it.nextTokens
+	it.started = true
+	it.pages++
+	it.nextTokens = req.nextPageTokens()
+
+	if len(prevTokens) != 0 && len(it.nextTokens) != 0 &&
+		awsutil.DeepEqual(it.nextTokens, prevTokens) {
+		it.done = true
+		if !it.EndPageOnSameToken {
+			return req.Data, awserr.New(ErrCodeRepeatedPageToken,
+				fmt.Sprintf("%s returned the same pagination tokens the page was requested with",
+					req.Operation.Name), nil)
+		}
+	}
+
+	return req.Data, nil
+}
+
 // A Paginator is the configuration data that defines how an API operation
 // should be paginated. This type is used by the API service models to define
 // the generated pagination config for service APIs.
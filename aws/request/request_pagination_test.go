@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/awstesting"
 	"github.com/aws/aws-sdk-go/awstesting/unit"
@@ -701,3 +702,156 @@ func BenchmarkEachPageIterator(b *testing.B) {
 		})
 	}
 }
+
+func newPaginatorTestClient(t *testing.T, resps []*dynamodb.ListTablesOutput, tokens *[]string) *dynamodb.DynamoDB {
+	db := dynamodb.New(unit.Session)
+	db.Handlers.Send.Clear() // mock sending
+	db.Handlers.Unmarshal.Clear()
+	db.Handlers.UnmarshalMeta.Clear()
+	db.Handlers.ValidateResponse.Clear()
+	db.Handlers.Build.PushBack(func(r *request.Request) {
+		in := r.Params.(*dynamodb.ListTablesInput)
+		*tokens = append(*tokens, aws.StringValue(in.ExclusiveStartTableName))
+	})
+	db.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		i := len(*tokens) - 1
+		if i >= len(resps) {
+			t.Fatalf("expect no more than %d requests", len(resps))
+		}
+		r.Data = resps[i]
+	})
+	return db
+}
+
+func TestPaginator(t *testing.T) {
+	var tokens []string
+	db := newPaginatorTestClient(t, []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1"), aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+		{TableNames: []*string{aws.String("Table3"), aws.String("Table4")}, LastEvaluatedTableName: aws.String("Table4")},
+		{TableNames: []*string{aws.String("Table5")}},
+	}, &tokens)
+
+	params := &dynamodb.ListTablesInput{Limit: aws.Int64(2)}
+	p := dynamodb.NewListTablesPaginator(db, params)
+
+	var pages []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(aws.BackgroundContext())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		pages = append(pages, aws.StringValueSlice(page.TableNames)...)
+	}
+
+	if e, a := []string{"", "Table2", "Table4"}, tokens; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+	if e, a := []string{"Table1", "Table2", "Table3", "Table4", "Table5"}, pages; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v pages, got %v", e, a)
+	}
+	if params.ExclusiveStartTableName != nil {
+		t.Errorf("expect input not to be modified, got %v", *params.ExclusiveStartTableName)
+	}
+
+	_, err := p.NextPage(aws.BackgroundContext())
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.ErrCodeNoMorePages, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestPaginator_PageLimit(t *testing.T) {
+	var tokens []string
+	db := newPaginatorTestClient(t, []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1")}, LastEvaluatedTableName: aws.String("Table1")},
+		{TableNames: []*string{aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table2")},
+	}, &tokens)
+
+	p := dynamodb.NewListTablesPaginator(db, nil)
+	p.PageLimit = 1
+
+	if _, err := p.NextPage(aws.BackgroundContext()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if p.HasMorePages() {
+		t.Errorf("expect no more pages after page limit")
+	}
+	if e, a := 1, len(tokens); e != a {
+		t.Errorf("expect %v requests, got %v", e, a)
+	}
+}
+
+func TestPaginator_RepeatedToken(t *testing.T) {
+	resps := []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1")}, LastEvaluatedTableName: aws.String("Table1")},
+		{TableNames: []*string{aws.String("Table2")}, LastEvaluatedTableName: aws.String("Table1")},
+	}
+
+	var tokens []string
+	p := dynamodb.NewListTablesPaginator(newPaginatorTestClient(t, resps, &tokens), nil)
+	if _, err := p.NextPage(aws.BackgroundContext()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	page, err := p.NextPage(aws.BackgroundContext())
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.ErrCodeRepeatedPageToken, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if page == nil {
+		t.Fatalf("expect page, got none")
+	}
+	if e, a := "Table2", aws.StringValue(page.TableNames[0]); e != a {
+		t.Errorf("expect %v table, got %v", e, a)
+	}
+	if p.HasMorePages() {
+		t.Errorf("expect no more pages after repeated token")
+	}
+}
+
+func TestPaginator_RetryPage(t *testing.T) {
+	var tokens []string
+	db := newPaginatorTestClient(t, []*dynamodb.ListTablesOutput{
+		{TableNames: []*string{aws.String("Table1")}, LastEvaluatedTableName: aws.String("Table1")},
+		nil,
+		{TableNames: []*string{aws.String("Table2")}},
+	}, &tokens)
+
+	var applied int
+	p := dynamodb.NewListTablesPaginator(db, nil, func(r *request.Request) {
+		applied++
+	})
+
+	if _, err := p.NextPage(aws.BackgroundContext()); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	failPage := func(r *request.Request) {
+		r.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+			r.Error = awserr.New("ValidationException", "failed", nil)
+		})
+	}
+	if _, err := p.NextPage(aws.BackgroundContext(), failPage); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	page, err := p.NextPage(aws.BackgroundContext())
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []string{"Table2"}, aws.StringValueSlice(page.TableNames); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v page, got %v", e, a)
+	}
+	if e, a := []string{"", "Table1", "Table1"}, tokens; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tokens, got %v", e, a)
+	}
+	if e, a := 3, applied; e != a {
+		t.Errorf("expect options applied to %v requests, got %v", e, a)
+	}
+	if p.HasMorePages() {
+		t.Errorf("expect no more pages")
+	}
+}
@@ -38,9 +38,8 @@ type Retryer interface {
 // value for chaining. The value must not be nil.
 func WithRetryer(cfg *aws.Config, retryer Retryer) *aws.Config {
 	if retryer == nil {
-		if cfg.Logger != nil {
-			cfg.Logger.Log("ERROR: Request.WithRetryer called with nil retryer. Replacing with retry disabled Retryer.")
-		}
+		aws.LogWithFields(cfg.Logger, aws.LogSeverityError,
+			"Request.WithRetryer called with nil retryer. Replacing with retry disabled Retryer.")
 		retryer = noOpRetryer{}
 	}
 	cfg.Retryer = retryer
@@ -1,12 +1,13 @@
 package request
 
 import (
-	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/internal/sdkrand"
 )
 
 // WaiterResourceNotReadyErrorCode is the error code returned by a waiter when
@@ -42,6 +43,27 @@ func ConstantWaiterDelay(delay time.Duration) WaiterDelay {
 	}
 }
 
+// ExponentialJitterWaiterDelay returns a WaiterDelay that will delay the
+// waiter between attempts with a jittered exponential backoff. The delay is a
+// random duration between minDelay, and minDelay doubled for each previous
+// attempt, up to maxDelay.
+func ExponentialJitterWaiterDelay(minDelay, maxDelay time.Duration) WaiterDelay {
+	return func(attempt int) time.Duration {
+		delay := minDelay
+		for i := 1; i < attempt && delay > 0 && delay < maxDelay; i++ {
+			delay *= 2
+		}
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+		if delay <= minDelay {
+			return delay
+		}
+
+		return minDelay + time.Duration(sdkrand.SeededRand.Int63n(int64(delay-minDelay)+1))
+	}
+}
+
 // WithWaiterDelay will set the Waiter to use the WaiterDelay passed in.
 func WithWaiterDelay(delayer WaiterDelay) WaiterOption {
 	return func(w *Waiter) {
@@ -171,7 +193,8 @@ func (w Waiter) WaitWithContext(ctx aws.Context) error {
 	for attempt := 1; ; attempt++ {
 		req, err := w.NewRequest(w.RequestOptions)
 		if err != nil {
-			waiterLogf(w.Logger, "unable to create request %v", err)
+			waiterLog(w.Logger, aws.LogSeverityError, "unable to create request", w.Name,
+				aws.LogField{Key: aws.LogFieldError, Value: err})
 			return err
 		}
 		req.Handlers.Build.PushBack(MakeAddToUserAgentFreeFormHandler("Waiter"))
@@ -236,7 +259,7 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 		}
 		result = true
 		for _, val := range vals {
-			if !awsutil.DeepEqual(val, a.Expected) {
+			if !waiterValueEqual(val, a.Expected) {
 				result = false
 				break
 			}
@@ -245,7 +268,7 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 		// Only a single match needs to equal for the result to match
 		vals, _ = awsutil.ValuesAtPath(req.Data, a.Argument)
 		for _, val := range vals {
-			if awsutil.DeepEqual(val, a.Expected) {
+			if waiterValueEqual(val, a.Expected) {
 				result = true
 				break
 			}
@@ -260,8 +283,8 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 			result = aerr.Code() == a.Expected.(string)
 		}
 	default:
-		waiterLogf(l, "WARNING: Waiter %s encountered unexpected matcher: %s",
-			name, a.Matcher)
+		waiterLog(l, aws.LogSeverityWarn, "Waiter encountered unexpected matcher", name,
+			aws.LogField{Key: "matcher", Value: a.Matcher})
 	}
 
 	if !result {
@@ -282,14 +305,40 @@ func (a *WaiterAcceptor) match(name string, l aws.Logger, req *Request, err erro
 		// clear the error and retry the operation
 		return false, nil
 	default:
-		waiterLogf(l, "WARNING: Waiter %s encountered unexpected state: %s",
-			name, a.State)
+		waiterLog(l, aws.LogSeverityWarn, "Waiter encountered unexpected state", name,
+			aws.LogField{Key: "state", Value: a.State})
 		return false, nil
 	}
 }
 
-func waiterLogf(logger aws.Logger, msg string, args ...interface{}) {
-	if logger != nil {
-		logger.Log(fmt.Sprintf(msg, args...))
+// waiterValueEqual returns if the value matches the acceptor's expected value.
+// Numeric values of different types, such as an int64 member and an expected
+// value decoded from JSON as float64, are compared by value.
+func waiterValueEqual(val, expected interface{}) bool {
+	if awsutil.DeepEqual(val, expected) {
+		return true
 	}
+
+	v, vOK := waiterNumber(val)
+	e, eOK := waiterNumber(expected)
+	return vOK && eOK && v == e
+}
+
+func waiterNumber(v interface{}) (float64, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func waiterLog(logger aws.Logger, severity aws.LogSeverity, msg, name string, fields ...aws.LogField) {
+	aws.LogWithFields(logger, severity, msg,
+		append([]aws.LogField{{Key: "waiter", Value: name}}, fields...)...)
 }
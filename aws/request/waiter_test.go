@@ -676,3 +676,74 @@ func TestWaiterWithContextNilInput(t *testing.T) {
 		t.Fatalf("expect no error, but got %v", err)
 	}
 }
+
+func TestWaiterPathNumber(t *testing.T) {
+	svc := &mockClient{Client: awstesting.NewClient(&aws.Config{
+		Region: aws.String("mock-region"),
+	})}
+	svc.Handlers.Send.Clear() // mock sending
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.ValidateResponse.Clear()
+
+	reqNum := 0
+	resps := []*MockOutput{
+		{States: []*MockState{{State: aws.String("pending")}}},
+		{States: []*MockState{{State: aws.String("pending")}, {State: aws.String("running")}}},
+	}
+	svc.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		if reqNum >= len(resps) {
+			t.Errorf("too many polling requests made")
+			return
+		}
+		r.Data = resps[reqNum]
+		reqNum++
+	})
+
+	w := request.Waiter{
+		MaxAttempts: 10,
+		Delay:       request.ConstantWaiterDelay(0),
+		Acceptors: []request.WaiterAcceptor{
+			{
+				State:    request.SuccessWaiterState,
+				Matcher:  request.PathWaiterMatch,
+				Argument: "length(States[])",
+				Expected: 2,
+			},
+		},
+		NewRequest: BuildNewMockRequest(svc, &MockInput{}),
+	}
+
+	err := w.WaitWithContext(aws.BackgroundContext())
+	if err != nil {
+		t.Errorf("expect nil, %v", err)
+	}
+	if e, a := 2, reqNum; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestExponentialJitterWaiterDelay(t *testing.T) {
+	delay := request.ExponentialJitterWaiterDelay(2*time.Second, 30*time.Second)
+
+	cases := []struct {
+		Attempt  int
+		Min, Max time.Duration
+	}{
+		{Attempt: 1, Min: 2 * time.Second, Max: 2 * time.Second},
+		{Attempt: 2, Min: 2 * time.Second, Max: 4 * time.Second},
+		{Attempt: 4, Min: 2 * time.Second, Max: 16 * time.Second},
+		{Attempt: 5, Min: 2 * time.Second, Max: 30 * time.Second},
+		{Attempt: 100, Min: 2 * time.Second, Max: 30 * time.Second},
+	}
+
+	for _, c := range cases {
+		for i := 0; i < 100; i++ {
+			d := delay(c.Attempt)
+			if d < c.Min || d > c.Max {
+				t.Fatalf("expect attempt %v delay between %v and %v, got %v",
+					c.Attempt, c.Min, c.Max, d)
+			}
+		}
+	}
+}
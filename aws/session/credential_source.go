@@ -0,0 +1,85 @@
+package session
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// A CredentialSourceFunc returns the credentials of a credential_source. The
+// role of the profile setting the credential_source is assumed with the
+// credentials.
+type CredentialSourceFunc func(CredentialSourceInput) (*credentials.Credentials, error)
+
+// CredentialSourceInput is the input of a CredentialSourceFunc.
+type CredentialSourceInput struct {
+	// The name of the credential_source, and the profile it was set in.
+	Name    string
+	Profile string
+
+	// The config and handlers of the session being created. Service clients
+	// the source's provider uses, such as an STS client, can be created from
+	// them:
+	//
+	//    svc := sts.New(&session.Session{
+	//        Config:   in.Config,
+	//        Handlers: in.Handlers,
+	//    })
+	Config   *aws.Config
+	Handlers request.Handlers
+}
+
+var credentialSources = struct {
+	sync.RWMutex
+	fns map[string]CredentialSourceFunc
+}{
+	fns: map[string]CredentialSourceFunc{},
+}
+
+// RegisterCredentialSource registers the function returning the credentials
+// of the credential_source name, for all sessions. Registered sources take
+// precedence over the built-in sources of the same name, and the credential
+// sources of the session Options take precedence over registered sources.
+//
+// RegisterCredentialSource panics if the name is empty or already
+// registered, or the function is nil. It is intended to be called from an
+// init function.
+//
+//    func init() {
+//        session.RegisterCredentialSource("Vault",
+//            func(in session.CredentialSourceInput) (*credentials.Credentials, error) {
+//                return credentials.NewCredentials(&vaultProvider{}), nil
+//            })
+//    }
+func RegisterCredentialSource(name string, fn CredentialSourceFunc) {
+	if len(name) == 0 {
+		panic("session: credential source name must not be empty")
+	}
+	if fn == nil {
+		panic("session: credential source " + name + " function must not be nil")
+	}
+
+	credentialSources.Lock()
+	defer credentialSources.Unlock()
+
+	if _, ok := credentialSources.fns[name]; ok {
+		panic("session: credential source " + name + " already registered")
+	}
+	credentialSources.fns[name] = fn
+}
+
+// lookupCredentialSource returns the function of the custom credential
+// source, from the session options or the registered sources.
+func lookupCredentialSource(opts Options, name string) (CredentialSourceFunc, bool) {
+	if fn, ok := opts.CredentialSources[name]; ok && fn != nil {
+		return fn, true
+	}
+
+	credentialSources.RLock()
+	defer credentialSources.RUnlock()
+
+	fn, ok := credentialSources.fns[name]
+	return fn, ok
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 func resolveCredentials(cfg *aws.Config,
@@ -25,7 +26,7 @@ func resolveCredentials(cfg *aws.Config,
 		// User explicitly provided an Profile in the session's configuration
 		// so load that profile from shared config first.
 		// Github(aws/aws-sdk-go#2727)
-		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, sessOpts)
+		return resolveCredsFromProfile(cfg, envCfg, envCfg.Profile, sharedCfg, handlers, sessOpts)
 
 	case envCfg.Creds.HasKeys():
 		// Environment credentials
@@ -42,7 +43,7 @@ func resolveCredentials(cfg *aws.Config,
 
 	default:
 		// Fallback to the "default" credential resolution chain.
-		return resolveCredsFromProfile(cfg, envCfg, sharedCfg, handlers, sessOpts)
+		return resolveCredsFromProfile(cfg, envCfg, envCfg.Profile, sharedCfg, handlers, sessOpts)
 	}
 }
 
@@ -81,7 +82,7 @@ func assumeWebIdentity(cfg *aws.Config, handlers request.Handlers,
 }
 
 func resolveCredsFromProfile(cfg *aws.Config,
-	envCfg envConfig, sharedCfg sharedConfig,
+	envCfg envConfig, profile string, sharedCfg sharedConfig,
 	handlers request.Handlers,
 	sessOpts Options,
 ) (creds *credentials.Credentials, err error) {
@@ -90,14 +91,17 @@ func resolveCredsFromProfile(cfg *aws.Config,
 	case sharedCfg.SourceProfile != nil:
 		// Assume IAM role with credentials source from a different profile.
 		creds, err = resolveCredsFromProfile(cfg, envCfg,
-			*sharedCfg.SourceProfile, handlers, sessOpts,
+			sharedCfg.SourceProfileName, *sharedCfg.SourceProfile,
+			handlers, sessOpts,
 		)
 
 	case sharedCfg.Creds.HasKeys():
 		// Static Credentials from Shared Config/Credentials file.
-		creds = credentials.NewStaticCredentialsFromCreds(
-			sharedCfg.Creds,
-		)
+		value := sharedCfg.Creds
+		if value.Source.Filename == envCfg.SharedCredentialsFile {
+			value.Source.Kind = credentials.SourceSharedCredentialsFile
+		}
+		creds = credentials.NewStaticCredentialsFromCreds(value)
 
 	case len(sharedCfg.CredentialProcess) != 0:
 		// Get credentials from CredentialProcess
@@ -105,7 +109,7 @@ func resolveCredsFromProfile(cfg *aws.Config,
 
 	case len(sharedCfg.CredentialSource) != 0:
 		creds, err = resolveCredsFromSource(cfg, envCfg,
-			sharedCfg, handlers, sessOpts,
+			profile, sharedCfg, handlers, sessOpts,
 		)
 
 	case len(sharedCfg.WebIdentityTokenFile) != 0:
@@ -152,17 +156,41 @@ func resolveCredsFromProfile(cfg *aws.Config,
 
 // valid credential source values
 const (
-	credSourceEc2Metadata  = "Ec2InstanceMetadata"
-	credSourceEnvironment  = "Environment"
-	credSourceECSContainer = "EcsContainer"
+	credSourceEc2Metadata    = "Ec2InstanceMetadata"
+	credSourceEnvironment    = "Environment"
+	credSourceECSContainer   = "EcsContainer"
+	credSourceK8sWebIdentity = "KubernetesWebIdentity"
 )
 
+// k8sWebIdentityTokenFile is the default path of the web identity token
+// projected into Kubernetes pods by EKS, used by the KubernetesWebIdentity
+// credential source if AWS_WEB_IDENTITY_TOKEN_FILE is not set.
+const k8sWebIdentityTokenFile = "/var/run/secrets/eks.amazonaws.com/serviceaccount/token"
+
 func resolveCredsFromSource(cfg *aws.Config,
-	envCfg envConfig, sharedCfg sharedConfig,
+	envCfg envConfig, profile string, sharedCfg sharedConfig,
 	handlers request.Handlers,
 	sessOpts Options,
 ) (creds *credentials.Credentials, err error) {
 
+	// Custom credential sources take precedence over the built-in sources.
+	if fn, ok := lookupCredentialSource(sessOpts, sharedCfg.CredentialSource); ok {
+		if len(profile) == 0 {
+			profile = DefaultSharedConfigProfile
+		}
+		creds, err = fn(CredentialSourceInput{
+			Name:     sharedCfg.CredentialSource,
+			Profile:  profile,
+			Config:   cfg,
+			Handlers: handlers.Copy(),
+		})
+		if err == nil && creds == nil {
+			err = awserr.New(ErrCodeSharedConfig, fmt.Sprintf(
+				"credential source %s returned no credentials", sharedCfg.CredentialSource), nil)
+		}
+		return creds, err
+	}
+
 	switch sharedCfg.CredentialSource {
 	case credSourceEc2Metadata:
 		p := defaults.RemoteCredProvider(*cfg, handlers)
@@ -179,11 +207,24 @@ func resolveCredsFromSource(cfg *aws.Config,
 		p := defaults.RemoteCredProvider(*cfg, handlers)
 		creds = credentials.NewCredentials(p)
 
+	case credSourceK8sWebIdentity:
+		// Assume the pod's role, AWS_ROLE_ARN, with the web identity token
+		// projected into the pod.
+		tokenFile := envCfg.WebIdentityTokenFilePath
+		if len(tokenFile) == 0 {
+			tokenFile = k8sWebIdentityTokenFile
+		}
+		creds, err = assumeWebIdentity(cfg, handlers,
+			tokenFile,
+			envCfg.RoleARN,
+			envCfg.RoleSessionName,
+		)
+
 	default:
 		return nil, ErrSharedConfigInvalidCredSource
 	}
 
-	return creds, nil
+	return creds, err
 }
 
 func credsFromAssumeRole(cfg aws.Config,
@@ -206,13 +247,32 @@ func credsFromAssumeRole(cfg aws.Config,
 		sharedCfg.RoleARN,
 		func(opt *stscreds.AssumeRoleProvider) {
 			opt.RoleSessionName = sharedCfg.RoleSessionName
+
+			// The session option's duration takes precedence over the
+			// profile's duration.
 			opt.Duration = sessOpts.AssumeRoleDuration
+			if opt.Duration == 0 {
+				opt.Duration = sharedCfg.RoleDuration
+			}
 
 			// Assume role with external ID
 			if len(sharedCfg.ExternalID) > 0 {
 				opt.ExternalID = aws.String(sharedCfg.ExternalID)
 			}
 
+			// Assume role with session tags, managed session policies, and
+			// source identity. Each role of a source_profile chain is
+			// assumed with the options of its own profile.
+			opt.Tags = sharedCfg.RoleSessionTags
+			if len(sharedCfg.TransitiveTagKeys) > 0 {
+				opt.TransitiveTagKeys = aws.StringSlice(sharedCfg.TransitiveTagKeys)
+			}
+			for _, arn := range sharedCfg.PolicyARNs {
+				opt.PolicyArns = append(opt.PolicyArns, &sts.PolicyDescriptorType{
+					Arn: aws.String(arn),
+				})
+			}
+
 			// Assume role with MFA
 			if len(sharedCfg.MFASerial) > 0 {
 				opt.SerialNumber = aws.String(sharedCfg.MFASerial)
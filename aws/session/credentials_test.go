@@ -50,8 +50,12 @@ func setupCredentialsEndpoints(t *testing.T) (endpoints.Resolver, func()) {
 
 	stsServer := httptest.NewServer(http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
+			respMsg := assumeRoleRespMsg
+			if r.FormValue("Action") == "AssumeRoleWithWebIdentity" {
+				respMsg = assumeRoleWithWebIdentityRespMsg
+			}
 			w.Write([]byte(fmt.Sprintf(
-				assumeRoleRespMsg,
+				respMsg,
 				time.Now().
 					Add(15*time.Minute).
 					Format("2006-01-02T15:04:05Z"))))
@@ -94,6 +98,7 @@ func TestSharedConfigCredentialSource(t *testing.T) {
 		expectedAccessKey string
 		expectedSecretKey string
 		expectedChain     []string
+		sessOptSources    map[string]CredentialSourceFunc
 		init              func()
 		dependentOnOS     bool
 	}{
@@ -150,6 +155,49 @@ func TestSharedConfigCredentialSource(t *testing.T) {
 				"assume_role_w_creds_role_arn_ec2",
 			},
 		},
+		{
+			name:              "kubernetes web identity credential source",
+			sessOptProfile:    "k8s_web_identity",
+			expectedAccessKey: "AKID",
+			expectedSecretKey: "SECRET",
+			expectedChain: []string{
+				"assume_role_w_creds_role_arn_k8s",
+			},
+			init: func() {
+				os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "testdata/web_identity_token")
+				os.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/k8s_pod_role")
+			},
+		},
+		{
+			name:           "kubernetes web identity credential source no role",
+			sessOptProfile: "k8s_web_identity",
+			expectedError:  WebIdentityEmptyRoleARNErr,
+			init: func() {
+				os.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", "testdata/web_identity_token")
+			},
+		},
+		{
+			name:              "custom credential source",
+			profile:           "custom_credential_source",
+			expectedAccessKey: "AKID",
+			expectedSecretKey: "SECRET",
+			expectedChain: []string{
+				"assume_role_w_creds_role_arn_custom",
+			},
+			sessOptSources: map[string]CredentialSourceFunc{
+				"Custom": func(in CredentialSourceInput) (*credentials.Credentials, error) {
+					if e, a := "custom_credential_source", in.Profile; e != a {
+						return nil, fmt.Errorf("expect %v profile, got %v", e, a)
+					}
+					return credentials.NewStaticCredentials("custom_akid", "custom_secret", ""), nil
+				},
+			},
+		},
+		{
+			name:          "unknown credential source",
+			profile:       "custom_credential_source",
+			expectedError: ErrSharedConfigInvalidCredSource,
+		},
 		{
 			name:              "credential process with no ARN set",
 			profile:           "cred_proc_no_arn_set",
@@ -214,7 +262,8 @@ func TestSharedConfigCredentialSource(t *testing.T) {
 			})
 
 			sess, err := NewSessionWithOptions(Options{
-				Profile: c.sessOptProfile,
+				Profile:           c.sessOptProfile,
+				CredentialSources: c.sessOptSources,
 				Config: aws.Config{
 					Logger:           t,
 					EndpointResolver: endpointResolver,
@@ -289,6 +338,78 @@ const assumeRoleRespMsg = `
 </AssumeRoleResponse>
 `
 
+const assumeRoleWithWebIdentityRespMsg = `
+<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::account_id:assumed-role/role/session_name</Arn>
+      <AssumedRoleId>AKID:session_name</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>WEB_IDENTITY_AKID</AccessKeyId>
+      <SecretAccessKey>WEB_IDENTITY_SECRET</SecretAccessKey>
+      <SessionToken>SESSION_TOKEN</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>request-id</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>
+`
+
+func TestRegisterCredentialSource(t *testing.T) {
+	const name = "TestRegisterCredentialSource"
+
+	fn := func(CredentialSourceInput) (*credentials.Credentials, error) {
+		return credentials.AnonymousCredentials, nil
+	}
+	RegisterCredentialSource(name, fn)
+	defer func() {
+		credentialSources.Lock()
+		delete(credentialSources.fns, name)
+		credentialSources.Unlock()
+	}()
+
+	if _, ok := lookupCredentialSource(Options{}, name); !ok {
+		t.Errorf("expect %v credential source registered", name)
+	}
+
+	optFn := func(CredentialSourceInput) (*credentials.Credentials, error) {
+		return nil, fmt.Errorf("session option source")
+	}
+	opts := Options{
+		CredentialSources: map[string]CredentialSourceFunc{name: optFn},
+	}
+	actual, ok := lookupCredentialSource(opts, name)
+	if !ok {
+		t.Fatalf("expect %v credential source", name)
+	}
+	if _, err := actual(CredentialSourceInput{}); err == nil {
+		t.Errorf("expect session option source to take precedence")
+	}
+
+	cases := map[string]struct {
+		Name string
+		Fn   CredentialSourceFunc
+	}{
+		"empty name": {Fn: fn},
+		"nil func":   {Name: "TestRegisterCredentialSourceNil"},
+		"duplicate":  {Name: name, Fn: fn},
+	}
+
+	for caseName, c := range cases {
+		t.Run(caseName, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expect panic")
+				}
+			}()
+			RegisterCredentialSource(c.Name, c.Fn)
+		})
+	}
+}
+
 func TestSessionAssumeRole(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
@@ -329,6 +450,12 @@ func TestSessionAssumeRole(t *testing.T) {
 	if e, a := "AssumeRoleProvider", creds.ProviderName; !strings.Contains(a, e) {
 		t.Errorf("expect %v, to be in %v", e, a)
 	}
+
+	expectSource := "AssumeRole(role=assume_role_w_creds_role_arn, session=assume_role_w_creds_session_name)" +
+		" <- SharedCredentialsFile(file=" + testConfigFilename + ", profile=assume_role_w_creds)"
+	if e, a := expectSource, creds.Source.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
 }
 
 func TestSessionAssumeRole_WithMFA(t *testing.T) {
@@ -523,6 +650,75 @@ func TestSessionAssumeRole_ExtendedDuration(t *testing.T) {
 	}
 }
 
+func TestSessionAssumeRole_SessionOptionsChain(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("AWS_REGION", "us-east-1")
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+	os.Setenv("AWS_PROFILE", "assume_role_session_options")
+
+	// Each role of the chain is assumed with the options of its own profile,
+	// starting with the source profile's role.
+	expectParams := []map[string]string{
+		{
+			"RoleArn":                    "assume_role_session_options_source_role_arn",
+			"Tags.member.1.Key":          "Project",
+			"Tags.member.1.Value":        "alpha",
+			"Tags.member.2.Key":          "Team",
+			"Tags.member.2.Value":        "sdk",
+			"TransitiveTagKeys.member.1": "Project",
+			"DurationSeconds":            "900",
+			"PolicyArns.member.1.arn":    "",
+			"PolicyArns.member.2.arn":    "",
+		},
+		{
+			"RoleArn":                    "assume_role_session_options_role_arn",
+			"Tags.member.1.Key":          "",
+			"TransitiveTagKeys.member.1": "",
+			"DurationSeconds":            "3600",
+			"PolicyArns.member.1.arn":    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+			"PolicyArns.member.2.arn":    "arn:aws:iam::123456789012:policy/team",
+		},
+	}
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls >= len(expectParams) {
+			t.Errorf("expect %v assume role calls, got %v", len(expectParams), calls+1)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		for k, e := range expectParams[calls] {
+			if a := r.FormValue(k); e != a {
+				t.Errorf("%d, expect %v %v, got %v", calls, k, e, a)
+			}
+		}
+		calls++
+
+		w.Write([]byte(fmt.Sprintf(
+			assumeRoleRespMsg,
+			time.Now().Add(15*time.Minute).Format("2006-01-02T15:04:05Z"))))
+	}))
+	defer server.Close()
+
+	s, err := NewSession(&aws.Config{
+		Endpoint:   aws.String(server.URL),
+		DisableSSL: aws.Bool(true),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if _, err := s.Config.Credentials.Get(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := len(expectParams), calls; e != a {
+		t.Errorf("expect %v assume role calls, got %v", e, a)
+	}
+}
+
 func TestSessionAssumeRole_WithMFA_ExtendedDuration(t *testing.T) {
 	restoreEnvFn := initSessionTestEnv()
 	defer restoreEnvFn()
@@ -0,0 +1,486 @@
+package session
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+)
+
+// A ProfileSettingSource is the source a ProfileSetting was loaded from.
+type ProfileSettingSource string
+
+// Sources of the settings of a profile description.
+const (
+	// ProfileSettingSourceEnvironment is a setting loaded from an environment
+	// variable.
+	ProfileSettingSourceEnvironment ProfileSettingSource = "Environment"
+
+	// ProfileSettingSourceSharedConfigFile is a setting loaded from a shared
+	// config or credentials file.
+	ProfileSettingSourceSharedConfigFile ProfileSettingSource = "SharedConfigFile"
+
+	// ProfileSettingSourceOptions is a setting provided by the session
+	// Options.
+	ProfileSettingSourceOptions ProfileSettingSource = "SessionOptions"
+)
+
+// describeRedactedValue replaces the value of secret settings in a profile
+// description.
+const describeRedactedValue = "<sensitive>"
+
+// A ProfileSetting is a single configuration value read by the session, and
+// where it was read from. The values of secrets such as secret access keys
+// and session tokens are redacted.
+type ProfileSetting struct {
+	// The environment variable, shared config key, or session option name.
+	Key string
+
+	// The value of the setting. Redacted for secrets.
+	Value string
+
+	// The source the setting was read from.
+	Source ProfileSettingSource
+
+	// The shared config file the setting was read from, if the source is
+	// ProfileSettingSourceSharedConfigFile.
+	Filename string
+
+	// The shared config profile the setting was read from, if the source is
+	// ProfileSettingSourceSharedConfigFile.
+	Profile string
+
+	// Overridden is set if the setting is also defined by a shared config file
+	// loaded later, whose value will be used instead.
+	Overridden bool
+}
+
+// A SharedConfigFileDescription describes a shared config file the session
+// attempted to load.
+type SharedConfigFileDescription struct {
+	// The name of the shared config file.
+	Filename string
+
+	// Loaded is set if the file was read and parsed. Files which cannot be
+	// read are skipped by the session.
+	Loaded bool
+
+	// The name of the config file which included this file, empty if the
+	// file was not loaded via an include directive.
+	IncludedBy string
+}
+
+// A CredentialProviderDescription describes a credential provider the
+// session's credentials will be retrieved with.
+type CredentialProviderDescription struct {
+	// The name of the credential provider, matches the ProviderName of the
+	// credentials.Value the provider will return.
+	ProviderName string
+
+	// The shared config profile the provider was configured by, if any.
+	Profile string
+
+	// Additional information about the provider's configuration, such as the
+	// role ARN to be assumed, or the credential process command.
+	Detail string
+}
+
+// A ProfileDescription describes the configuration the session would be
+// created with for a set of session Options, without making any requests to
+// AWS services.
+type ProfileDescription struct {
+	// The name of the shared config profile used.
+	Profile string
+
+	// SharedConfigEnabled is set if the shared config file (~/.aws/config)
+	// is loaded in addition to the shared credentials file.
+	SharedConfigEnabled bool
+
+	// The shared config files in the order they are loaded, including files
+	// loaded via include directives, followed by the config files that could
+	// not be read. Values in later files overwrite values defined in earlier
+	// files.
+	Files []SharedConfigFileDescription
+
+	// The settings read from the environment, session options, and the
+	// shared config files of the profile, its base profiles, and its source
+	// profiles.
+	Settings []ProfileSetting
+
+	// The chain of credential providers the session will use, ordered from
+	// the provider supplying the base credentials to the provider whose
+	// credentials will be used by the session. Each assume role provider in
+	// the chain uses the credentials of the provider before it.
+	CredentialProviders []CredentialProviderDescription
+
+	// Problems found with the configuration. A session created with the same
+	// Options will fail to be created, or fail to retrieve credentials.
+	Problems []error
+}
+
+// DescribeProfile loads the environment and shared config for the session
+// Options, and returns a description of the configuration and credential
+// provider chain a session created with NewSessionWithOptions would use.
+// Configuration problems such as a missing source_profile, a bad
+// credential_process, or an invalid credential_source are reported in the
+// description's Problems instead of when the credentials are first retrieved.
+//
+// DescribeProfile does not make any requests to AWS services, or retrieve
+// credentials. An error is only returned if the environment config cannot be
+// loaded.
+//
+//     desc, err := session.DescribeProfile(session.Options{
+//         Profile: "profile_name",
+//     })
+//     for _, p := range desc.CredentialProviders {
+//         fmt.Println(p.ProviderName, p.Profile, p.Detail)
+//     }
+func DescribeProfile(opts Options) (*ProfileDescription, error) {
+	envCfg, err := loadEnvConfigWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := envCfg.Profile
+	if len(profile) == 0 {
+		profile = DefaultSharedConfigProfile
+	}
+
+	desc := &ProfileDescription{
+		Profile:             profile,
+		SharedConfigEnabled: envCfg.EnableSharedConfig,
+	}
+
+	desc.addEnvSettings(envCfg)
+	if len(opts.Profile) != 0 {
+		desc.Settings = append(desc.Settings, ProfileSetting{
+			Key: "Profile", Value: opts.Profile,
+			Source: ProfileSettingSourceOptions,
+		})
+	}
+
+	userCfg := &aws.Config{}
+	userCfg.MergeIn(&opts.Config)
+
+	// The files are loaded separately from the profile to describe the
+	// settings of each file. Errors loading the files are reported by
+	// loadSessionSharedConfig, which only ignores them when
+	// NewSessionWithOptions would.
+	cfgFiles := sharedConfigFilenames(opts, envCfg)
+	files, _ := loadSharedConfigIniFiles(cfgFiles)
+	for _, f := range files {
+		desc.Files = append(desc.Files, SharedConfigFileDescription{
+			Filename:   f.Filename,
+			Loaded:     true,
+			IncludedBy: f.IncludedBy,
+		})
+	}
+	for _, filename := range cfgFiles {
+		var loaded bool
+		for _, f := range files {
+			if f.Filename == filename {
+				loaded = true
+				break
+			}
+		}
+		if !loaded {
+			desc.Files = append(desc.Files, SharedConfigFileDescription{
+				Filename: filename,
+			})
+		}
+	}
+	desc.addSharedConfigSettings(profile, files)
+
+	sharedCfg, err := loadSessionSharedConfig(envCfg, cfgFiles, userCfg)
+	if err != nil {
+		desc.Problems = append(desc.Problems, err)
+		return desc, nil
+	}
+	if len(envCfg.Profile) != 0 && !sharedConfigProfileExists(files, envCfg.Profile) {
+		// The session is created without the profile, but its credentials
+		// will fail to be retrieved.
+		desc.Problems = append(desc.Problems,
+			SharedConfigProfileNotExistsError{Profile: envCfg.Profile})
+	}
+
+	desc.describeCredentials(opts, envCfg, sharedCfg)
+
+	// Resolve the session's config the same as NewSessionWithOptions to find
+	// problems in the credential provider configuration.
+	cfg := defaults.Config()
+	handlers := opts.Handlers
+	if handlers.IsEmpty() {
+		handlers = defaults.Handlers()
+	}
+	cfg.MergeIn(userCfg)
+	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, opts); err != nil {
+		desc.Problems = append(desc.Problems, err)
+	}
+
+	return desc, nil
+}
+
+// sharedConfigProfileExists returns if the profile is set in any of the
+// files.
+func sharedConfigProfileExists(files []sharedConfigFile, profile string) bool {
+	for _, f := range files {
+		if _, ok := f.profileSection(profile); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// envSettingKeys are the environment variables included in a profile
+// description.
+var envSettingKeys = [][]string{
+	credAccessEnvKey,
+	credSecretEnvKey,
+	credSessionEnvKey,
+	regionEnvKeys,
+	profileEnvKeys,
+	sharedCredsFileEnvKey,
+	sharedConfigFileEnvKey,
+	webIdentityTokenFilePathEnvKey,
+	roleARNEnvKey,
+	roleSessionNameEnvKey,
+	enableEndpointDiscoveryEnvKey,
+	stsRegionalEndpointKey,
+	s3UsEast1RegionalEndpoint,
+	s3UseARNRegionEnvKey,
+	ec2IMDSEndpointEnvKey,
+	ec2IMDSEndpointModeEnvKey,
+	{"AWS_EC2_METADATA_DISABLED"},
+	csmEnabledEnvKey,
+	csmHostEnvKey,
+	csmPortEnvKey,
+	csmClientIDEnvKey,
+	{"AWS_SDK_LOAD_CONFIG"},
+	{"AWS_CA_BUNDLE"},
+	{shareddefaults.ECSCredsProviderEnvVar},
+	{"AWS_CONTAINER_CREDENTIALS_FULL_URI"},
+}
+
+// describeSecretKeys are the environment variables and shared config keys
+// whose values are redacted from profile descriptions.
+var describeSecretKeys = map[string]struct{}{
+	secretAccessKey:                     {},
+	sessionTokenKey:                     {},
+	"AWS_SECRET_ACCESS_KEY":             {},
+	"AWS_SECRET_KEY":                    {},
+	"AWS_SESSION_TOKEN":                 {},
+	"AWS_CONTAINER_AUTHORIZATION_TOKEN": {},
+}
+
+func describeSettingValue(key, value string) string {
+	if _, ok := describeSecretKeys[key]; ok && len(value) != 0 {
+		return describeRedactedValue
+	}
+	return value
+}
+
+func (d *ProfileDescription) addEnvSettings(envCfg envConfig) {
+	for _, keys := range envSettingKeys {
+		if !envCfg.EnableSharedConfig && len(keys) > 1 &&
+			(keys[0] == regionEnvKeys[0] || keys[0] == profileEnvKeys[0]) {
+			// Only the first region and profile environment variables are
+			// read if shared config is not enabled.
+			keys = keys[:1]
+		}
+
+		for _, k := range keys {
+			if v := os.Getenv(k); len(v) != 0 {
+				d.Settings = append(d.Settings, ProfileSetting{
+					Key:    k,
+					Value:  describeSettingValue(k, v),
+					Source: ProfileSettingSourceEnvironment,
+				})
+				break
+			}
+		}
+	}
+}
+
+// addSharedConfigSettings adds the settings of the profile, its base
+// profiles, and its chain of source profiles from the shared config files.
+func (d *ProfileDescription) addSharedConfigSettings(profile string, files []sharedConfigFile) {
+	visited := map[string]struct{}{}
+	pending := []string{profile}
+	for len(pending) != 0 {
+		profile, pending = pending[0], pending[1:]
+		if _, ok := visited[profile]; ok {
+			continue
+		}
+		visited[profile] = struct{}{}
+
+		var sourceProfile, baseProfile string
+		latest := map[string]int{}
+		for _, f := range files {
+			section, ok := f.profileSection(profile)
+			if !ok {
+				continue
+			}
+
+			for _, k := range section.ValueNames() {
+				if i, ok := latest[k]; ok {
+					d.Settings[i].Overridden = true
+				}
+				latest[k] = len(d.Settings)
+
+				d.Settings = append(d.Settings, ProfileSetting{
+					Key:      k,
+					Value:    describeSettingValue(k, section.String(k)),
+					Source:   ProfileSettingSourceSharedConfigFile,
+					Filename: f.Filename,
+					Profile:  profile,
+				})
+			}
+			if section.Has(sourceProfileKey) {
+				sourceProfile = section.String(sourceProfileKey)
+			}
+			if section.Has(baseProfileKey) {
+				baseProfile = section.String(baseProfileKey)
+			}
+		}
+
+		for _, p := range []string{baseProfile, sourceProfile} {
+			if len(p) != 0 {
+				pending = append(pending, p)
+			}
+		}
+	}
+}
+
+// describeCredentials adds the credential providers the session will use,
+// mirroring how resolveCredentials creates the session's credentials.
+func (d *ProfileDescription) describeCredentials(opts Options, envCfg envConfig, sharedCfg sharedConfig) {
+	switch {
+	case opts.Config.Credentials != nil:
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: "SessionOptions",
+			Detail:       "credentials provided by the session Options Config",
+		})
+
+	case len(opts.Profile) != 0:
+		d.describeCredsFromProfile(opts, envCfg, d.Profile, sharedCfg)
+
+	case envCfg.Creds.HasKeys():
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: EnvProviderName,
+		})
+
+	case len(envCfg.WebIdentityTokenFilePath) != 0:
+		d.describeWebIdentity("", envCfg.WebIdentityTokenFilePath, envCfg.RoleARN)
+
+	default:
+		d.describeCredsFromProfile(opts, envCfg, d.Profile, sharedCfg)
+	}
+}
+
+func (d *ProfileDescription) describeCredsFromProfile(opts Options, envCfg envConfig,
+	profile string, sharedCfg sharedConfig,
+) {
+	switch {
+	case sharedCfg.SourceProfile != nil:
+		d.describeCredsFromProfile(opts, envCfg, sharedCfg.SourceProfileName, *sharedCfg.SourceProfile)
+
+	case sharedCfg.Creds.HasKeys():
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: sharedCfg.Creds.ProviderName,
+			Profile:      profile,
+		})
+
+	case len(sharedCfg.CredentialProcess) != 0:
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: processcreds.ProviderName,
+			Profile:      profile,
+			Detail:       sharedCfg.CredentialProcess,
+		})
+		if err := processcreds.ValidateCommand(sharedCfg.CredentialProcess); err != nil {
+			d.Problems = append(d.Problems, err)
+		}
+
+	case len(sharedCfg.CredentialSource) != 0:
+		var name string
+		if _, ok := lookupCredentialSource(opts, sharedCfg.CredentialSource); !ok {
+			switch sharedCfg.CredentialSource {
+			case credSourceEnvironment:
+				name = EnvProviderName
+			case credSourceECSContainer:
+				name = endpointcreds.ProviderName
+			case credSourceEc2Metadata:
+				name = remoteCredProviderName()
+			case credSourceK8sWebIdentity:
+				name = stscreds.WebIdentityProviderName
+			default:
+				d.Problems = append(d.Problems, ErrSharedConfigInvalidCredSource)
+			}
+		}
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: name,
+			Profile:      profile,
+			Detail:       fmt.Sprintf("%s = %s", credentialSourceKey, sharedCfg.CredentialSource),
+		})
+
+		if sharedCfg.CredentialSource == credSourceK8sWebIdentity && len(name) != 0 {
+			tokenFile := envCfg.WebIdentityTokenFilePath
+			if len(tokenFile) == 0 {
+				tokenFile = k8sWebIdentityTokenFile
+			}
+			if _, err := os.Stat(tokenFile); err != nil {
+				d.Problems = append(d.Problems, awserr.New(stscreds.ErrCodeWebIdentity,
+					fmt.Sprintf("unable to read web identity token file, %s", tokenFile), err))
+			}
+		}
+
+	case len(sharedCfg.WebIdentityTokenFile) != 0:
+		// Assume web identity assumes the role itself, and is not wrapped
+		// with another assume role provider.
+		d.describeWebIdentity(profile, sharedCfg.WebIdentityTokenFile, sharedCfg.RoleARN)
+		return
+
+	default:
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: remoteCredProviderName(),
+			Detail:       "default credential chain",
+		})
+	}
+
+	if len(sharedCfg.RoleARN) > 0 {
+		d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+			ProviderName: stscreds.ProviderName,
+			Profile:      profile,
+			Detail:       sharedCfg.RoleARN,
+		})
+	}
+}
+
+func (d *ProfileDescription) describeWebIdentity(profile, filename, roleARN string) {
+	d.CredentialProviders = append(d.CredentialProviders, CredentialProviderDescription{
+		ProviderName: stscreds.WebIdentityProviderName,
+		Profile:      profile,
+		Detail:       roleARN,
+	})
+
+	if _, err := os.Stat(filename); err != nil {
+		d.Problems = append(d.Problems, awserr.New(stscreds.ErrCodeWebIdentity,
+			fmt.Sprintf("unable to read web identity token file, %s", filename), err))
+	}
+}
+
+// remoteCredProviderName returns the name of the provider
+// defaults.RemoteCredProvider will use.
+func remoteCredProviderName() string {
+	if len(os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")) != 0 ||
+		len(os.Getenv(shareddefaults.ECSCredsProviderEnvVar)) != 0 {
+		return endpointcreds.ProviderName
+	}
+	return ec2rolecreds.ProviderName
+}
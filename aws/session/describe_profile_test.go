@@ -0,0 +1,313 @@
+// +build go1.7
+
+package session
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+)
+
+func TestDescribeProfile(t *testing.T) {
+	cases := map[string]struct {
+		Profile         string
+		ExpectProviders []CredentialProviderDescription
+		ExpectProblems  []string
+	}{
+		"static credentials": {
+			Profile: "complete_creds",
+			ExpectProviders: []CredentialProviderDescription{
+				{
+					ProviderName: "SharedConfigCredentials: " + testConfigFilename,
+					Profile:      "complete_creds",
+				},
+			},
+		},
+		"multiple assume role": {
+			Profile: "multiple_assume_role",
+			ExpectProviders: []CredentialProviderDescription{
+				{
+					ProviderName: "SharedConfigCredentials: " + testConfigFilename,
+					Profile:      "complete_creds",
+				},
+				{
+					ProviderName: stscreds.ProviderName,
+					Profile:      "assume_role",
+					Detail:       "assume_role_role_arn",
+				},
+				{
+					ProviderName: stscreds.ProviderName,
+					Profile:      "multiple_assume_role",
+					Detail:       "multiple_assume_role_role_arn",
+				},
+			},
+		},
+		"credential source": {
+			Profile: "assume_role_with_credential_source",
+			ExpectProviders: []CredentialProviderDescription{
+				{
+					ProviderName: ec2rolecreds.ProviderName,
+					Profile:      "assume_role_with_credential_source",
+					Detail:       "credential_source = Ec2InstanceMetadata",
+				},
+				{
+					ProviderName: stscreds.ProviderName,
+					Profile:      "assume_role_with_credential_source",
+					Detail:       "assume_role_with_credential_source_role_arn",
+				},
+			},
+		},
+		"mfa without token provider": {
+			Profile: "assume_role_w_mfa",
+			ExpectProviders: []CredentialProviderDescription{
+				{
+					ProviderName: "SharedConfigCredentials: " + testConfigFilename,
+					Profile:      "complete_creds",
+				},
+				{
+					ProviderName: stscreds.ProviderName,
+					Profile:      "assume_role_w_mfa",
+					Detail:       "assume_role_role_arn",
+				},
+			},
+			ExpectProblems: []string{"AssumeRoleTokenProviderNotSetError"},
+		},
+		"invalid source profile": {
+			Profile:        "assume_role_invalid_source_profile",
+			ExpectProblems: []string{"SharedConfigAssumeRoleError"},
+		},
+		"profile not exists": {
+			Profile:        "profile_not_exists",
+			ExpectProblems: []string{"SharedConfigProfileNotExistsError"},
+			ExpectProviders: []CredentialProviderDescription{
+				{
+					ProviderName: ec2rolecreds.ProviderName,
+					Detail:       "default credential chain",
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+			os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+
+			desc, err := DescribeProfile(Options{Profile: c.Profile})
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.Profile, desc.Profile; e != a {
+				t.Errorf("expect %v profile, got %v", e, a)
+			}
+			if e, a := c.ExpectProviders, desc.CredentialProviders; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v providers, got %v", e, a)
+			}
+
+			var problems []string
+			for _, p := range desc.Problems {
+				problems = append(problems, p.(interface{ Code() string }).Code())
+			}
+			if e, a := c.ExpectProblems, problems; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v problems, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDescribeProfile_Settings(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("AWS_SDK_LOAD_CONFIG", "1")
+	os.Setenv("AWS_CONFIG_FILE", testConfigFilename)
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", testConfigOtherFilename)
+	os.Setenv("AWS_REGION", "env_region")
+	os.Setenv("AWS_SESSION_TOKEN", "env_token")
+
+	desc, err := DescribeProfile(Options{Profile: "config_file_load_order"})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expectFiles := []SharedConfigFileDescription{
+		{Filename: testConfigFilename, Loaded: true},
+		{Filename: testConfigOtherFilename, Loaded: true},
+	}
+	if e, a := expectFiles, desc.Files; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v files, got %v", e, a)
+	}
+
+	settings := map[string][]ProfileSetting{}
+	for _, s := range desc.Settings {
+		settings[s.Key] = append(settings[s.Key], s)
+	}
+
+	if e, a := []ProfileSetting{{
+		Key: "AWS_REGION", Value: "env_region", Source: ProfileSettingSourceEnvironment,
+	}}, settings["AWS_REGION"]; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v region setting, got %v", e, a)
+	}
+	if e, a := describeRedactedValue, settings["AWS_SESSION_TOKEN"][0].Value; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+
+	secrets := settings[secretAccessKey]
+	if e, a := 2, len(secrets); e != a {
+		t.Fatalf("expect %v secret settings, got %v", e, a)
+	}
+	if e, a := testConfigFilename, secrets[0].Filename; e != a {
+		t.Errorf("expect %v filename, got %v", e, a)
+	}
+	if !secrets[0].Overridden {
+		t.Errorf("expect first file's setting to be overridden")
+	}
+	if secrets[1].Overridden {
+		t.Errorf("expect last file's setting not to be overridden")
+	}
+	for _, s := range secrets {
+		if e, a := describeRedactedValue, s.Value; e != a {
+			t.Errorf("expect %v secret value, got %v", e, a)
+		}
+	}
+}
+
+func TestDescribeProfile_CredentialProcess(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	// The command is run by the shell, and is not looked up.
+	cases := map[string]struct {
+		Command       string
+		ExpectProblem string
+	}{
+		"quoted path": {
+			Command: `"/path with spaces/credential-helper" --profile abc`,
+		},
+		"env prefix": {
+			Command: `HELPER_PROFILE=abc credential-helper`,
+		},
+		"relative path": {
+			Command: `cat ./testdata/test_json.json`,
+		},
+		"empty command": {
+			Command:       " \t",
+			ExpectProblem: "command must not be empty",
+		},
+		"unterminated quote": {
+			Command:       `"/path with spaces/credential-helper --profile abc`,
+			ExpectProblem: "command cannot be split into arguments",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			desc := &ProfileDescription{}
+			desc.describeCredsFromProfile(Options{}, envConfig{}, "process", sharedConfig{
+				CredentialProcess: c.Command,
+			})
+
+			expectProviders := []CredentialProviderDescription{
+				{
+					ProviderName: processcreds.ProviderName,
+					Profile:      "process",
+					Detail:       c.Command,
+				},
+			}
+			if e, a := expectProviders, desc.CredentialProviders; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %v providers, got %v", e, a)
+			}
+
+			if len(c.ExpectProblem) == 0 {
+				if e, a := 0, len(desc.Problems); e != a {
+					t.Errorf("expect %v problems, got %v", e, a)
+				}
+				return
+			}
+			if e, a := 1, len(desc.Problems); e != a {
+				t.Fatalf("expect %v problems, got %v", e, a)
+			}
+			aerr, ok := desc.Problems[0].(awserr.Error)
+			if !ok {
+				t.Fatalf("expect awserr.Error, got %T", desc.Problems[0])
+			}
+			if e, a := processcreds.ErrCodeProcessProviderExecution, aerr.Code(); e != a {
+				t.Errorf("expect %v code, got %v", e, a)
+			}
+			if e, a := c.ExpectProblem, aerr.Message(); !strings.Contains(a, e) {
+				t.Errorf("expect %q in message, got %q", e, a)
+			}
+		})
+	}
+}
+
+func TestDescribeProfile_CredentialSource(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	cases := map[string]struct {
+		Options        Options
+		EnvConfig      envConfig
+		Source         string
+		ExpectProvider string
+		ExpectProblems int
+	}{
+		"kubernetes web identity": {
+			EnvConfig:      envConfig{WebIdentityTokenFilePath: testConfigFilename},
+			Source:         credSourceK8sWebIdentity,
+			ExpectProvider: stscreds.WebIdentityProviderName,
+		},
+		"kubernetes web identity token not found": {
+			EnvConfig:      envConfig{WebIdentityTokenFilePath: "testdata/token_not_exists"},
+			Source:         credSourceK8sWebIdentity,
+			ExpectProvider: stscreds.WebIdentityProviderName,
+			ExpectProblems: 1,
+		},
+		"custom": {
+			Options: Options{
+				CredentialSources: map[string]CredentialSourceFunc{
+					"Custom": func(CredentialSourceInput) (*credentials.Credentials, error) {
+						return credentials.AnonymousCredentials, nil
+					},
+				},
+			},
+			Source: "Custom",
+		},
+		"unknown": {
+			Source:         "Unknown",
+			ExpectProblems: 1,
+		},
+	}
+
+	for name, c := range cases {
+		desc := &ProfileDescription{}
+		desc.describeCredsFromProfile(c.Options, c.EnvConfig, "source", sharedConfig{
+			RoleARN:          "role_arn",
+			CredentialSource: c.Source,
+		})
+
+		if e, a := 2, len(desc.CredentialProviders); e != a {
+			t.Fatalf("%s, expect %v providers, got %v", name, e, a)
+		}
+		if e, a := c.ExpectProvider, desc.CredentialProviders[0].ProviderName; e != a {
+			t.Errorf("%s, expect %v provider, got %v", name, e, a)
+		}
+		if e, a := stscreds.ProviderName, desc.CredentialProviders[1].ProviderName; e != a {
+			t.Errorf("%s, expect %v provider, got %v", name, e, a)
+		}
+		if e, a := c.ExpectProblems, len(desc.Problems); e != a {
+			t.Errorf("%s, expect %v problems, got %v", name, e, a)
+		}
+	}
+}
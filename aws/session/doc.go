@@ -146,6 +146,18 @@ specified, such as "source_profile", "credential_source", or
 	mfa_serial = <serial or mfa arn>
 	role_session_name = session_name
 
+The role's session may also be configured with session tags, the keys of the
+tags passed on to roles chained from the session, managed session policies,
+and the duration of the role's credentials in seconds. Session tags are a
+comma separated list of key=value pairs, and the tag keys and policy ARNs are
+comma separated lists. When a "source_profile" itself assumes a role, each
+role in the chain is assumed with the fields of its own profile. These fields
+are not used when assuming a role with a "web_identity_token_file".
+
+	role_session_tags = Project=alpha, Team=sdk
+	transitive_tag_keys = Project
+	policy_arns = arn:aws:iam::aws:policy/ReadOnlyAccess
+	duration_seconds = 3600
 
 The SDK supports assuming a role with MFA token. If "mfa_serial" is set, you
 must also set the Session Option.AssumeRoleTokenProvider. The Session will fail
@@ -155,9 +167,65 @@ to load if the AssumeRoleTokenProvider is not specified.
         AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
     }))
 
+The "credential_source" field sets the source of the credentials the role is
+assumed with: "Environment", "Ec2InstanceMetadata", "EcsContainer", or
+"KubernetesWebIdentity". KubernetesWebIdentity assumes the pod's role,
+AWS_ROLE_ARN, with the web identity token projected into the pod by EKS, read
+from AWS_WEB_IDENTITY_TOKEN_FILE or the default EKS token path, before the
+profile's role is assumed. Since the web identity environment variables take
+precedence over AWS_PROFILE, a profile using this source must be selected with
+the Session Option.Profile.
+
+	role_arn = arn:aws:iam::<account_number>:role/<role_name>
+	credential_source = KubernetesWebIdentity
+
+Custom credential sources can be registered for all sessions with
+RegisterCredentialSource, or set for a session with the Session
+Option.CredentialSources. Custom sources take precedence over the built-in
+sources of the same name.
+
+    sess := session.Must(session.NewSessionWithOptions(session.Options{
+        CredentialSources: map[string]session.CredentialSourceFunc{
+            "Vault": func(in session.CredentialSourceInput) (*credentials.Credentials, error) {
+                return credentials.NewCredentials(&vaultProvider{}), nil
+            },
+        },
+    }))
+
 To setup Assume Role outside of a session see the stscreds.AssumeRoleProvider
 documentation.
 
+Profile inheritance and includes
+
+A profile may inherit the configuration of another profile with the
+"base_profile" field. Only non-credential fields, such as "region", are
+inherited from the base profile, and fields set in the profile override the
+inherited values. Base profiles may themselves set "base_profile". The Session
+will fail to load if the base profile does not exist, or the profiles form a
+cycle.
+
+	[profile base]
+	region = us-west-2
+	s3_use_arn_region = true
+
+	[profile dev]
+	base_profile = base
+	aws_access_key_id = AKID
+	aws_secret_access_key = SECRET
+
+A shared config file may include other files with the "include" field set
+before the file's first profile. Multiple files are separated by commas.
+Relative paths are relative to the directory of the including file, and "~/"
+is expanded to the user's home directory. Included files are loaded before the
+file that includes them, so the including file's values take precedence. The
+Session will fail to load if an included file does not exist, or files include
+each other.
+
+	include = ~/.aws/platform_config, team_config
+
+	[profile dev]
+	region = us-east-1
+
 Environment Variables
 
 When a Session is created several environment variables can be set to adjust
@@ -148,6 +148,19 @@ type envConfig struct {
 	//
 	// AWS_S3_USE_ARN_REGION=true
 	S3UseARNRegion bool
+
+	// Specifies the EC2 Instance Metadata Service endpoint to use. If
+	// specified it overrides EC2IMDSEndpointMode.
+	//
+	// AWS_EC2_METADATA_SERVICE_ENDPOINT=http://[::1]
+	EC2IMDSEndpoint string
+
+	// Specifies the EC2 Instance Metadata Service endpoint mode, selecting
+	// the IPv4 or IPv6 endpoint.
+	//
+	// AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE=IPv6
+	// This can take value as `IPv4` or `IPv6`
+	EC2IMDSEndpointMode endpoints.EC2IMDSEndpointModeState
 }
 
 var (
@@ -211,6 +224,12 @@ var (
 	s3UseARNRegionEnvKey = []string{
 		"AWS_S3_USE_ARN_REGION",
 	}
+	ec2IMDSEndpointEnvKey = []string{
+		"AWS_EC2_METADATA_SERVICE_ENDPOINT",
+	}
+	ec2IMDSEndpointModeEnvKey = []string{
+		"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE",
+	}
 )
 
 // loadEnvConfig retrieves the SDK's environment configuration.
@@ -247,6 +266,7 @@ func envConfigLoad(enableSharedConfig bool) (envConfig, error) {
 	if creds.HasKeys() {
 		// Require logical grouping of credentials
 		creds.ProviderName = EnvProviderName
+		creds.Source = credentials.Source{Kind: credentials.SourceEnvironment}
 		cfg.Creds = creds
 	}
 
@@ -317,6 +337,18 @@ func envConfigLoad(enableSharedConfig bool) (envConfig, error) {
 		}
 	}
 
+	setFromEnvVal(&cfg.EC2IMDSEndpoint, ec2IMDSEndpointEnvKey)
+
+	// EC2 IMDS Endpoint Mode variable
+	for _, k := range ec2IMDSEndpointModeEnvKey {
+		if v := os.Getenv(k); len(v) != 0 {
+			cfg.EC2IMDSEndpointMode, err = endpoints.GetEC2IMDSEndpointMode(v)
+			if err != nil {
+				return cfg, fmt.Errorf("failed to load, %v from env config, %v", k, err)
+			}
+		}
+	}
+
 	var s3UseARNRegion string
 	setFromEnvVal(&s3UseARNRegion, s3UseARNRegionEnvKey)
 	if len(s3UseARNRegion) != 0 {
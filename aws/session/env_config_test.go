@@ -52,6 +52,7 @@ func TestLoadEnvConfig_Creds(t *testing.T) {
 			Val: credentials.Value{
 				AccessKeyID: "AKID", SecretAccessKey: "SECRET",
 				ProviderName: "EnvConfigCredentials",
+				Source:       credentials.Source{Kind: credentials.SourceEnvironment},
 			},
 		},
 		{
@@ -62,6 +63,7 @@ func TestLoadEnvConfig_Creds(t *testing.T) {
 			Val: credentials.Value{
 				AccessKeyID: "AKID", SecretAccessKey: "SECRET",
 				ProviderName: "EnvConfigCredentials",
+				Source:       credentials.Source{Kind: credentials.SourceEnvironment},
 			},
 		},
 		{
@@ -73,6 +75,7 @@ func TestLoadEnvConfig_Creds(t *testing.T) {
 			Val: credentials.Value{
 				AccessKeyID: "AKID", SecretAccessKey: "SECRET", SessionToken: "TOKEN",
 				ProviderName: "EnvConfigCredentials",
+				Source:       credentials.Source{Kind: credentials.SourceEnvironment},
 			},
 		},
 	}
@@ -292,6 +295,18 @@ func TestLoadEnvConfig(t *testing.T) {
 				SharedConfigFile:          shareddefaults.SharedConfigFilename(),
 			},
 		},
+		{
+			Env: map[string]string{
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT":      "http://[::1]",
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE": "ipv6",
+			},
+			Config: envConfig{
+				EC2IMDSEndpoint:       "http://[::1]",
+				EC2IMDSEndpointMode:   endpoints.EC2IMDSEndpointModeStateIPv6,
+				SharedCredentialsFile: shareddefaults.SharedCredentialsFilename(),
+				SharedConfigFile:      shareddefaults.SharedConfigFilename(),
+			},
+		},
 		{
 			Env: map[string]string{
 				"AWS_S3_USE_ARN_REGION": "true",
@@ -36,7 +36,7 @@ var ErrSharedConfigSourceCollision = awserr.New(ErrCodeSharedConfig, "only sourc
 var ErrSharedConfigECSContainerEnvVarEmpty = awserr.New(ErrCodeSharedConfig, "EcsContainer was specified as the credential_source, but 'AWS_CONTAINER_CREDENTIALS_RELATIVE_URI' was not set", nil)
 
 // ErrSharedConfigInvalidCredSource will be returned if an invalid credential source was provided
-var ErrSharedConfigInvalidCredSource = awserr.New(ErrCodeSharedConfig, "credential source values must be EcsContainer, Ec2InstanceMetadata, or Environment", nil)
+var ErrSharedConfigInvalidCredSource = awserr.New(ErrCodeSharedConfig, "credential source values must be EcsContainer, Ec2InstanceMetadata, Environment, KubernetesWebIdentity, or a registered credential source", nil)
 
 // A Session provides a central location to create service clients from and
 // store configurations and request handlers for those services.
@@ -106,9 +106,9 @@ func New(cfgs ...*aws.Config) *Session {
 	}
 
 	if csmCfg, err := loadCSMConfig(envCfg, []string{}); err != nil {
-		if l := s.Config.Logger; l != nil {
-			l.Log(fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err))
-		}
+		aws.LogWithFields(s.Config.Logger, aws.LogSeverityError,
+			"failed to load CSM configuration",
+			aws.LogField{Key: aws.LogFieldError, Value: err})
 	} else if csmCfg.Enabled {
 		err := enableCSM(&s.Handlers, csmCfg, s.Config.Logger)
 		if err != nil {
@@ -219,7 +219,8 @@ type Options struct {
 
 	// When the SDK's shared config is configured to assume a role this option
 	// may be provided to set the expiry duration of the STS credentials.
-	// Defaults to 15 minutes if not set as documented in the
+	// Takes precedence over the duration_seconds field of the profiles. Defaults
+	// to 15 minutes if neither is set as documented in the
 	// stscreds.AssumeRoleProvider.
 	AssumeRoleDuration time.Duration
 
@@ -243,6 +244,12 @@ type Options struct {
 	// function to initialize this value before changing the handlers to be
 	// used by the SDK.
 	Handlers request.Handlers
+
+	// Credential sources, by credential_source name, the session may use in
+	// addition to the sources registered with RegisterCredentialSource.
+	// Takes precedence over the registered and built-in sources of the same
+	// name.
+	CredentialSources map[string]CredentialSourceFunc
 }
 
 // NewSessionWithOptions returns a new Session created from SDK defaults, config files,
@@ -275,17 +282,39 @@ type Options struct {
 //         SharedConfigState: session.SharedConfigEnable,
 //     }))
 func NewSessionWithOptions(opts Options) (*Session, error) {
+	envCfg, err := loadEnvConfigWithOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only use AWS_CA_BUNDLE if session option is not provided.
+	if len(envCfg.CustomCABundle) != 0 && opts.CustomCABundle == nil {
+		f, err := os.Open(envCfg.CustomCABundle)
+		if err != nil {
+			return nil, awserr.New("LoadCustomCABundleError",
+				"failed to open custom CA bundle PEM file", err)
+		}
+		defer f.Close()
+		opts.CustomCABundle = f
+	}
+
+	return newSession(opts, envCfg, &opts.Config)
+}
+
+// loadEnvConfigWithOptions loads the environment config, applying the profile
+// and shared config state of the session options.
+func loadEnvConfigWithOptions(opts Options) (envConfig, error) {
 	var envCfg envConfig
 	var err error
 	if opts.SharedConfigState == SharedConfigEnable {
 		envCfg, err = loadSharedEnvConfig()
 		if err != nil {
-			return nil, fmt.Errorf("failed to load shared config, %v", err)
+			return envConfig{}, fmt.Errorf("failed to load shared config, %v", err)
 		}
 	} else {
 		envCfg, err = loadEnvConfig()
 		if err != nil {
-			return nil, fmt.Errorf("failed to load environment config, %v", err)
+			return envConfig{}, fmt.Errorf("failed to load environment config, %v", err)
 		}
 	}
 
@@ -300,18 +329,7 @@ func NewSessionWithOptions(opts Options) (*Session, error) {
 		envCfg.EnableSharedConfig = true
 	}
 
-	// Only use AWS_CA_BUNDLE if session option is not provided.
-	if len(envCfg.CustomCABundle) != 0 && opts.CustomCABundle == nil {
-		f, err := os.Open(envCfg.CustomCABundle)
-		if err != nil {
-			return nil, awserr.New("LoadCustomCABundleError",
-				"failed to open custom CA bundle PEM file", err)
-		}
-		defer f.Close()
-		opts.CustomCABundle = f
-	}
-
-	return newSession(opts, envCfg, &opts.Config)
+	return envCfg, nil
 }
 
 // Must is a helper function to ensure the Session is valid and there was no
@@ -356,9 +374,7 @@ func deprecatedNewSession(cfgs ...*aws.Config) *Session {
 }
 
 func enableCSM(handlers *request.Handlers, cfg csmConfig, logger aws.Logger) error {
-	if logger != nil {
-		logger.Log("Enabling CSM")
-	}
+	aws.LogWithFields(logger, aws.LogSeverityInfo, "Enabling CSM")
 
 	r, err := csm.Start(cfg.ClientID, csm.AddressWithDefaults(cfg.Host, cfg.Port))
 	if err != nil {
@@ -383,32 +399,12 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	userCfg.MergeIn(cfgs...)
 	cfg.MergeIn(userCfg)
 
-	// Ordered config files will be loaded in with later files overwriting
-	// previous config file values.
-	var cfgFiles []string
-	if opts.SharedConfigFiles != nil {
-		cfgFiles = opts.SharedConfigFiles
-	} else {
-		cfgFiles = []string{envCfg.SharedConfigFile, envCfg.SharedCredentialsFile}
-		if !envCfg.EnableSharedConfig {
-			// The shared config file (~/.aws/config) is only loaded if instructed
-			// to load via the envConfig.EnableSharedConfig (AWS_SDK_LOAD_CONFIG).
-			cfgFiles = cfgFiles[1:]
-		}
-	}
+	cfgFiles := sharedConfigFilenames(opts, envCfg)
 
 	// Load additional config from file(s)
-	sharedCfg, err := loadSharedConfig(envCfg.Profile, cfgFiles, envCfg.EnableSharedConfig)
+	sharedCfg, err := loadSessionSharedConfig(envCfg, cfgFiles, userCfg)
 	if err != nil {
-		if len(envCfg.Profile) == 0 && !envCfg.EnableSharedConfig && (envCfg.Creds.HasKeys() || userCfg.Credentials != nil) {
-			// Special case where the user has not explicitly specified an AWS_PROFILE,
-			// or session.Options.profile, shared config is not enabled, and the
-			// environment has credentials, allow the shared config file to fail to
-			// load since the user has already provided credentials, and nothing else
-			// is required to be read file. Github(aws/aws-sdk-go#2455)
-		} else if _, ok := err.(SharedConfigProfileNotExistsError); !ok {
-			return nil, err
-		}
+		return nil, err
 	}
 
 	if err := mergeConfigSrcs(cfg, userCfg, envCfg, sharedCfg, handlers, opts); err != nil {
@@ -423,9 +419,9 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	initHandlers(s)
 
 	if csmCfg, err := loadCSMConfig(envCfg, cfgFiles); err != nil {
-		if l := s.Config.Logger; l != nil {
-			l.Log(fmt.Sprintf("ERROR: failed to load CSM configuration, %v", err))
-		}
+		aws.LogWithFields(s.Config.Logger, aws.LogSeverityError,
+			"failed to load CSM configuration",
+			aws.LogField{Key: aws.LogFieldError, Value: err})
 	} else if csmCfg.Enabled {
 		err = enableCSM(&s.Handlers, csmCfg, s.Config.Logger)
 		if err != nil {
@@ -443,6 +439,42 @@ func newSession(opts Options, envCfg envConfig, cfgs ...*aws.Config) (*Session,
 	return s, nil
 }
 
+// sharedConfigFilenames returns the ordered list of shared config files the
+// session will load. Later files overwrite previous config file values.
+func sharedConfigFilenames(opts Options, envCfg envConfig) []string {
+	if opts.SharedConfigFiles != nil {
+		return opts.SharedConfigFiles
+	}
+
+	cfgFiles := []string{envCfg.SharedConfigFile, envCfg.SharedCredentialsFile}
+	if !envCfg.EnableSharedConfig {
+		// The shared config file (~/.aws/config) is only loaded if instructed
+		// to load via the envConfig.EnableSharedConfig (AWS_SDK_LOAD_CONFIG).
+		cfgFiles = cfgFiles[1:]
+	}
+
+	return cfgFiles
+}
+
+// loadSessionSharedConfig loads the shared config for the session's profile,
+// ignoring errors that do not prevent the session from being created.
+func loadSessionSharedConfig(envCfg envConfig, cfgFiles []string, userCfg *aws.Config) (sharedConfig, error) {
+	sharedCfg, err := loadSharedConfig(envCfg.Profile, cfgFiles, envCfg.EnableSharedConfig)
+	if err != nil {
+		if len(envCfg.Profile) == 0 && !envCfg.EnableSharedConfig && (envCfg.Creds.HasKeys() || userCfg.Credentials != nil) {
+			// Special case where the user has not explicitly specified an AWS_PROFILE,
+			// or session.Options.profile, shared config is not enabled, and the
+			// environment has credentials, allow the shared config file to fail to
+			// load since the user has already provided credentials, and nothing else
+			// is required to be read file. Github(aws/aws-sdk-go#2455)
+		} else if _, ok := err.(SharedConfigProfileNotExistsError); !ok {
+			return sharedCfg, err
+		}
+	}
+
+	return sharedCfg, nil
+}
+
 type csmConfig struct {
 	Enabled  bool
 	Host     string
@@ -570,6 +602,21 @@ func mergeConfigSrcs(cfg, userCfg *aws.Config,
 		endpoints.LegacyS3UsEast1Endpoint,
 	})
 
+	// EC2 Instance Metadata Service endpoint and endpoint mode, with an
+	// endpoint taking precedence over an endpoint mode.
+	if cfg.EC2MetadataServiceEndpoint == nil {
+		if len(envCfg.EC2IMDSEndpoint) != 0 {
+			cfg.WithEC2MetadataServiceEndpoint(envCfg.EC2IMDSEndpoint)
+		} else if len(sharedCfg.EC2IMDSEndpoint) != 0 {
+			cfg.WithEC2MetadataServiceEndpoint(sharedCfg.EC2IMDSEndpoint)
+		}
+	}
+	mergeEC2IMDSEndpointModeConfig(cfg, []endpoints.EC2IMDSEndpointModeState{
+		userCfg.EC2MetadataServiceEndpointMode,
+		envCfg.EC2IMDSEndpointMode,
+		sharedCfg.EC2IMDSEndpointMode,
+	})
+
 	// Configure credentials if not already set by the user when creating the
 	// Session.
 	if cfg.Credentials == credentials.AnonymousCredentials && userCfg.Credentials == nil {
@@ -609,6 +656,15 @@ func mergeS3UsEast1RegionalEndpointConfig(cfg *aws.Config, values []endpoints.S3
 	}
 }
 
+func mergeEC2IMDSEndpointModeConfig(cfg *aws.Config, values []endpoints.EC2IMDSEndpointModeState) {
+	for _, v := range values {
+		if v != endpoints.EC2IMDSEndpointModeStateUnset {
+			cfg.EC2MetadataServiceEndpointMode = v
+			break
+		}
+	}
+}
+
 func initHandlers(s *Session) {
 	// Add the Validate parameter handler if it is not disabled.
 	s.Handlers.Validate.Remove(corehandlers.ValidateParametersHandler)
@@ -688,6 +744,12 @@ func (s *Session) resolveEndpoint(service, region string, cfg *aws.Config) (endp
 			// precedence.
 			opt.S3UsEast1RegionalEndpoint = cfg.S3UsEast1RegionalEndpoint
 
+			// Support for the EC2 Instance Metadata Service endpoint mode,
+			// selecting the service's IPv4 or IPv6 endpoint, and custom
+			// endpoint.
+			opt.EC2MetadataEndpointMode = cfg.EC2MetadataServiceEndpointMode
+			opt.EC2MetadataEndpoint = aws.StringValue(cfg.EC2MetadataServiceEndpoint)
+
 			// Support the condition where the service is modeled but its
 			// endpoint metadata is not available.
 			opt.ResolveUnknownService = true
@@ -727,7 +789,8 @@ func (s *Session) logDeprecatedNewSessionError(msg string, err error, cfgs []*aw
 	// Session creation failed, need to report the error and prevent
 	// any requests from succeeding.
 	s.Config.MergeIn(cfgs...)
-	s.Config.Logger.Log("ERROR:", msg, "Error:", err)
+	aws.LogWithFields(s.Config.Logger, aws.LogSeverityError, msg,
+		aws.LogField{Key: aws.LogFieldError, Value: err})
 	s.Handlers.Validate.PushBack(func(r *request.Request) {
 		r.Error = err
 	})
@@ -663,3 +663,91 @@ func TestSession_RegionalEndpoints(t *testing.T) {
 		})
 	}
 }
+
+func TestSession_EC2MetadataServiceEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		Env    map[string]string
+		Config aws.Config
+
+		ExpectEndpoint string
+	}{
+		"default": {
+			ExpectEndpoint: "http://169.254.169.254/latest",
+		},
+		"env mode": {
+			Env: map[string]string{
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE": "IPv6",
+			},
+			ExpectEndpoint: "http://[fd00:ec2::254]/latest",
+		},
+		"shared config mode": {
+			Env: map[string]string{
+				"AWS_SDK_LOAD_CONFIG": "1",
+				"AWS_CONFIG_FILE":     testConfigFilename,
+				"AWS_PROFILE":         "with_ec2_metadata_service_endpoint",
+			},
+			ExpectEndpoint: "http://[::1]/latest",
+		},
+		"env endpoint": {
+			Env: map[string]string{
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT":      "http://[::1]:1338/",
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE": "IPv6",
+			},
+			ExpectEndpoint: "http://[::1]:1338/latest",
+		},
+		"config mode over env": {
+			Env: map[string]string{
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE": "IPv6",
+			},
+			Config: aws.Config{
+				EC2MetadataServiceEndpointMode: endpoints.EC2IMDSEndpointModeStateIPv4,
+			},
+			ExpectEndpoint: "http://169.254.169.254/latest",
+		},
+		"config endpoint over env": {
+			Env: map[string]string{
+				"AWS_EC2_METADATA_SERVICE_ENDPOINT": "http://[::1]",
+			},
+			Config: aws.Config{
+				EC2MetadataServiceEndpoint: aws.String("127.0.0.1:1338"),
+			},
+			ExpectEndpoint: "http://127.0.0.1:1338/latest",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoreEnvFn := initSessionTestEnv()
+			defer restoreEnvFn()
+
+			for k, v := range c.Env {
+				os.Setenv(k, v)
+			}
+
+			s, err := NewSession(&c.Config)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			cfg := s.ClientConfig(endpoints.Ec2metadataServiceID)
+			if e, a := c.ExpectEndpoint, cfg.Endpoint; e != a {
+				t.Errorf("expect %v endpoint, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestSession_EC2MetadataServiceEndpoint_InvalidMode(t *testing.T) {
+	restoreEnvFn := initSessionTestEnv()
+	defer restoreEnvFn()
+
+	os.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE", "IPv5")
+
+	_, err := NewSession()
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %v in error, got %v", e, a)
+	}
+}
@@ -2,11 +2,18 @@ package session
 
 import (
 	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/internal/ini"
+	"github.com/aws/aws-sdk-go/internal/shareddefaults"
+	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 const (
@@ -23,6 +30,12 @@ const (
 	mfaSerialKey        = `mfa_serial`        // optional
 	roleSessionNameKey  = `role_session_name` // optional
 
+	// Assume Role session options
+	roleSessionTagsKey   = `role_session_tags`   // optional
+	transitiveTagKeysKey = `transitive_tag_keys` // optional
+	policyARNsKey        = `policy_arns`         // optional
+	durationSecondsKey   = `duration_seconds`    // optional
+
 	// CSM options
 	csmEnabledKey  = `csm_enabled`
 	csmHostKey     = `csm_host`
@@ -54,6 +67,20 @@ const (
 
 	// S3 ARN Region Usage
 	s3UseARNRegionKey = "s3_use_arn_region"
+
+	// EC2 IMDS Endpoint
+	ec2MetadataServiceEndpointKey = "ec2_metadata_service_endpoint"
+
+	// EC2 IMDS Endpoint Mode
+	ec2MetadataServiceEndpointModeKey = "ec2_metadata_service_endpoint_mode"
+
+	// Profile inheritance, non-credential keys are inherited from the base
+	// profile.
+	baseProfileKey = `base_profile` // optional
+
+	// Comma separated list of additional config files to load before the
+	// file. Only valid outside of a profile, at the top of the file.
+	includeKey = `include`
 )
 
 // sharedConfig represents the configuration fields of the SDK config files.
@@ -78,6 +105,19 @@ type sharedConfig struct {
 	ExternalID      string
 	MFASerial       string
 
+	// Session options of the role assumed. Session tags are a comma separated
+	// list of key=value pairs, and the transitive tag keys and policy ARNs
+	// comma separated lists.
+	//
+	//	role_session_tags = Project=alpha, Team=sdk
+	//	transitive_tag_keys = Project
+	//	policy_arns = arn:aws:iam::aws:policy/ReadOnlyAccess
+	//	duration_seconds = 3600
+	RoleSessionTags   []*sts.Tag
+	TransitiveTagKeys []string
+	PolicyARNs        []string
+	RoleDuration      time.Duration
+
 	SourceProfileName string
 	SourceProfile     *sharedConfig
 
@@ -116,11 +156,38 @@ type sharedConfig struct {
 	//
 	// s3_use_arn_region=true
 	S3UseARNRegion bool
+
+	// Specifies the EC2 Instance Metadata Service endpoint to use. If
+	// specified it overrides EC2IMDSEndpointMode.
+	//
+	// ec2_metadata_service_endpoint="http://[::1]"
+	EC2IMDSEndpoint string
+
+	// Specifies the EC2 Instance Metadata Service endpoint mode, selecting
+	// the IPv4 or IPv6 endpoint.
+	//
+	// ec2_metadata_service_endpoint_mode=IPv6
+	// This can take value as `IPv4` or `IPv6`
+	EC2IMDSEndpointMode endpoints.EC2IMDSEndpointModeState
 }
 
 type sharedConfigFile struct {
 	Filename string
 	IniData  ini.Sections
+
+	// The name of the file that included this file, empty if the file was
+	// not loaded via an include directive.
+	IncludedBy string
+}
+
+// profileSection returns the section of the profile in the file, falling back
+// to the alternate profile name, "profile <name>".
+func (f sharedConfigFile) profileSection(profile string) (ini.Section, bool) {
+	section, ok := f.IniData.GetSection(profile)
+	if !ok {
+		section, ok = f.IniData.GetSection(fmt.Sprintf("profile %s", profile))
+	}
+	return section, ok
 }
 
 // loadSharedConfig retrieves the configuration from the list of files using
@@ -153,27 +220,102 @@ func loadSharedConfig(profile string, filenames []string, exOpts bool) (sharedCo
 	return cfg, nil
 }
 
+// loadSharedConfigIniFiles loads the config files, and the files they
+// include. Files included by a config file are loaded before the file that
+// includes them, so that the values of the including file have precedence. A
+// file included multiple times is only loaded the first time it is included.
 func loadSharedConfigIniFiles(filenames []string) ([]sharedConfigFile, error) {
 	files := make([]sharedConfigFile, 0, len(filenames))
+	loaded := map[string]struct{}{}
 
 	for _, filename := range filenames {
-		sections, err := ini.OpenFile(filename)
-		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ini.ErrCodeUnableToReadFile {
-			// Skip files which can't be opened and read for whatever reason
-			continue
-		} else if err != nil {
-			return nil, SharedConfigLoadError{Filename: filename, Err: err}
+		var err error
+		files, err = appendSharedConfigIniFile(files, loaded, filename, nil)
+		if err != nil {
+			return nil, err
 		}
-
-		files = append(files, sharedConfigFile{
-			Filename: filename, IniData: sections,
-		})
 	}
 
 	return files, nil
 }
 
+func appendSharedConfigIniFile(files []sharedConfigFile, loaded map[string]struct{},
+	filename string, includedBy []string,
+) ([]sharedConfigFile, error) {
+	if _, ok := loaded[filepath.Clean(filename)]; ok {
+		return files, nil
+	}
+
+	var parent string
+	if len(includedBy) != 0 {
+		parent = includedBy[len(includedBy)-1]
+	}
+	for _, f := range includedBy {
+		if filepath.Clean(f) == filepath.Clean(filename) {
+			return nil, SharedConfigIncludeError{
+				Filename: parent, Include: filename,
+				Err: fmt.Errorf("include cycle, %s -> %s",
+					strings.Join(includedBy, " -> "), filename),
+			}
+		}
+	}
+
+	sections, err := ini.OpenFile(filename)
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == ini.ErrCodeUnableToReadFile {
+		if len(parent) != 0 {
+			// Included files must exist.
+			return nil, SharedConfigIncludeError{
+				Filename: parent, Include: filename, Err: err,
+			}
+		}
+		// Skip files which can't be opened and read for whatever reason
+		return files, nil
+	} else if err != nil {
+		return nil, SharedConfigLoadError{Filename: filename, Err: err}
+	}
+
+	if section, ok := sections.GetSection(""); ok && section.Has(includeKey) {
+		for _, include := range strings.Split(section.String(includeKey), ",") {
+			include = strings.TrimSpace(include)
+			if len(include) == 0 {
+				continue
+			}
+
+			files, err = appendSharedConfigIniFile(files, loaded,
+				resolveIncludePath(filename, include), append(includedBy, filename))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	loaded[filepath.Clean(filename)] = struct{}{}
+	return append(files, sharedConfigFile{
+		Filename: filename, IniData: sections, IncludedBy: parent,
+	}), nil
+}
+
+// resolveIncludePath returns the path of the included file. Paths starting
+// with "~/" are relative to the user's home directory, and other relative
+// paths are relative to the directory of the including file.
+func resolveIncludePath(filename, include string) string {
+	switch {
+	case strings.HasPrefix(include, "~/") || strings.HasPrefix(include, `~\`):
+		return filepath.Join(shareddefaults.UserHomeDir(), include[2:])
+	case filepath.IsAbs(include):
+		return include
+	default:
+		return filepath.Join(filepath.Dir(filename), include)
+	}
+}
+
 func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile string, files []sharedConfigFile, exOpts bool) error {
+	// Inherit the non-credential options of the profile's base profiles.
+	// Values in the profile itself will overwrite the inherited values.
+	if err := cfg.setFromBaseProfile(profile, files, exOpts, []string{profile}); err != nil {
+		return err
+	}
+
 	// Trim files from the list that don't exist.
 	var skippedFiles int
 	var profileNotFoundErr error
@@ -246,6 +388,60 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 	return nil
 }
 
+// setFromBaseProfile loads the options of the profile's base profile, and
+// recursively of its base profiles. Only non-credential options are
+// inherited, credential and assume role options of base profiles are cleared.
+// The chain is the list of profiles already visited, used to detect cycles.
+func (cfg *sharedConfig) setFromBaseProfile(profile string, files []sharedConfigFile, exOpts bool, chain []string) error {
+	var base string
+	for _, f := range files {
+		if section, ok := f.profileSection(profile); ok && section.Has(baseProfileKey) {
+			base = section.String(baseProfileKey)
+		}
+	}
+	if len(base) == 0 {
+		return nil
+	}
+
+	for _, p := range chain {
+		if p == base {
+			return SharedConfigBaseProfileError{
+				Profile: profile, BaseProfile: base,
+				Err: fmt.Errorf("base profile cycle, %s -> %s",
+					strings.Join(chain, " -> "), base),
+			}
+		}
+	}
+
+	if err := cfg.setFromBaseProfile(base, files, exOpts, append(chain, base)); err != nil {
+		return err
+	}
+
+	var found bool
+	for _, f := range files {
+		if err := cfg.setFromIniFile(base, f, exOpts); err != nil {
+			if _, ok := err.(SharedConfigProfileNotExistsError); ok {
+				continue
+			}
+			return SharedConfigBaseProfileError{
+				Profile: profile, BaseProfile: base, Err: err,
+			}
+		}
+		found = true
+	}
+	if !found {
+		return SharedConfigBaseProfileError{
+			Profile: profile, BaseProfile: base,
+			Err: SharedConfigProfileNotExistsError{Profile: base},
+		}
+	}
+
+	cfg.clearCredentialOptions()
+	cfg.clearAssumeRoleOptions()
+
+	return nil
+}
+
 // setFromFile loads the configuration from the file using the profile
 // provided. A sharedConfig pointer type value is used so that multiple config
 // file loadings can be chained.
@@ -255,13 +451,9 @@ func (cfg *sharedConfig) setFromIniFiles(profiles map[string]struct{}, profile s
 // example if a config file only includes aws_access_key_id but no
 // aws_secret_access_key the aws_access_key_id will be ignored.
 func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, exOpts bool) error {
-	section, ok := file.IniData.GetSection(profile)
+	section, ok := file.profileSection(profile)
 	if !ok {
-		// Fallback to to alternate profile name: profile <name>
-		section, ok = file.IniData.GetSection(fmt.Sprintf("profile %s", profile))
-		if !ok {
-			return SharedConfigProfileNotExistsError{Profile: profile, Err: nil}
-		}
+		return SharedConfigProfileNotExistsError{Profile: profile, Err: nil}
 	}
 
 	if exOpts {
@@ -271,6 +463,27 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 		updateString(&cfg.MFASerial, section, mfaSerialKey)
 		updateString(&cfg.RoleSessionName, section, roleSessionNameKey)
 		updateString(&cfg.SourceProfileName, section, sourceProfileKey)
+		updateStringList(&cfg.TransitiveTagKeys, section, transitiveTagKeysKey)
+		updateStringList(&cfg.PolicyARNs, section, policyARNsKey)
+
+		if section.Has(roleSessionTagsKey) {
+			tags, err := parseRoleSessionTags(section.String(roleSessionTagsKey))
+			if err != nil {
+				return fmt.Errorf("failed to load %s from shared config, %s, %v",
+					roleSessionTagsKey, file.Filename, err)
+			}
+			cfg.RoleSessionTags = tags
+		}
+
+		if section.Has(durationSecondsKey) {
+			seconds, err := strconv.Atoi(section.String(durationSecondsKey))
+			if err != nil || seconds <= 0 {
+				return fmt.Errorf("failed to load %s from shared config, %s, "+
+					"expect positive number of seconds, got %q",
+					durationSecondsKey, file.Filename, section.String(durationSecondsKey))
+			}
+			cfg.RoleDuration = time.Duration(seconds) * time.Second
+		}
 		updateString(&cfg.CredentialSource, section, credentialSourceKey)
 		updateString(&cfg.Region, section, regionKey)
 
@@ -291,6 +504,17 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 			}
 			cfg.S3UsEast1RegionalEndpoint = sre
 		}
+
+		updateString(&cfg.EC2IMDSEndpoint, section, ec2MetadataServiceEndpointKey)
+
+		if v := section.String(ec2MetadataServiceEndpointModeKey); len(v) != 0 {
+			mode, err := endpoints.GetEC2IMDSEndpointMode(v)
+			if err != nil {
+				return fmt.Errorf("failed to load %s from shared config, %s, %v",
+					ec2MetadataServiceEndpointModeKey, file.Filename, err)
+			}
+			cfg.EC2IMDSEndpointMode = mode
+		}
 	}
 
 	updateString(&cfg.CredentialProcess, section, credentialProcessKey)
@@ -302,6 +526,11 @@ func (cfg *sharedConfig) setFromIniFile(profile string, file sharedConfigFile, e
 		SecretAccessKey: section.String(secretAccessKey),
 		SessionToken:    section.String(sessionTokenKey),
 		ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", file.Filename),
+		Source: credentials.Source{
+			Kind:     credentials.SourceSharedConfigFile,
+			Filename: file.Filename,
+			Profile:  profile,
+		},
 	}
 	if creds.HasKeys() {
 		cfg.Creds = creds
@@ -384,6 +613,10 @@ func (cfg *sharedConfig) clearAssumeRoleOptions() {
 	cfg.MFASerial = ""
 	cfg.RoleSessionName = ""
 	cfg.SourceProfileName = ""
+	cfg.RoleSessionTags = nil
+	cfg.TransitiveTagKeys = nil
+	cfg.PolicyARNs = nil
+	cfg.RoleDuration = 0
 }
 
 func oneOrNone(bs ...bool) bool {
@@ -410,6 +643,46 @@ func updateString(dst *string, section ini.Section, key string) {
 	*dst = section.String(key)
 }
 
+// updateStringList will only update the dst with the comma separated values
+// in the section key, key is present in the section. Empty values are
+// ignored.
+func updateStringList(dst *[]string, section ini.Section, key string) {
+	if !section.Has(key) {
+		return
+	}
+
+	var vs []string
+	for _, v := range strings.Split(section.String(key), ",") {
+		if v = strings.TrimSpace(v); len(v) != 0 {
+			vs = append(vs, v)
+		}
+	}
+	*dst = vs
+}
+
+// parseRoleSessionTags parses the comma separated list of key=value session
+// tags. Values may be empty, but keys must not.
+func parseRoleSessionTags(v string) ([]*sts.Tag, error) {
+	var tags []*sts.Tag
+	for _, pair := range strings.Split(v, ",") {
+		if pair = strings.TrimSpace(pair); len(pair) == 0 {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || len(key) == 0 {
+			return nil, fmt.Errorf("invalid session tag %q, expect key=value", pair)
+		}
+		tags = append(tags, &sts.Tag{
+			Key:   aws.String(key),
+			Value: aws.String(strings.TrimSpace(parts[1])),
+		})
+	}
+
+	return tags, nil
+}
+
 // updateBool will only update the dst with the value in the section key, key
 // is present in the section.
 func updateBool(dst *bool, section ini.Section, key string) {
@@ -545,3 +818,66 @@ func (e CredentialRequiresARNError) OrigErr() error {
 func (e CredentialRequiresARNError) Error() string {
 	return awserr.SprintError(e.Code(), e.Message(), "", nil)
 }
+
+// SharedConfigIncludeError is an error for the shared config when a file
+// included by a config file failed to load, or the included files form a
+// cycle.
+type SharedConfigIncludeError struct {
+	// The config file with the include directive.
+	Filename string
+
+	// The file included.
+	Include string
+
+	Err error
+}
+
+// Code is the short id of the error.
+func (e SharedConfigIncludeError) Code() string {
+	return "SharedConfigIncludeError"
+}
+
+// Message is the description of the error
+func (e SharedConfigIncludeError) Message() string {
+	return fmt.Sprintf("failed to include config file %s, in %s", e.Include, e.Filename)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigIncludeError) OrigErr() error {
+	return e.Err
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigIncludeError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", e.Err)
+}
+
+// SharedConfigBaseProfileError is an error for the shared config when the
+// base profile of a profile does not exist, failed to load, or the base
+// profiles form a cycle.
+type SharedConfigBaseProfileError struct {
+	Profile     string
+	BaseProfile string
+	Err         error
+}
+
+// Code is the short id of the error.
+func (e SharedConfigBaseProfileError) Code() string {
+	return "SharedConfigBaseProfileError"
+}
+
+// Message is the description of the error
+func (e SharedConfigBaseProfileError) Message() string {
+	return fmt.Sprintf("failed to load base profile %s, for profile %s",
+		e.BaseProfile, e.Profile)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigBaseProfileError) OrigErr() error {
+	return e.Err
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigBaseProfileError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", e.Err)
+}
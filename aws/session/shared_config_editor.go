@@ -0,0 +1,221 @@
+package session
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/ini"
+)
+
+// SharedConfigEditor provides editing of the profiles of a shared config
+// (~/.aws/config) or shared credentials (~/.aws/credentials) file. Comments,
+// whitespace, and the order of the profiles and their keys are preserved
+// when the file is saved. Only the lines of the keys and profiles modified
+// are changed.
+//
+// Use EditSharedConfigFile or EditSharedCredentialsFile to create the editor
+// for the file's format. The shared config file's profiles, other than the
+// default profile, are named "[profile name]", whereas the shared credentials
+// file's profiles are named "[name]".
+//
+//     editor, err := session.EditSharedCredentialsFile(
+//         defaults.SharedCredentialsFilename())
+//     if err != nil {
+//         return err
+//     }
+//
+//     if err := editor.SetCredentials("my_profile", creds); err != nil {
+//         return err
+//     }
+//     if err := editor.Save(); err != nil {
+//         return err
+//     }
+type SharedConfigEditor struct {
+	// The path of the file the editor was loaded from, and will be saved to.
+	Filename string
+
+	credentialsFile bool
+	doc             *ini.Document
+}
+
+// EditSharedConfigFile loads the shared config file (~/.aws/config) at the
+// filename for editing. An empty editor is returned if the file does not
+// exist, and the file will be created when the editor is saved.
+func EditSharedConfigFile(filename string) (*SharedConfigEditor, error) {
+	return newSharedConfigEditor(filename, false)
+}
+
+// EditSharedCredentialsFile loads the shared credentials file
+// (~/.aws/credentials) at the filename for editing. An empty editor is
+// returned if the file does not exist, and the file will be created when the
+// editor is saved.
+func EditSharedCredentialsFile(filename string) (*SharedConfigEditor, error) {
+	return newSharedConfigEditor(filename, true)
+}
+
+func newSharedConfigEditor(filename string, credentialsFile bool) (*SharedConfigEditor, error) {
+	doc, err := ini.OpenDocument(filename)
+	if err != nil {
+		return nil, SharedConfigLoadError{Filename: filename, Err: err}
+	}
+
+	return &SharedConfigEditor{
+		Filename:        filename,
+		credentialsFile: credentialsFile,
+		doc:             doc,
+	}, nil
+}
+
+// Profiles returns the names of the profiles defined in the file, in the
+// order they are defined.
+func (e *SharedConfigEditor) Profiles() []string {
+	var profiles []string
+	seen := map[string]bool{}
+	for _, section := range e.doc.Sections() {
+		profile := strings.TrimSpace(strings.TrimPrefix(section, "profile "))
+		if !seen[profile] {
+			seen[profile] = true
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
+}
+
+// HasProfile returns if the profile is defined in the file.
+func (e *SharedConfigEditor) HasProfile(profile string) bool {
+	_, ok := e.existingSection(profile)
+	return ok
+}
+
+// Value returns the value of the key in the profile, and if the key is set.
+func (e *SharedConfigEditor) Value(profile, key string) (string, bool) {
+	section, ok := e.existingSection(profile)
+	if !ok {
+		return "", false
+	}
+	return e.doc.Value(section, key)
+}
+
+// SetValue sets the value of the key in the profile. The profile is added to
+// the end of the file if it is not defined. An error is returned if the
+// profile name, key, or value cannot be written to the file, such as values
+// with line breaks.
+func (e *SharedConfigEditor) SetValue(profile, key, value string) error {
+	return e.doc.SetValue(e.section(profile), key, value)
+}
+
+// DeleteValue removes the key from the profile. Returns false if the key was
+// not set.
+func (e *SharedConfigEditor) DeleteValue(profile, key string) bool {
+	section, ok := e.existingSection(profile)
+	if !ok {
+		return false
+	}
+	return e.doc.DeleteValue(section, key)
+}
+
+// DeleteProfile removes the profile and its keys from the file. Returns false
+// if the profile was not defined.
+func (e *SharedConfigEditor) DeleteProfile(profile string) bool {
+	section, ok := e.existingSection(profile)
+	if !ok {
+		return false
+	}
+	return e.doc.DeleteSection(section)
+}
+
+// SetCredentials sets the access key ID, secret access key, and session
+// token of the profile to the credentials' values. The profile's session
+// token is removed if the credentials do not have a session token. An error
+// is returned if the values cannot be written to the file, and the profile is
+// not modified.
+func (e *SharedConfigEditor) SetCredentials(profile string, creds credentials.Value) error {
+	values := []string{creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken}
+	for i, key := range []string{accessKeyIDKey, secretAccessKey, sessionTokenKey} {
+		if err := ini.ValidateValue(key, values[i]); err != nil {
+			return err
+		}
+	}
+
+	if err := e.SetValue(profile, accessKeyIDKey, creds.AccessKeyID); err != nil {
+		return err
+	}
+	if err := e.SetValue(profile, secretAccessKey, creds.SecretAccessKey); err != nil {
+		return err
+	}
+	if len(creds.SessionToken) != 0 {
+		return e.SetValue(profile, sessionTokenKey, creds.SessionToken)
+	}
+	e.DeleteValue(profile, sessionTokenKey)
+	return nil
+}
+
+// Bytes returns the content of the file with the edits applied.
+func (e *SharedConfigEditor) Bytes() []byte {
+	return e.doc.Bytes()
+}
+
+// Save writes the edited file to the editor's Filename. The file is written
+// atomically, readers of the file will observe either the previous or the
+// edited content, never a partially written file. The permissions of an
+// existing file are preserved, and new files are created readable and
+// writable only by the owner.
+func (e *SharedConfigEditor) Save() error {
+	if err := e.doc.WriteFile(e.Filename, 0600); err != nil {
+		return SharedConfigWriteError{Filename: e.Filename, Err: err}
+	}
+	return nil
+}
+
+// existingSection returns the name of the section of the profile defined in
+// the file.
+func (e *SharedConfigEditor) existingSection(profile string) (string, bool) {
+	for _, section := range []string{profile, fmt.Sprintf("profile %s", profile)} {
+		if e.doc.HasSection(section) {
+			return section, true
+		}
+	}
+	return "", false
+}
+
+// section returns the name of the section of the profile, either the section
+// already defined in the file, or the name of the section for the file's
+// format if the profile is not defined.
+func (e *SharedConfigEditor) section(profile string) string {
+	if section, ok := e.existingSection(profile); ok {
+		return section
+	}
+	if e.credentialsFile || profile == DefaultSharedConfigProfile {
+		return profile
+	}
+	return fmt.Sprintf("profile %s", profile)
+}
+
+// SharedConfigWriteError is an error for the shared config file failed to be
+// written.
+type SharedConfigWriteError struct {
+	Filename string
+	Err      error
+}
+
+// Code is the short id of the error.
+func (e SharedConfigWriteError) Code() string {
+	return "SharedConfigWriteError"
+}
+
+// Message is the description of the error
+func (e SharedConfigWriteError) Message() string {
+	return fmt.Sprintf("failed to write config file, %s", e.Filename)
+}
+
+// OrigErr is the underlying error that caused the failure.
+func (e SharedConfigWriteError) OrigErr() error {
+	return e.Err
+}
+
+// Error satisfies the error interface.
+func (e SharedConfigWriteError) Error() string {
+	return awserr.SprintError(e.Code(), e.Message(), "", e.Err)
+}
@@ -0,0 +1,175 @@
+// +build go1.7
+
+package session
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestSharedConfigEditor(t *testing.T) {
+	cases := map[string]struct {
+		Credentials bool
+		Content     string
+		Edit        func(*SharedConfigEditor) error
+		Expect      string
+	}{
+		"config new profile": {
+			Content: "# comment\n[default]\nregion = us-west-2\n",
+			Edit: func(e *SharedConfigEditor) error {
+				return e.SetValue("foo", "region", "us-east-1")
+			},
+			Expect: "# comment\n[default]\nregion = us-west-2\n\n[profile foo]\nregion = us-east-1\n",
+		},
+		"config default profile": {
+			Edit: func(e *SharedConfigEditor) error {
+				return e.SetValue("default", "region", "us-east-1")
+			},
+			Expect: "[default]\nregion = us-east-1\n",
+		},
+		"credentials new profile": {
+			Credentials: true,
+			Content:     "[default]\naws_access_key_id = AKID\n",
+			Edit: func(e *SharedConfigEditor) error {
+				return e.SetCredentials("foo", credentials.Value{
+					AccessKeyID: "FOO_AKID", SecretAccessKey: "FOO_SECRET", SessionToken: "FOO_TOKEN",
+				})
+			},
+			Expect: "[default]\naws_access_key_id = AKID\n\n[foo]\naws_access_key_id = FOO_AKID\naws_secret_access_key = FOO_SECRET\naws_session_token = FOO_TOKEN\n",
+		},
+		"refresh credentials": {
+			Credentials: true,
+			Content:     "[foo] ; comment\naws_access_key_id = OLD_AKID\naws_secret_access_key = OLD_SECRET\naws_session_token = OLD_TOKEN\n# trailing comment\n",
+			Edit: func(e *SharedConfigEditor) error {
+				return e.SetCredentials("foo", credentials.Value{
+					AccessKeyID: "NEW_AKID", SecretAccessKey: "NEW_SECRET",
+				})
+			},
+			Expect: "[foo] ; comment\naws_access_key_id = NEW_AKID\naws_secret_access_key = NEW_SECRET\n# trailing comment\n",
+		},
+		"existing profile name": {
+			Credentials: true,
+			Content:     "[profile foo]\nregion = us-west-2\n",
+			Edit: func(e *SharedConfigEditor) error {
+				return e.SetValue("foo", "region", "us-east-1")
+			},
+			Expect: "[profile foo]\nregion = us-east-1\n",
+		},
+		"delete profile": {
+			Content: "[default]\nregion = us-west-2\n\n[profile foo]\nregion = us-east-1\n",
+			Edit: func(e *SharedConfigEditor) error {
+				if !e.DeleteProfile("foo") {
+					t.Errorf("expect profile deleted")
+				}
+				return nil
+			},
+			Expect: "[default]\nregion = us-west-2\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "shared_config_editor")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			defer os.RemoveAll(dir)
+
+			filename := filepath.Join(dir, "config")
+			if len(c.Content) != 0 {
+				if err := ioutil.WriteFile(filename, []byte(c.Content), 0644); err != nil {
+					t.Fatalf("expect no error, got %v", err)
+				}
+			}
+
+			edit := EditSharedConfigFile
+			if c.Credentials {
+				edit = EditSharedCredentialsFile
+			}
+			editor, err := edit(filename)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if err := c.Edit(editor); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if err := editor.Save(); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			b, err := ioutil.ReadFile(filename)
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(b); e != a {
+				t.Errorf("expect\n%v\ngot\n%v", e, a)
+			}
+		})
+	}
+}
+
+func TestSharedConfigEditor_Values(t *testing.T) {
+	editor, err := EditSharedConfigFile(testConfigFilename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	profiles := editor.Profiles()
+	if e, a := "default", profiles[0]; e != a {
+		t.Errorf("expect %v first profile, got %v", e, a)
+	}
+	if !editor.HasProfile("assume_role") {
+		t.Errorf("expect assume_role profile")
+	}
+
+	v, ok := editor.Value("assume_role", "role_arn")
+	if !ok {
+		t.Fatalf("expect role_arn value")
+	}
+	if e, a := "assume_role_role_arn", v; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	if _, ok := editor.Value("profile_not_exists", "region"); ok {
+		t.Errorf("expect no value for profile not exists")
+	}
+
+	// Loading the file for editing must not change the file's content.
+	expect, err := ioutil.ReadFile(testConfigFilename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := expect, editor.Bytes(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect file content unchanged")
+	}
+}
+
+func TestSharedConfigEditor_SetCredentialsInvalid(t *testing.T) {
+	editor, err := EditSharedCredentialsFile(filepath.Join("testdata", "not_exists"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	err = editor.SetCredentials("foo", credentials.Value{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN\n[evil]\naws_access_key_id = EVIL",
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+
+	// The profile is not modified if any of the values are invalid.
+	if editor.HasProfile("foo") {
+		t.Errorf("expect profile not to be added")
+	}
+	if e, a := 0, len(editor.Bytes()); e != a {
+		t.Errorf("expect no content, got %q", editor.Bytes())
+	}
+}
@@ -9,15 +9,20 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/internal/ini"
+	"github.com/aws/aws-sdk-go/service/sts"
 )
 
 var (
-	testConfigFilename      = filepath.Join("testdata", "shared_config")
-	testConfigOtherFilename = filepath.Join("testdata", "shared_config_other")
+	testConfigFilename            = filepath.Join("testdata", "shared_config")
+	testConfigOtherFilename       = filepath.Join("testdata", "shared_config_other")
+	testConfigIncludeFilename     = filepath.Join("testdata", "shared_config_include")
+	testConfigIncludeBaseFilename = filepath.Join("testdata", "shared_config_include_base")
 )
 
 func TestLoadSharedConfig(t *testing.T) {
@@ -47,6 +52,11 @@ func TestLoadSharedConfig(t *testing.T) {
 					AccessKeyID:     "shared_config_akid",
 					SecretAccessKey: "shared_config_secret",
 					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigFilename,
+						Profile:  "config_file_load_order",
+					},
 				},
 			},
 		},
@@ -59,6 +69,11 @@ func TestLoadSharedConfig(t *testing.T) {
 					AccessKeyID:     "shared_config_other_akid",
 					SecretAccessKey: "shared_config_other_secret",
 					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigOtherFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigOtherFilename,
+						Profile:  "config_file_load_order",
+					},
 				},
 			},
 		},
@@ -73,6 +88,11 @@ func TestLoadSharedConfig(t *testing.T) {
 						AccessKeyID:     "complete_creds_akid",
 						SecretAccessKey: "complete_creds_secret",
 						ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+						Source: credentials.Source{
+							Kind:     credentials.SourceSharedConfigFile,
+							Filename: testConfigFilename,
+							Profile:  "complete_creds",
+						},
 					},
 				},
 			},
@@ -102,6 +122,11 @@ func TestLoadSharedConfig(t *testing.T) {
 						AccessKeyID:     "assume_role_w_creds_akid",
 						SecretAccessKey: "assume_role_w_creds_secret",
 						ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+						Source: credentials.Source{
+							Kind:     credentials.SourceSharedConfigFile,
+							Filename: testConfigFilename,
+							Profile:  "assume_role_w_creds",
+						},
 					},
 				},
 			},
@@ -145,11 +170,64 @@ func TestLoadSharedConfig(t *testing.T) {
 							AccessKeyID:     "complete_creds_akid",
 							SecretAccessKey: "complete_creds_secret",
 							ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+							Source: credentials.Source{
+								Kind:     credentials.SourceSharedConfigFile,
+								Filename: testConfigFilename,
+								Profile:  "complete_creds",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Filenames: []string{testConfigOtherFilename, testConfigFilename},
+			Profile:   "assume_role_session_options",
+			Expected: sharedConfig{
+				RoleARN:           "assume_role_session_options_role_arn",
+				SourceProfileName: "assume_role_session_options_source",
+				PolicyARNs: []string{
+					"arn:aws:iam::aws:policy/ReadOnlyAccess",
+					"arn:aws:iam::123456789012:policy/team",
+				},
+				RoleDuration: time.Hour,
+				SourceProfile: &sharedConfig{
+					RoleARN:           "assume_role_session_options_source_role_arn",
+					SourceProfileName: "complete_creds",
+					RoleSessionTags: []*sts.Tag{
+						{Key: aws.String("Project"), Value: aws.String("alpha")},
+						{Key: aws.String("Team"), Value: aws.String("sdk")},
+					},
+					TransitiveTagKeys: []string{"Project"},
+					SourceProfile: &sharedConfig{
+						Creds: credentials.Value{
+							AccessKeyID:     "complete_creds_akid",
+							SecretAccessKey: "complete_creds_secret",
+							ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+							Source: credentials.Source{
+								Kind:     credentials.SourceSharedConfigFile,
+								Filename: testConfigFilename,
+								Profile:  "complete_creds",
+							},
 						},
 					},
 				},
 			},
 		},
+		{
+			Filenames: []string{testConfigOtherFilename, testConfigFilename},
+			Profile:   "assume_role_invalid_session_tags",
+			Err: fmt.Errorf("failed to load %s from shared config, %s, %v",
+				roleSessionTagsKey, testConfigFilename,
+				`invalid session tag "Project", expect key=value`),
+		},
+		{
+			Filenames: []string{testConfigOtherFilename, testConfigFilename},
+			Profile:   "assume_role_invalid_duration_seconds",
+			Err: fmt.Errorf("failed to load %s from shared config, %s, "+
+				"expect positive number of seconds, got %q",
+				durationSecondsKey, testConfigFilename, "one hour"),
+		},
 		{
 			Filenames: []string{testConfigOtherFilename, testConfigFilename},
 			Profile:   "multiple_assume_role_with_credential_source",
@@ -192,6 +270,80 @@ func TestLoadSharedConfig(t *testing.T) {
 				S3UsEast1RegionalEndpoint: endpoints.RegionalS3UsEast1Endpoint,
 			},
 		},
+		{
+			Filenames: []string{testConfigFilename},
+			Profile:   "with_ec2_metadata_service_endpoint",
+			Expected: sharedConfig{
+				EC2IMDSEndpoint:     "http://[::1]",
+				EC2IMDSEndpointMode: endpoints.EC2IMDSEndpointModeStateIPv6,
+			},
+		},
+		{
+			Filenames: []string{testConfigIncludeFilename},
+			Profile:   "include_override",
+			Expected: sharedConfig{
+				Region:         "include_override_region",
+				S3UseARNRegion: true,
+			},
+		},
+		{
+			Filenames: []string{testConfigIncludeFilename},
+			Profile:   "base_child",
+			Expected: sharedConfig{
+				Region:         "base_parent_region",
+				S3UseARNRegion: true,
+				Creds: credentials.Value{
+					AccessKeyID:     "base_child_akid",
+					SecretAccessKey: "base_child_secret",
+					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigIncludeFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigIncludeFilename,
+						Profile:  "base_child",
+					},
+				},
+			},
+		},
+		{
+			Filenames: []string{testConfigIncludeFilename},
+			Profile:   "base_override",
+			Expected: sharedConfig{
+				Region:         "base_override_region",
+				S3UseARNRegion: true,
+			},
+		},
+		{
+			Filenames: []string{testConfigIncludeFilename},
+			Profile:   "base_cycle_a",
+			Err: SharedConfigBaseProfileError{
+				Profile: "base_cycle_b", BaseProfile: "base_cycle_a",
+				Err: fmt.Errorf("base profile cycle, base_cycle_a -> base_cycle_b -> base_cycle_a"),
+			},
+		},
+		{
+			Filenames: []string{testConfigIncludeFilename},
+			Profile:   "base_not_exists",
+			Err: SharedConfigBaseProfileError{
+				Profile: "base_not_exists", BaseProfile: "profile_not_exists",
+				Err: SharedConfigProfileNotExistsError{Profile: "profile_not_exists"},
+			},
+		},
+		{
+			Filenames: []string{filepath.Join("testdata", "shared_config_include_cycle")},
+			Profile:   "default",
+			Err: SharedConfigIncludeError{
+				Filename: filepath.Join("testdata", "shared_config_include_cycle_other"),
+				Include:  filepath.Join("testdata", "shared_config_include_cycle"),
+			},
+		},
+		{
+			Filenames: []string{filepath.Join("testdata", "shared_config_include_not_exists")},
+			Profile:   "default",
+			Err: SharedConfigIncludeError{
+				Filename: filepath.Join("testdata", "shared_config_include_not_exists"),
+				Include:  filepath.Join("testdata", "file_not_exists"),
+			},
+		},
 	}
 
 	for i, c := range cases {
@@ -253,6 +405,11 @@ func TestLoadSharedConfigFromFile(t *testing.T) {
 					AccessKeyID:     "complete_creds_akid",
 					SecretAccessKey: "complete_creds_secret",
 					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigFilename,
+						Profile:  "complete_creds",
+					},
 				},
 			},
 		},
@@ -264,6 +421,11 @@ func TestLoadSharedConfigFromFile(t *testing.T) {
 					SecretAccessKey: "complete_creds_with_token_secret",
 					SessionToken:    "complete_creds_with_token_token",
 					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigFilename,
+						Profile:  "complete_creds_with_token",
+					},
 				},
 			},
 		},
@@ -274,6 +436,11 @@ func TestLoadSharedConfigFromFile(t *testing.T) {
 					AccessKeyID:     "full_profile_akid",
 					SecretAccessKey: "full_profile_secret",
 					ProviderName:    fmt.Sprintf("SharedConfigCredentials: %s", testConfigFilename),
+					Source: credentials.Source{
+						Kind:     credentials.SourceSharedConfigFile,
+						Filename: testConfigFilename,
+						Profile:  "full_profile",
+					},
 				},
 				Region: "full_profile_region",
 			},
@@ -354,6 +521,13 @@ func TestLoadSharedConfigIniFiles(t *testing.T) {
 				{Filename: testConfigOtherFilename},
 			},
 		},
+		{
+			Filenames: []string{testConfigIncludeFilename, testConfigIncludeBaseFilename},
+			Expected: []sharedConfigFile{
+				{Filename: testConfigIncludeBaseFilename, IncludedBy: testConfigIncludeFilename},
+				{Filename: testConfigIncludeFilename},
+			},
+		},
 	}
 
 	for i, c := range cases {
@@ -370,6 +544,9 @@ func TestLoadSharedConfigIniFiles(t *testing.T) {
 				if e, a := expectedFile.Filename, files[i].Filename; e != a {
 					t.Errorf("expect %v, got %v", e, a)
 				}
+				if e, a := expectedFile.IncludedBy, files[i].IncludedBy; e != a {
+					t.Errorf("expect %v included by, got %v", e, a)
+				}
 			}
 		})
 	}
@@ -205,6 +205,10 @@ type Signer struct {
 	// UnsignedPayload will prevent signing of the payload. This will only
 	// work for services that have support for this.
 	UnsignedPayload bool
+
+	// logFields are the fields of the SDK request being signed, attached to
+	// the signing log entries.
+	logFields []aws.LogField
 }
 
 // NewSigner returns a Signer pointer configured with the credentials and optional
@@ -340,7 +344,7 @@ func (v4 Signer) signWithBody(r *http.Request, body io.ReadSeeker, service, regi
 	}
 
 	var err error
-	ctx.credValues, err = v4.Credentials.Get()
+	ctx.credValues, err = v4.Credentials.GetWithContext(requestContext(r))
 	if err != nil {
 		return http.Header{}, err
 	}
@@ -410,9 +414,11 @@ func (ctx *signingCtx) assignAmzQueryValues() {
 // SignRequestHandler is a named request handler the SDK will use to sign
 // service client request with using the V4 signature.
 var SignRequestHandler = request.NamedHandler{
-	Name: "v4.SignRequestHandler", Fn: SignSDKRequest,
+	Name: signRequestHandlerName, Fn: SignSDKRequest,
 }
 
+const signRequestHandlerName = "v4.SignRequestHandler"
+
 // SignSDKRequest signs an AWS request with the V4 signature. This
 // request handler should only be used with the SDK's built in service client's
 // API operation requests.
@@ -433,7 +439,7 @@ func BuildNamedHandler(name string, opts ...func(*Signer)) request.NamedHandler
 	return request.NamedHandler{
 		Name: name,
 		Fn: func(req *request.Request) {
-			SignSDKRequestWithCurrentTime(req, time.Now, opts...)
+			signSDKRequestWithCurrentTime(req, name, time.Now, opts...)
 		},
 	}
 }
@@ -442,6 +448,12 @@ func BuildNamedHandler(name string, opts ...func(*Signer)) request.NamedHandler
 // function passed in. Behaves the same as SignSDKRequest with the exception
 // the request is signed with the value returned by the current time function.
 func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.Time, opts ...func(*Signer)) {
+	signSDKRequestWithCurrentTime(req, signRequestHandlerName, curTimeFn, opts...)
+}
+
+// signSDKRequestWithCurrentTime signs the SDK's request, attaching the name of
+// the signing handler to the signer's log entries.
+func signSDKRequestWithCurrentTime(req *request.Request, handlerName string, curTimeFn func() time.Time, opts ...func(*Signer)) {
 	// If the request does not need to be signed ignore the signing of the
 	// request if the AnonymousCredentials object is used.
 	if req.Config.Credentials == credentials.AnonymousCredentials {
@@ -461,6 +473,8 @@ func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.T
 	v4 := NewSigner(req.Config.Credentials, func(v4 *Signer) {
 		v4.Debug = req.Config.LogLevel.Value()
 		v4.Logger = req.Config.Logger
+		v4.logFields = append(req.LogFields(),
+			aws.LogField{Key: aws.LogFieldHandler, Value: handlerName})
 		v4.DisableHeaderHoisting = req.NotHoist
 		v4.currentTimeFn = curTimeFn
 		if name == "s3" {
@@ -491,7 +505,7 @@ func SignSDKRequestWithCurrentTime(req *request.Request, curTimeFn func() time.T
 	req.LastSignedAt = curTime
 }
 
-const logSignInfoMsg = `DEBUG: Request Signature:
+const logSignInfoMsg = `Request Signature:
 ---[ CANONICAL STRING  ]-----------------------------
 %s
 ---[ STRING TO SIGN ]--------------------------------
@@ -507,7 +521,13 @@ func (v4 *Signer) logSigningInfo(ctx *signingCtx) {
 		signedURLMsg = fmt.Sprintf(logSignedURLMsg, ctx.Request.URL.String())
 	}
 	msg := fmt.Sprintf(logSignInfoMsg, ctx.canonicalString, ctx.stringToSign, signedURLMsg)
-	v4.Logger.Log(msg)
+
+	fields := v4.logFields
+	if fields == nil {
+		fields = []aws.LogField{{Key: aws.LogFieldService, Value: ctx.ServiceName}}
+	}
+	fields = append(fields, aws.LogField{Key: "region", Value: ctx.Region})
+	aws.LogWithFields(v4.Logger, aws.LogSeverityDebug, msg, fields...)
 }
 
 func (ctx *signingCtx) build(disableHeaderHoisting bool) error {
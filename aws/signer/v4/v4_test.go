@@ -767,3 +767,47 @@ func (r *readerSeekerWrapper) Seek(offset int64, whence int) (int64, error) {
 func (r *readerSeekerWrapper) Len() int {
 	return r.r.Len()
 }
+
+func TestSignSDKRequest_LogSigningInfo(t *testing.T) {
+	var entries []string
+	fields := map[string]interface{}{}
+	svc := awstesting.NewClient(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-west-2"),
+		LogLevel:    aws.LogLevel(aws.LogDebugWithSigning),
+		Logger: aws.StructuredLoggerFunc(
+			func(s aws.LogSeverity, msg string, fs ...aws.LogField) {
+				if e, a := aws.LogSeverityDebug, s; e != a {
+					t.Errorf("expect %v severity, got %v", e, a)
+				}
+				entries = append(entries, msg)
+				for _, f := range fs {
+					fields[f.Key] = f.Value
+				}
+			}),
+	})
+	r := svc.NewRequest(&request.Operation{
+		Name:       "BatchGetItem",
+		HTTPMethod: "POST",
+		HTTPPath:   "/",
+	}, nil, nil)
+
+	SignSDKRequest(r)
+
+	if e, a := 1, len(entries); e != a {
+		t.Fatalf("expect %v log entries, got %v", e, a)
+	}
+	if e, a := "Request Signature:\n", entries[0]; !strings.HasPrefix(a, e) {
+		t.Errorf("expect %q message prefix, got %q", e, a)
+	}
+	expect := map[string]interface{}{
+		aws.LogFieldOperation: "BatchGetItem",
+		aws.LogFieldHandler:   "v4.SignRequestHandler",
+		"region":              "us-west-2",
+	}
+	for k, e := range expect {
+		if a := fields[k]; e != a {
+			t.Errorf("expect %v %v field, got %v", e, k, a)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+// Package waiter provides waiters for API operations of the service clients
+// which are defined at runtime, in addition to the waiters generated for the
+// service clients. Waiters are defined in the format of the API models'
+// waiters-2.json documents, or with the Definition type.
+//
+//    defs, err := waiter.LoadFile("waiters-2.json")
+//    if err != nil {
+//        return err
+//    }
+//
+//    err = defs.WaitWithContext(ctx, "TableActive", dynamodb.New(sess),
+//        &dynamodb.DescribeTableInput{TableName: aws.String("myTable")})
+//
+// A waiter's API operation is called by name, with the client's
+// "<Operation>Request" method, e.g. "DescribeTableRequest".
+package waiter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	// ErrCodeInvalidDefinition is the error code of the error returned when a
+	// waiter definition is not valid, or its API operation cannot be called
+	// with the client and input.
+	ErrCodeInvalidDefinition = "InvalidWaiterDefinition"
+
+	// ErrCodeUnknownWaiter is the error code of the error returned when a
+	// waiter is not defined.
+	ErrCodeUnknownWaiter = "UnknownWaiter"
+)
+
+// DefaultMaxDelay is the maximum delay between attempts of waiters with a
+// MinDelay, if MaxDelay is not set.
+const DefaultMaxDelay = 120
+
+// Definitions are the waiter definitions keyed by the waiter's name, in the
+// format of the API models' waiters-2.json documents.
+type Definitions struct {
+	Version int                   `json:"version"`
+	Waiters map[string]Definition `json:"waiters"`
+}
+
+// A Definition defines a waiter for an API operation.
+type Definition struct {
+	// The name of the API operation, e.g. "DescribeTable".
+	Operation string `json:"operation"`
+
+	// The constant delay between attempts, in seconds. Not used if MinDelay
+	// is set.
+	Delay int `json:"delay"`
+
+	// The minimum and maximum delay between attempts, in seconds. If MinDelay
+	// is set, the waiter delays with a jittered exponential backoff between
+	// MinDelay and MaxDelay instead of the constant Delay. MaxDelay defaults
+	// to DefaultMaxDelay.
+	MinDelay int `json:"minDelay"`
+	MaxDelay int `json:"maxDelay"`
+
+	// The maximum number of times the API operation is called.
+	MaxAttempts int `json:"maxAttempts"`
+
+	// The acceptors matched against the response of each attempt, in order.
+	Acceptors []Acceptor `json:"acceptors"`
+}
+
+// An Acceptor defines a state of the resource the waiter is waiting on.
+type Acceptor struct {
+	// The waiter's state if the acceptor matches: "success", "failure", or
+	// "retry".
+	State string `json:"state"`
+
+	// How the response is matched: "path", "pathAll", "pathAny", "status",
+	// or "error".
+	Matcher string `json:"matcher"`
+
+	// The JMESPath expression of the output values matched by path matchers.
+	Argument string `json:"argument"`
+
+	// The expected value of the output values, HTTP status code, or error
+	// code.
+	Expected interface{} `json:"expected"`
+}
+
+// Load decodes the waiter definitions from the reader. The definitions are
+// validated when the waiter is created.
+func Load(r io.Reader) (Definitions, error) {
+	var defs Definitions
+	if err := json.NewDecoder(r).Decode(&defs); err != nil {
+		return Definitions{}, awserr.New(request.ErrCodeSerialization,
+			"failed to decode waiter definitions", err)
+	}
+
+	return defs, nil
+}
+
+// LoadFile loads the waiter definitions from the file, e.g. an API model's
+// waiters-2.json file.
+func LoadFile(filename string) (Definitions, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return Definitions{}, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+// WaitWithContext waits with the named waiter, calling the waiter's API
+// operation on the client with the input. The input must be the API
+// operation's input type, or nil.
+//
+// The context must be non-nil and will be used for request cancellation, and
+// the delays between attempts. Returns the request.WaiterResourceNotReadyErrorCode
+// error code if the waiter fails or its max attempts are exhausted.
+func (d Definitions) WaitWithContext(ctx aws.Context, name string, c, input interface{}, opts ...request.WaiterOption) error {
+	def, ok := d.Waiters[name]
+	if !ok {
+		return awserr.New(ErrCodeUnknownWaiter,
+			fmt.Sprintf("waiter %s is not defined", name), nil)
+	}
+
+	w, err := def.NewWaiter(ctx, name, c, input)
+	if err != nil {
+		return err
+	}
+	w.ApplyOptions(opts...)
+
+	return w.WaitWithContext(ctx)
+}
+
+// NewWaiter returns the waiter of the definition, calling the definition's
+// API operation on the client with the input. The input must be the API
+// operation's input type, or nil. Returns an error if the definition is not
+// valid.
+//
+// The context must be non-nil and will be used for request cancellation.
+func (d Definition) NewWaiter(ctx aws.Context, name string, c, input interface{}) (request.Waiter, error) {
+	if d.MaxAttempts <= 0 {
+		return request.Waiter{}, invalidDefinitionError(name, "max attempts must be greater than zero", nil)
+	}
+	if d.Delay < 0 || d.MinDelay < 0 || d.MaxDelay < 0 {
+		return request.Waiter{}, invalidDefinitionError(name, "delays must not be negative", nil)
+	}
+	if len(d.Acceptors) == 0 {
+		return request.Waiter{}, invalidDefinitionError(name, "no acceptors defined", nil)
+	}
+
+	acceptors := make([]request.WaiterAcceptor, 0, len(d.Acceptors))
+	for _, a := range d.Acceptors {
+		acceptor, err := a.waiterAcceptor()
+		if err != nil {
+			return request.Waiter{}, invalidDefinitionError(name, "invalid acceptor", err)
+		}
+		acceptors = append(acceptors, acceptor)
+	}
+
+	newRequest, err := operationRequest(c, d.Operation, input)
+	if err != nil {
+		return request.Waiter{}, invalidDefinitionError(name, "invalid operation", err)
+	}
+
+	delay := request.ConstantWaiterDelay(time.Duration(d.Delay) * time.Second)
+	if d.MinDelay > 0 {
+		maxDelay := d.MaxDelay
+		if maxDelay == 0 {
+			maxDelay = DefaultMaxDelay
+		}
+		if maxDelay < d.MinDelay {
+			return request.Waiter{}, invalidDefinitionError(name,
+				"max delay must not be less than min delay", nil)
+		}
+		delay = request.ExponentialJitterWaiterDelay(
+			time.Duration(d.MinDelay)*time.Second,
+			time.Duration(maxDelay)*time.Second,
+		)
+	}
+
+	return request.Waiter{
+		Name:        name,
+		MaxAttempts: d.MaxAttempts,
+		Delay:       delay,
+		Acceptors:   acceptors,
+		Logger:      clientLogger(c),
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req := newRequest()
+			req.SetContext(ctx)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}, nil
+}
+
+func (a Acceptor) waiterAcceptor() (request.WaiterAcceptor, error) {
+	acceptor := request.WaiterAcceptor{
+		Argument: a.Argument,
+		Expected: a.Expected,
+	}
+
+	switch a.State {
+	case "success":
+		acceptor.State = request.SuccessWaiterState
+	case "failure":
+		acceptor.State = request.FailureWaiterState
+	case "retry":
+		acceptor.State = request.RetryWaiterState
+	default:
+		return acceptor, fmt.Errorf("unknown state %q", a.State)
+	}
+
+	switch a.Matcher {
+	case "path":
+		acceptor.Matcher = request.PathWaiterMatch
+	case "pathAll":
+		acceptor.Matcher = request.PathAllWaiterMatch
+	case "pathAny":
+		acceptor.Matcher = request.PathAnyWaiterMatch
+	case "status":
+		acceptor.Matcher = request.StatusWaiterMatch
+		switch v := a.Expected.(type) {
+		case int:
+		case float64:
+			acceptor.Expected = int(v)
+		default:
+			return acceptor, fmt.Errorf("status matcher expected %v is not a status code", a.Expected)
+		}
+	case "error":
+		acceptor.Matcher = request.ErrorWaiterMatch
+		if _, ok := a.Expected.(string); !ok {
+			return acceptor, fmt.Errorf("error matcher expected %v is not an error code", a.Expected)
+		}
+	default:
+		return acceptor, fmt.Errorf("unknown matcher %q", a.Matcher)
+	}
+
+	return acceptor, nil
+}
+
+var requestType = reflect.TypeOf((*request.Request)(nil))
+
+// operationRequest returns a function creating the API operation's request
+// with a copy of the input, using the client's "<Operation>Request" method.
+func operationRequest(c interface{}, operation string, input interface{}) (func() *request.Request, error) {
+	if len(operation) == 0 {
+		return nil, fmt.Errorf("operation not set")
+	}
+
+	method := reflect.ValueOf(c).MethodByName(operation + "Request")
+	if !method.IsValid() {
+		return nil, fmt.Errorf("client %T does not have the %s API operation", c, operation)
+	}
+	mt := method.Type()
+	if mt.NumIn() != 1 || mt.In(0).Kind() != reflect.Ptr ||
+		mt.NumOut() != 2 || mt.Out(0) != requestType {
+		return nil, fmt.Errorf("client %T %sRequest method is not an API operation", c, operation)
+	}
+
+	inType := mt.In(0)
+	in := reflect.New(inType.Elem())
+	if input != nil {
+		in = reflect.ValueOf(input)
+		if in.Type() != inType {
+			return nil, fmt.Errorf("input %T is not the %s API operation's input, %v",
+				input, operation, inType)
+		}
+	}
+
+	return func() *request.Request {
+		inCpy := reflect.New(inType.Elem())
+		if !in.IsNil() {
+			inCpy.Elem().Set(in.Elem())
+		}
+		return method.Call([]reflect.Value{inCpy})[0].Interface().(*request.Request)
+	}, nil
+}
+
+// clientLogger returns the logger of the service client's config, if any.
+func clientLogger(c interface{}) aws.Logger {
+	v := reflect.ValueOf(c)
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		if cl, ok := v.Interface().(*client.Client); ok {
+			return cl.Config.Logger
+		}
+
+		// Service clients embed the client.Client, and fakes of the clients
+		// embed the service client.
+		v = v.Elem()
+		if v.Kind() != reflect.Struct || v.NumField() == 0 || !v.Type().Field(0).Anonymous {
+			return nil
+		}
+		v = v.Field(0)
+	}
+
+	return nil
+}
+
+func invalidDefinitionError(name, msg string, err error) error {
+	return awserr.New(ErrCodeInvalidDefinition,
+		fmt.Sprintf("waiter %s %s", name, msg), err)
+}
@@ -0,0 +1,234 @@
+package waiter_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/waiter"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+const testWaiters = `{
+	"version": 2,
+	"waiters": {
+		"TableActive": {
+			"operation": "DescribeTable",
+			"delay": 20,
+			"maxAttempts": 5,
+			"acceptors": [
+				{"state": "success", "matcher": "path", "argument": "Table.TableStatus", "expected": "ACTIVE"},
+				{"state": "failure", "matcher": "path", "argument": "Table.TableStatus", "expected": "DELETING"},
+				{"state": "retry", "matcher": "error", "expected": "ResourceNotFoundException"}
+			]
+		},
+		"TableHasItems": {
+			"operation": "DescribeTable",
+			"minDelay": 2,
+			"maxDelay": 10,
+			"maxAttempts": 5,
+			"acceptors": [
+				{"state": "success", "matcher": "path", "argument": "Table.ItemCount", "expected": 3}
+			]
+		}
+	}
+}`
+
+func newTestClient(t *testing.T, resps []*dynamodb.DescribeTableOutput, delays *[]time.Duration) *dynamodb.DynamoDB {
+	svc := dynamodb.New(unit.Session, &aws.Config{
+		SleepDelay: func(d time.Duration) {
+			*delays = append(*delays, d)
+		},
+	})
+	svc.Handlers.Send.Clear() // mock sending
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.ValidateResponse.Clear()
+
+	reqNum := 0
+	svc.Handlers.Build.PushBack(func(r *request.Request) {
+		in := r.Params.(*dynamodb.DescribeTableInput)
+		if e, a := "myTable", aws.StringValue(in.TableName); e != a {
+			t.Errorf("expect %v table name, got %v", e, a)
+		}
+	})
+	svc.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		if reqNum >= len(resps) {
+			t.Fatalf("too many polling requests made")
+		}
+		if resps[reqNum] == nil {
+			r.Error = awserr.New(dynamodb.ErrCodeResourceNotFoundException, "not found", nil)
+		} else {
+			r.Data = resps[reqNum]
+		}
+		reqNum++
+	})
+
+	return svc
+}
+
+func TestDefinitions_WaitWithContext(t *testing.T) {
+	defs, err := waiter.Load(strings.NewReader(testWaiters))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var delays []time.Duration
+	svc := newTestClient(t, []*dynamodb.DescribeTableOutput{
+		nil,
+		{Table: &dynamodb.TableDescription{TableStatus: aws.String("CREATING")}},
+		{Table: &dynamodb.TableDescription{TableStatus: aws.String("ACTIVE")}},
+	}, &delays)
+
+	err = defs.WaitWithContext(aws.BackgroundContext(), "TableActive", svc,
+		&dynamodb.DescribeTableInput{TableName: aws.String("myTable")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []time.Duration{20 * time.Second, 20 * time.Second}, delays; len(e) != len(a) || e[0] != a[0] || e[1] != a[1] {
+		t.Errorf("expect %v delays, got %v", e, a)
+	}
+}
+
+func TestDefinitions_WaitWithContext_Failure(t *testing.T) {
+	defs, err := waiter.Load(strings.NewReader(testWaiters))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var delays []time.Duration
+	svc := newTestClient(t, []*dynamodb.DescribeTableOutput{
+		{Table: &dynamodb.TableDescription{TableStatus: aws.String("DELETING")}},
+	}, &delays)
+
+	err = defs.WaitWithContext(aws.BackgroundContext(), "TableActive", svc,
+		&dynamodb.DescribeTableInput{TableName: aws.String("myTable")})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.WaiterResourceNotReadyErrorCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestDefinitions_WaitWithContext_JitterDelay(t *testing.T) {
+	defs, err := waiter.Load(strings.NewReader(testWaiters))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var delays []time.Duration
+	svc := newTestClient(t, []*dynamodb.DescribeTableOutput{
+		{Table: &dynamodb.TableDescription{ItemCount: aws.Int64(0)}},
+		{Table: &dynamodb.TableDescription{ItemCount: aws.Int64(1)}},
+		{Table: &dynamodb.TableDescription{ItemCount: aws.Int64(2)}},
+		{Table: &dynamodb.TableDescription{ItemCount: aws.Int64(3)}},
+	}, &delays)
+
+	err = defs.WaitWithContext(aws.BackgroundContext(), "TableHasItems", svc,
+		&dynamodb.DescribeTableInput{TableName: aws.String("myTable")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 3, len(delays); e != a {
+		t.Fatalf("expect %v delays, got %v", e, a)
+	}
+	for i, d := range delays {
+		if d < 2*time.Second || d > 10*time.Second {
+			t.Errorf("expect %d delay between 2s and 10s, got %v", i, d)
+		}
+	}
+	if e, a := 2*time.Second, delays[0]; e != a {
+		t.Errorf("expect first delay %v, got %v", e, a)
+	}
+}
+
+func TestDefinitions_WaitWithContext_UnknownWaiter(t *testing.T) {
+	defs, err := waiter.Load(strings.NewReader(testWaiters))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	err = defs.WaitWithContext(aws.BackgroundContext(), "TableDeleted",
+		dynamodb.New(unit.Session), nil)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := waiter.ErrCodeUnknownWaiter, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestDefinition_NewWaiter_Invalid(t *testing.T) {
+	acceptors := []waiter.Acceptor{
+		{State: "success", Matcher: "status", Expected: 200.0},
+	}
+
+	cases := map[string]struct {
+		Definition waiter.Definition
+		Input      interface{}
+		ExpectErr  string
+	}{
+		"no max attempts": {
+			Definition: waiter.Definition{Operation: "DescribeTable", Acceptors: acceptors},
+			ExpectErr:  "max attempts",
+		},
+		"no acceptors": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1},
+			ExpectErr:  "no acceptors",
+		},
+		"unknown matcher": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1,
+				Acceptors: []waiter.Acceptor{{State: "success", Matcher: "pathList"}},
+			},
+			ExpectErr: "unknown matcher",
+		},
+		"unknown state": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1,
+				Acceptors: []waiter.Acceptor{{State: "done", Matcher: "path"}},
+			},
+			ExpectErr: "unknown state",
+		},
+		"invalid status": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1,
+				Acceptors: []waiter.Acceptor{{State: "success", Matcher: "status", Expected: "OK"}},
+			},
+			ExpectErr: "not a status code",
+		},
+		"unknown operation": {
+			Definition: waiter.Definition{Operation: "DescribeWidget", MaxAttempts: 1, Acceptors: acceptors},
+			ExpectErr:  "does not have the DescribeWidget API operation",
+		},
+		"wrong input": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1, Acceptors: acceptors},
+			Input:      &dynamodb.ListTablesInput{},
+			ExpectErr:  "is not the DescribeTable API operation's input",
+		},
+		"max delay less than min delay": {
+			Definition: waiter.Definition{Operation: "DescribeTable", MaxAttempts: 1, Acceptors: acceptors,
+				MinDelay: 10, MaxDelay: 5,
+			},
+			ExpectErr: "max delay",
+		},
+	}
+
+	svc := dynamodb.New(unit.Session)
+	for name, c := range cases {
+		_, err := c.Definition.NewWaiter(aws.BackgroundContext(), "Waiter", svc, c.Input)
+		if err == nil {
+			t.Errorf("%s, expect error, got none", name)
+			continue
+		}
+		aerr := err.(awserr.Error)
+		if e, a := waiter.ErrCodeInvalidDefinition, aerr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+			t.Errorf("%s, expect %q error, got %v", name, e, a)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package imds
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Credentials are the credentials of the instance's IAM role served by the
+// Emulator.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	Token           string
+	LastUpdated     time.Time
+	Expiration      time.Time
+}
+
+// SetRole sets the name of the instance's IAM role, issuing new credentials
+// for the role. The role is removed if the name is empty.
+func (e *Emulator) SetRole(name string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.role = name
+	e.creds = nil
+	if len(name) != 0 {
+		e.rotateCredentials(e.now())
+	}
+}
+
+// RotateCredentials issues new credentials for the instance's IAM role,
+// before the current credentials expire, returning the new credentials.
+func (e *Emulator) RotateCredentials() Credentials {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.rotateCredentials(e.now())
+	return *e.creds
+}
+
+// RoleCredentials returns the current credentials of the instance's IAM
+// role, and if the instance has a role.
+func (e *Emulator) RoleCredentials() (Credentials, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.creds == nil {
+		return Credentials{}, false
+	}
+	return *e.creds, true
+}
+
+func (e *Emulator) rotateCredentials(now time.Time) {
+	duration := e.CredentialsDuration
+	if duration <= 0 {
+		duration = DefaultCredentialsDuration
+	}
+
+	e.issued++
+	e.creds = &Credentials{
+		AccessKeyID:     fmt.Sprintf("ASIAIMDSEMULATOR%04d", e.issued),
+		SecretAccessKey: fmt.Sprintf("imds-emulator-secret-%d", e.issued),
+		Token:           fmt.Sprintf("imds-emulator-token-%d", e.issued),
+		LastUpdated:     now,
+		Expiration:      now.Add(duration),
+	}
+}
+
+// metadataTree returns the instance metadata, including the IAM role's
+// metadata if the instance has a role. The role's credentials are rotated
+// if they have expired.
+func (e *Emulator) metadataTree(now time.Time) map[string]string {
+	if len(e.role) == 0 {
+		return e.metadata
+	}
+
+	if !now.Before(e.creds.Expiration) {
+		e.rotateCredentials(now)
+	}
+
+	tree := make(map[string]string, len(e.metadata)+2)
+	for k, v := range e.metadata {
+		tree[k] = v
+	}
+
+	tree["iam/info"] = marshalJSON(struct {
+		Code               string
+		LastUpdated        string
+		InstanceProfileArn string
+		InstanceProfileId  string
+	}{
+		Code:               "Success",
+		LastUpdated:        e.creds.LastUpdated.UTC().Format(time.RFC3339),
+		InstanceProfileArn: "arn:aws:iam::123456789012:instance-profile/" + e.role,
+		InstanceProfileId:  "AIPAIMDSEMULATOR",
+	})
+	tree["iam/security-credentials/"+e.role] = marshalJSON(struct {
+		Code            string
+		LastUpdated     string
+		Type            string
+		AccessKeyId     string
+		SecretAccessKey string
+		Token           string
+		Expiration      string
+	}{
+		Code:            "Success",
+		LastUpdated:     e.creds.LastUpdated.UTC().Format(time.RFC3339),
+		Type:            "AWS-HMAC",
+		AccessKeyId:     e.creds.AccessKeyID,
+		SecretAccessKey: e.creds.SecretAccessKey,
+		Token:           e.creds.Token,
+		Expiration:      e.creds.Expiration.UTC().Format(time.RFC3339),
+	})
+
+	return tree
+}
+
+func marshalJSON(v interface{}) string {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal IMDS emulator response, %v", err))
+	}
+	return string(b)
+}
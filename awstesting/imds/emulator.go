@@ -0,0 +1,439 @@
+// Package imds provides an emulator of the EC2 Instance Metadata Service
+// (IMDS), for testing code using the EC2 instance metadata, such as the SDK's
+// ec2metadata client and ec2rolecreds provider, without an EC2 instance.
+//
+// The Emulator serves the instance metadata, user data, and dynamic data
+// configured, including the credentials of an IAM role which are rotated as
+// they expire. IMDSv2 session tokens are issued with the TTL requested and
+// the hop limit simulated, and can be required for all requests. Latency,
+// throttling, and errors, such as 401 responses, can be injected to test how
+// code handles an unreliable metadata service.
+//
+//    emulator := imds.New()
+//    emulator.SetMetadata("placement/region", "us-west-2")
+//    emulator.SetRole("my-role")
+//
+//    ts := httptest.NewServer(emulator)
+//    defer ts.Close()
+//
+//    sess := session.Must(session.NewSession(&aws.Config{
+//        EC2MetadataServiceEndpoint: aws.String(ts.URL),
+//    }))
+//    client := ec2metadata.New(sess)
+package imds
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// TokenPath is the path of the IMDSv2 session token API.
+	TokenPath = "/latest/api/token"
+
+	// TokenTTLHeader is the header of token requests, and token responses,
+	// with the token's TTL in seconds.
+	TokenTTLHeader = "X-Aws-Ec2-Metadata-Token-Ttl-Seconds"
+
+	// TokenHeader is the header of metadata requests with the IMDSv2 session
+	// token.
+	TokenHeader = "X-Aws-Ec2-Metadata-Token"
+
+	// MaxTokenTTL is the maximum TTL of a token, 6 hours.
+	MaxTokenTTL = 21600 * time.Second
+
+	// DefaultCredentialsDuration is the duration the IAM role credentials are
+	// valid for, if not set on the Emulator.
+	DefaultCredentialsDuration = 6 * time.Hour
+)
+
+// A Request is a request served by the Emulator.
+type Request struct {
+	// The HTTP method and URL path of the request.
+	Method string
+	Path   string
+
+	// The IMDSv2 session token the request was made with, if any.
+	Token string
+
+	// The HTTP status code of the response. Zero if the response was
+	// dropped, such as for a token request exceeding the hop limit.
+	StatusCode int
+}
+
+// A Fault is an error response injected for the requests matching the
+// fault's path, instead of the requests being served.
+type Fault struct {
+	// The URL path prefix of the requests the fault is injected for, such
+	// as "/latest/meta-data/iam/". All requests if empty.
+	Path string
+
+	// The HTTP status code of the error response, such as
+	// http.StatusUnauthorized.
+	StatusCode int
+
+	// The number of requests the fault is injected for. The fault is
+	// injected for all requests matching the path if zero.
+	Count int
+}
+
+// An Emulator is an HTTP handler emulating the EC2 Instance Metadata Service.
+// The Emulator is safe to use concurrently.
+//
+// The exported fields of the Emulator must be set before the Emulator serves
+// requests. Use the Emulator's methods to change the metadata, role, and
+// faults while serving requests.
+type Emulator struct {
+	// If IMDSv2 session tokens are required for all requests. Requests
+	// without a token fail with a 401 Unauthorized response.
+	TokenRequired bool
+
+	// If the token API is disabled, failing token requests with a 404 Not
+	// Found response as versions of the service without IMDSv2 support do.
+	DisableTokens bool
+
+	// The maximum number of network hops the responses to token requests
+	// travel. Responses to token requests from clients further away than the
+	// hop limit are dropped, by closing the connection without a response.
+	// Defaults to 1.
+	HopLimit int
+
+	// The number of network hops between the clients and the Emulator, such
+	// as 2 for a client in a container on the instance. Defaults to 1.
+	Hops int
+
+	// The latency added to each response.
+	Latency time.Duration
+
+	// The maximum number of requests served per second. Requests exceeding
+	// the limit fail with a 429 Too Many Requests response. Not limited if
+	// zero.
+	RequestsPerSecond int
+
+	// The duration the IAM role credentials are valid for. The credentials
+	// are rotated once they expire. Defaults to DefaultCredentialsDuration.
+	CredentialsDuration time.Duration
+
+	// The current time of the Emulator, used for expiring tokens and
+	// credentials. Defaults to time.Now.
+	Now func() time.Time
+
+	mu       sync.Mutex
+	metadata map[string]string
+	dynamic  map[string]string
+	userData *string
+	role     string
+	creds    *Credentials
+	tokens   map[string]time.Time
+	faults   []*Fault
+	requests []Request
+
+	issued      int
+	window      time.Time
+	windowCount int
+}
+
+// New returns an Emulator without any metadata, user data, dynamic data, or
+// IAM role. IMDSv1 requests without a token are served.
+func New() *Emulator {
+	return &Emulator{
+		metadata: map[string]string{},
+		dynamic:  map[string]string{},
+		tokens:   map[string]time.Time{},
+	}
+}
+
+// SetMetadata sets the value of the instance metadata path, relative to
+// "/latest/meta-data/", such as "placement/region". The listings of the
+// path's parent directories are generated from the paths set. Set a path
+// with a trailing slash, such as "public-keys/", to override the listing of
+// the directory.
+func (e *Emulator) SetMetadata(p, value string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.metadata[p] = value
+}
+
+// DeleteMetadata deletes the instance metadata path, and the paths within
+// it if it is a directory.
+func (e *Emulator) DeleteMetadata(p string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	deletePath(e.metadata, p)
+}
+
+// SetDynamic sets the value of the dynamic data path, relative to
+// "/latest/dynamic/", such as "instance-identity/document".
+func (e *Emulator) SetDynamic(p, value string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.dynamic[p] = value
+}
+
+// SetUserData sets the instance's user data. The user data is not found
+// until it is set.
+func (e *Emulator) SetUserData(data string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.userData = &data
+}
+
+// InvalidateTokens invalidates all of the IMDSv2 session tokens issued,
+// failing requests made with them with a 401 Unauthorized response.
+func (e *Emulator) InvalidateTokens() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.tokens = map[string]time.Time{}
+}
+
+// InjectFault injects the fault for the requests matching it. Faults are
+// matched in the order they were injected.
+func (e *Emulator) InjectFault(f Fault) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = append(e.faults, &f)
+}
+
+// ClearFaults removes all of the faults injected.
+func (e *Emulator) ClearFaults() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.faults = nil
+}
+
+// Requests returns the requests served by the Emulator, in the order they
+// were received.
+func (e *Emulator) Requests() []Request {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]Request(nil), e.requests...)
+}
+
+// ServeHTTP serves the EC2 Instance Metadata Service request.
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if e.Latency > 0 {
+		time.Sleep(e.Latency)
+	}
+
+	req := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Token:  r.Header.Get(TokenHeader),
+	}
+
+	status, header, body := e.serve(r)
+	if status == 0 {
+		// Drop the response, as packets exceeding the hop limit are.
+		if hj, ok := w.(http.Hijacker); ok {
+			if conn, _, err := hj.Hijack(); err == nil {
+				conn.Close()
+			}
+		}
+	} else {
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}
+
+	req.StatusCode = status
+	e.mu.Lock()
+	e.requests = append(e.requests, req)
+	e.mu.Unlock()
+}
+
+func (e *Emulator) serve(r *http.Request) (int, http.Header, string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	if e.throttled(now) {
+		return errorResponse(http.StatusTooManyRequests)
+	}
+	if status := e.fault(r.URL.Path); status != 0 {
+		return errorResponse(status)
+	}
+
+	if r.URL.Path == TokenPath {
+		return e.serveToken(r, now)
+	}
+
+	if token := r.Header.Get(TokenHeader); len(token) != 0 {
+		if expires, ok := e.tokens[token]; !ok || !now.Before(expires) {
+			delete(e.tokens, token)
+			return errorResponse(http.StatusUnauthorized)
+		}
+	} else if e.TokenRequired {
+		return errorResponse(http.StatusUnauthorized)
+	}
+
+	if r.Method != "GET" {
+		return errorResponse(http.StatusMethodNotAllowed)
+	}
+
+	var value string
+	var ok bool
+	switch p := r.URL.Path; {
+	case p == "/latest/user-data" || p == "/latest/user-data/":
+		if ok = e.userData != nil; ok {
+			value = *e.userData
+		}
+	case strings.HasPrefix(p, "/latest/meta-data"):
+		value, ok = lookupPath(e.metadataTree(now), strings.TrimPrefix(p, "/latest/meta-data"))
+	case strings.HasPrefix(p, "/latest/dynamic"):
+		value, ok = lookupPath(e.dynamic, strings.TrimPrefix(p, "/latest/dynamic"))
+	}
+	if !ok {
+		return errorResponse(http.StatusNotFound)
+	}
+
+	return http.StatusOK, nil, value
+}
+
+// serveToken serves the IMDSv2 session token request.
+func (e *Emulator) serveToken(r *http.Request, now time.Time) (int, http.Header, string) {
+	if e.DisableTokens {
+		return errorResponse(http.StatusNotFound)
+	}
+	if r.Method != "PUT" {
+		return errorResponse(http.StatusMethodNotAllowed)
+	}
+	if len(r.Header.Get("X-Forwarded-For")) != 0 {
+		// Token requests through a proxy are rejected.
+		return errorResponse(http.StatusForbidden)
+	}
+
+	ttl, err := strconv.Atoi(r.Header.Get(TokenTTLHeader))
+	if err != nil || ttl < 1 || time.Duration(ttl)*time.Second > MaxTokenTTL {
+		return errorResponse(http.StatusBadRequest)
+	}
+
+	if e.hops() > e.hopLimit() {
+		return 0, nil, ""
+	}
+
+	e.issued++
+	token := fmt.Sprintf("IMDSv2Token%d-%d", e.issued, now.UnixNano())
+	e.tokens[token] = now.Add(time.Duration(ttl) * time.Second)
+
+	header := http.Header{}
+	header.Set(TokenTTLHeader, strconv.Itoa(ttl))
+	return http.StatusOK, header, token
+}
+
+// throttled returns if the request exceeds the requests per second limit.
+func (e *Emulator) throttled(now time.Time) bool {
+	if e.RequestsPerSecond <= 0 {
+		return false
+	}
+
+	if window := now.Truncate(time.Second); !window.Equal(e.window) {
+		e.window, e.windowCount = window, 0
+	}
+	e.windowCount++
+
+	return e.windowCount > e.RequestsPerSecond
+}
+
+// fault returns the status code of the fault injected for the path, if any.
+func (e *Emulator) fault(p string) int {
+	for i, f := range e.faults {
+		if !strings.HasPrefix(p, f.Path) {
+			continue
+		}
+		if f.Count > 0 {
+			if f.Count--; f.Count == 0 {
+				e.faults = append(e.faults[:i], e.faults[i+1:]...)
+			}
+		}
+		return f.StatusCode
+	}
+
+	return 0
+}
+
+func (e *Emulator) now() time.Time {
+	if e.Now != nil {
+		return e.Now()
+	}
+	return time.Now()
+}
+
+func (e *Emulator) hops() int {
+	if e.Hops > 0 {
+		return e.Hops
+	}
+	return 1
+}
+
+func (e *Emulator) hopLimit() int {
+	if e.HopLimit > 0 {
+		return e.HopLimit
+	}
+	return 1
+}
+
+func errorResponse(status int) (int, http.Header, string) {
+	return status, nil, http.StatusText(status)
+}
+
+// lookupPath returns the value of the path in the tree, or the listing of
+// the directory at the path if the path is a directory.
+func lookupPath(tree map[string]string, p string) (string, bool) {
+	p = strings.TrimPrefix(p, "/")
+	if v, ok := tree[p]; ok {
+		return v, true
+	}
+
+	dir := strings.TrimSuffix(p, "/")
+	if len(dir) != 0 {
+		dir += "/"
+	}
+
+	entries := map[string]struct{}{}
+	for k := range tree {
+		if !strings.HasPrefix(k, dir) || len(k) == len(dir) {
+			continue
+		}
+		name := k[len(dir):]
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[:i+1]
+		}
+		entries[name] = struct{}{}
+	}
+	if len(entries) == 0 {
+		return "", false
+	}
+
+	names := make([]string, 0, len(entries))
+	for name := range entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, "\n"), true
+}
+
+func deletePath(tree map[string]string, p string) {
+	p = strings.TrimSuffix(p, "/")
+	for k := range tree {
+		if k == p || strings.HasPrefix(k, p+"/") {
+			delete(tree, k)
+		}
+	}
+}
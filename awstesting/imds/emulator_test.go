@@ -0,0 +1,375 @@
+package imds_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/awstesting/imds"
+	"github.com/aws/aws-sdk-go/awstesting/unit"
+)
+
+func newClient(ts *httptest.Server) *ec2metadata.EC2Metadata {
+	// A custom HTTP client prevents the client overriding the retries.
+	return ec2metadata.New(unit.Session, &aws.Config{
+		Endpoint:   aws.String(ts.URL + "/latest"),
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		MaxRetries: aws.Int(0),
+	})
+}
+
+// testClock is a clock for the emulator advanced by the test.
+type testClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *testClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestEmulator_Metadata(t *testing.T) {
+	emulator := imds.New()
+	emulator.SetMetadata("instance-id", "i-1234567890abcdef0")
+	emulator.SetMetadata("placement/region", "us-west-2")
+	emulator.SetMetadata("placement/availability-zone", "us-west-2a")
+	emulator.SetMetadata("public-keys/", "0=my-key")
+	emulator.SetMetadata("public-keys/0/openssh-key", "ssh-rsa AAAA my-key")
+	emulator.SetDynamic("instance-identity/document", `{"region": "us-west-2"}`)
+
+	ts := httptest.NewServer(emulator)
+	defer ts.Close()
+	client := newClient(ts)
+
+	cases := []struct {
+		Path, Expect string
+	}{
+		{"instance-id", "i-1234567890abcdef0"},
+		{"", "instance-id\nplacement/\npublic-keys/"},
+		{"placement/", "availability-zone\nregion"},
+		{"placement", "availability-zone\nregion"},
+		{"public-keys/", "0=my-key"},
+		{"public-keys/0/openssh-key", "ssh-rsa AAAA my-key"},
+	}
+	for _, c := range cases {
+		v, err := client.GetMetadata(c.Path)
+		if err != nil {
+			t.Fatalf("%q: expect no error, got %v", c.Path, err)
+		}
+		if e, a := c.Expect, v; e != a {
+			t.Errorf("%q: expect %q, got %q", c.Path, e, a)
+		}
+	}
+
+	v, err := client.GetDynamicData("instance-identity/document")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := `{"region": "us-west-2"}`, v; e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	if _, err = client.GetUserData(); err == nil {
+		t.Fatalf("expect user data not found error, got none")
+	}
+	if e, a := http.StatusNotFound, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	emulator.SetUserData("#!/bin/bash")
+	if v, err = client.GetUserData(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "#!/bin/bash", v; e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	emulator.DeleteMetadata("placement")
+	if _, err = client.GetMetadata("placement/region"); err == nil {
+		t.Errorf("expect deleted metadata not to be found")
+	}
+
+	// The token is fetched once, and used for all requests.
+	reqs := emulator.Requests()
+	if e, a := "PUT", reqs[0].Method; e != a {
+		t.Fatalf("expect %v token request, got %v", e, a)
+	}
+	for _, r := range reqs[1:] {
+		if len(r.Token) == 0 || r.Method != "GET" {
+			t.Errorf("expect GET request with token, got %+v", r)
+		}
+	}
+}
+
+func TestEmulator_HopLimit(t *testing.T) {
+	cases := map[string]struct {
+		HopLimit, Hops int
+		TokenRequired  bool
+		ExpectErr      bool
+		ExpectToken    bool
+	}{
+		"within hop limit": {
+			TokenRequired: true,
+			ExpectToken:   true,
+		},
+		"exceeds hop limit, IMDSv1 fallback": {
+			Hops: 2,
+		},
+		"exceeds hop limit, token required": {
+			Hops:          2,
+			TokenRequired: true,
+			ExpectErr:     true,
+		},
+		"raised hop limit": {
+			HopLimit:      2,
+			Hops:          2,
+			TokenRequired: true,
+			ExpectToken:   true,
+		},
+	}
+
+	for name, c := range cases {
+		emulator := imds.New()
+		emulator.HopLimit = c.HopLimit
+		emulator.Hops = c.Hops
+		emulator.TokenRequired = c.TokenRequired
+		emulator.SetMetadata("instance-id", "i-1234567890abcdef0")
+
+		ts := httptest.NewServer(emulator)
+		_, err := newClient(ts).GetMetadata("instance-id")
+		ts.Close()
+
+		if c.ExpectErr {
+			if err == nil {
+				t.Errorf("%s: expect error, got none", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: expect no error, got %v", name, err)
+			continue
+		}
+
+		reqs := emulator.Requests()
+		last := reqs[len(reqs)-1]
+		if e, a := c.ExpectToken, len(last.Token) != 0; e != a {
+			t.Errorf("%s: expect token %v, got %+v", name, e, last)
+		}
+	}
+}
+
+func TestEmulator_Tokens(t *testing.T) {
+	clock := &testClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	emulator := imds.New()
+	emulator.Now = clock.Now
+	emulator.SetMetadata("instance-id", "i-1234567890abcdef0")
+
+	ts := httptest.NewServer(emulator)
+	defer ts.Close()
+
+	do := func(method, p string, header map[string]string) *http.Response {
+		req, _ := http.NewRequest(method, ts.URL+p, nil)
+		for k, v := range header {
+			req.Header.Set(k, v)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	statusCases := []struct {
+		Method string
+		Header map[string]string
+		Expect int
+	}{
+		{"GET", map[string]string{imds.TokenTTLHeader: "60"}, http.StatusMethodNotAllowed},
+		{"PUT", nil, http.StatusBadRequest},
+		{"PUT", map[string]string{imds.TokenTTLHeader: "21601"}, http.StatusBadRequest},
+		{"PUT", map[string]string{imds.TokenTTLHeader: "60", "X-Forwarded-For": "10.0.0.1"}, http.StatusForbidden},
+	}
+	for i, c := range statusCases {
+		if e, a := c.Expect, do(c.Method, imds.TokenPath, c.Header).StatusCode; e != a {
+			t.Errorf("%d: expect %v status code, got %v", i, e, a)
+		}
+	}
+
+	client := newClient(ts)
+	if _, err := client.GetMetadata("instance-id"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	token := emulator.Requests()[len(statusCases)+1].Token
+	if len(token) == 0 {
+		t.Fatalf("expect token to be fetched")
+	}
+
+	withToken := map[string]string{imds.TokenHeader: token}
+	if e, a := http.StatusOK, do("GET", "/latest/meta-data/instance-id", withToken).StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+
+	// Tokens expire after their TTL.
+	clock.Advance(imds.MaxTokenTTL)
+	if e, a := http.StatusUnauthorized, do("GET", "/latest/meta-data/instance-id", withToken).StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+
+	// Invalidated tokens are not accepted before they expire.
+	req, _ := http.NewRequest("PUT", ts.URL+imds.TokenPath, nil)
+	req.Header.Set(imds.TokenTTLHeader, "60")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if e, a := "60", resp.Header.Get(imds.TokenTTLHeader); e != a {
+		t.Errorf("expect %v TTL, got %v", e, a)
+	}
+
+	withToken[imds.TokenHeader] = string(b)
+	if e, a := http.StatusOK, do("GET", "/latest/meta-data/instance-id", withToken).StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	emulator.InvalidateTokens()
+	if e, a := http.StatusUnauthorized, do("GET", "/latest/meta-data/instance-id", withToken).StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+}
+
+func TestEmulator_RoleCredentials(t *testing.T) {
+	clock := &testClock{now: time.Now()}
+	emulator := imds.New()
+	emulator.Now = clock.Now
+	emulator.CredentialsDuration = time.Hour
+	emulator.SetRole("my-role")
+
+	ts := httptest.NewServer(emulator)
+	defer ts.Close()
+
+	provider := &ec2rolecreds.EC2RoleProvider{Client: newClient(ts)}
+	v, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expect, _ := emulator.RoleCredentials()
+	if e, a := expect.AccessKeyID, v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+	if e, a := expect.Token, v.SessionToken; e != a {
+		t.Errorf("expect %v session token, got %v", e, a)
+	}
+	if provider.IsExpired() {
+		t.Errorf("expect credentials not to be expired")
+	}
+
+	// The credentials are rotated once they expire.
+	clock.Advance(time.Hour)
+	rotated, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if v.AccessKeyID == rotated.AccessKeyID {
+		t.Errorf("expect credentials to be rotated, got %v", rotated.AccessKeyID)
+	}
+
+	next := emulator.RotateCredentials()
+	if v, err = provider.Retrieve(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := next.AccessKeyID, v.AccessKeyID; e != a {
+		t.Errorf("expect %v access key ID, got %v", e, a)
+	}
+
+	emulator.SetRole("")
+	if _, err = provider.Retrieve(); err == nil {
+		t.Errorf("expect error without role, got none")
+	}
+}
+
+func TestEmulator_Faults(t *testing.T) {
+	emulator := imds.New()
+	emulator.SetMetadata("instance-id", "i-1234567890abcdef0")
+
+	ts := httptest.NewServer(emulator)
+	defer ts.Close()
+	client := newClient(ts)
+
+	emulator.InjectFault(imds.Fault{
+		Path:       "/latest/meta-data/",
+		StatusCode: http.StatusInternalServerError,
+		Count:      1,
+	})
+	if _, err := client.GetMetadata("instance-id"); err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if _, err := client.GetMetadata("instance-id"); err != nil {
+		t.Fatalf("expect no error once fault is exhausted, got %v", err)
+	}
+
+	emulator.InjectFault(imds.Fault{StatusCode: http.StatusUnauthorized})
+	_, err := client.GetMetadata("instance-id")
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := http.StatusUnauthorized, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	emulator.ClearFaults()
+
+	reqs := emulator.Requests()
+	var statuses []int
+	for _, r := range reqs {
+		statuses = append(statuses, r.StatusCode)
+	}
+	expect := []int{200, 500, 200, 401}
+	if e, a := expect, statuses; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v statuses, got %v", e, a)
+	}
+}
+
+func TestEmulator_Throttling(t *testing.T) {
+	clock := &testClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	emulator := imds.New()
+	emulator.Now = clock.Now
+	emulator.RequestsPerSecond = 2
+	emulator.SetMetadata("instance-id", "i-1234567890abcdef0")
+
+	ts := httptest.NewServer(emulator)
+	defer ts.Close()
+	client := newClient(ts)
+
+	// The token and metadata requests are within the limit.
+	if _, err := client.GetMetadata("instance-id"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	_, err := client.GetMetadata("instance-id")
+	if err == nil {
+		t.Fatalf("expect throttling error, got none")
+	}
+	if e, a := http.StatusTooManyRequests, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+
+	clock.Advance(time.Second)
+	if _, err := client.GetMetadata("instance-id"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
@@ -0,0 +1,563 @@
+// Package recorder provides an HTTP transport for recording the HTTP
+// interactions of SDK requests with AWS services, and replaying them in tests
+// without network access.
+//
+// The interactions are recorded to a cassette file, with credentials and
+// signatures scrubbed from the requests. Requests are matched to recorded
+// interactions by the API operation and the canonicalized request body, not
+// the raw request, so signatures and timestamps which differ between runs do
+// not prevent the interactions from being replayed.
+//
+//    rec, err := recorder.New("testdata/get_item.json", recorder.ModeReplay)
+//    if err != nil {
+//        t.Fatal(err)
+//    }
+//
+//    sess := session.Must(session.NewSession(&aws.Config{
+//        HTTPClient: rec.HTTPClient(),
+//    }))
+//
+// Use ModeRecord to record the interactions with the service, and Save to
+// write the cassette file once the test completes.
+//
+// The session's custom CA bundle, AWS_CA_BUNDLE, cannot be used with the
+// recorder's HTTP client. Use the Transport of the recorder to configure the
+// TLS settings of the requests recorded instead.
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	// ErrCodeInteractionNotFound is the error code for a request that does
+	// not match any interaction recorded in the cassette.
+	ErrCodeInteractionNotFound = "InteractionNotFound"
+
+	// ErrCodeCassette is the error code for a cassette file that failed to
+	// be read or written.
+	ErrCodeCassette = "CassetteError"
+)
+
+// ScrubbedValue is the value scrubbed values are replaced with.
+const ScrubbedValue = "SCRUBBED"
+
+// Mode is the mode of the recorder.
+type Mode int
+
+const (
+	// ModeReplay replays the interactions recorded in the cassette. Requests
+	// not matching a recorded interaction fail. No requests are sent.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends the requests with the recorder's Transport, and
+	// records the interactions in the cassette.
+	ModeRecord
+)
+
+// DefaultScrubHeaders are the request headers scrubbed from recorded
+// interactions.
+var DefaultScrubHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+	"X-Amz-Date",
+	"X-Amz-Content-Sha256",
+}
+
+// DefaultScrubQuery are the query parameters scrubbed from recorded
+// interaction's URLs, such as the signatures of presigned URLs.
+var DefaultScrubQuery = []string{
+	"X-Amz-Signature",
+	"X-Amz-Credential",
+	"X-Amz-Security-Token",
+	"X-Amz-Date",
+	"Signature",
+	"AWSAccessKeyId",
+	"SecurityToken",
+}
+
+// DefaultScrubResponseFields are the fields of XML and JSON response bodies
+// scrubbed from recorded interactions, such as the credentials returned by
+// AWS STS.
+var DefaultScrubResponseFields = []string{
+	"SecretAccessKey",
+	"SessionToken",
+}
+
+// A Recorder is an http.RoundTripper that records or replays the HTTP
+// interactions of the requests sent with it. A Recorder is safe to use
+// concurrently.
+type Recorder struct {
+	// The cassette file interactions are replayed from, and recorded to.
+	Filename string
+
+	// The mode of the recorder.
+	Mode Mode
+
+	// The transport requests are sent with in ModeRecord. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// The request headers scrubbed from recorded interactions. Defaults to
+	// DefaultScrubHeaders.
+	ScrubHeaders []string
+
+	// The URL query parameters scrubbed from recorded interactions. Defaults
+	// to DefaultScrubQuery.
+	ScrubQuery []string
+
+	// The fields of XML and JSON response bodies scrubbed from recorded
+	// interactions. Defaults to DefaultScrubResponseFields.
+	ScrubResponseFields []string
+
+	// The names of request body fields, and query parameters, that are
+	// ignored when matching requests to interactions. Use to ignore values
+	// which differ between runs, such as idempotency tokens.
+	IgnoreFields []string
+
+	mu       sync.Mutex
+	cassette *Cassette
+	used     map[*Interaction]bool
+}
+
+// New returns a Recorder for the cassette file in the mode. The cassette's
+// interactions are loaded in ModeReplay.
+func New(filename string, mode Mode) (*Recorder, error) {
+	r := &Recorder{
+		Filename: filename,
+		Mode:     mode,
+		cassette: &Cassette{},
+		used:     map[*Interaction]bool{},
+	}
+
+	if mode == ModeReplay {
+		c, err := LoadCassette(filename)
+		if err != nil {
+			return nil, err
+		}
+		r.cassette = c
+	}
+
+	return r, nil
+}
+
+// HTTPClient returns an HTTP client using the recorder as its transport, for
+// use with aws.Config.HTTPClient.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Cassette returns the cassette of the interactions recorded, or replayed.
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette
+}
+
+// Save writes the interactions recorded to the recorder's cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.cassette.Save(r.Filename)
+}
+
+// RoundTrip records, or replays, the HTTP interaction of the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recReq := r.recordRequest(req, body)
+
+	if r.Mode == ModeRecord {
+		return r.record(req, body, recReq)
+	}
+	return r.replay(req, body, recReq)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recReq RecordedRequest) (*http.Response, error) {
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	sendReq := new(http.Request)
+	*sendReq = *req
+	sendReq.Body = ioutil.NopCloser(bytes.NewReader(body))
+	sendReq.ContentLength = int64(len(body))
+
+	resp, err := transport.RoundTrip(sendReq)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	recResp := RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+	}
+	recResp.Body, recResp.BodyEncoding = encodeBody(r.scrubResponseBody(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recReq,
+		Response: recResp,
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte, recReq RecordedRequest) (*http.Response, error) {
+	key := r.matchKey(req.URL.Query(), req.Header.Get("Content-Type"), body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, i := range r.cassette.Interactions {
+		if r.used[i] || i.Request.Operation != recReq.Operation {
+			continue
+		}
+		if k, err := r.recordedMatchKey(i.Request); err != nil || k != key {
+			continue
+		}
+		r.used[i] = true
+
+		body, err := i.Response.body()
+		if err != nil {
+			return nil, err
+		}
+
+		header := http.Header{}
+		for k, v := range i.Response.Header {
+			header[k] = append([]string{}, v...)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, awserr.New(ErrCodeInteractionNotFound,
+		fmt.Sprintf("no recorded interaction for %s request, %s", recReq.Operation, r.Filename), nil)
+}
+
+// recordRequest returns the scrubbed recorded request for the HTTP request,
+// with the operation used to match the request to interactions.
+func (r *Recorder) recordRequest(req *http.Request, body []byte) RecordedRequest {
+	scrubHeaders := r.ScrubHeaders
+	if scrubHeaders == nil {
+		scrubHeaders = DefaultScrubHeaders
+	}
+
+	header := http.Header{}
+	for k, v := range req.Header {
+		header[k] = append([]string{}, v...)
+	}
+	for _, k := range scrubHeaders {
+		if len(header.Get(k)) != 0 {
+			header.Set(k, ScrubbedValue)
+		}
+	}
+
+	u := *req.URL
+	query := u.Query()
+	for _, k := range r.scrubQuery() {
+		if _, ok := query[k]; ok {
+			query.Set(k, ScrubbedValue)
+		}
+	}
+	u.RawQuery = query.Encode()
+
+	recReq := RecordedRequest{
+		Method:    req.Method,
+		URL:       u.String(),
+		Header:    header,
+		Operation: operationName(req, body),
+	}
+	recReq.Body, recReq.BodyEncoding = encodeBody(body)
+
+	return recReq
+}
+
+// scrubResponseBody returns the response body with the values of the
+// scrubbed response fields replaced.
+func (r *Recorder) scrubResponseBody(body []byte) []byte {
+	fields := r.ScrubResponseFields
+	if fields == nil {
+		fields = DefaultScrubResponseFields
+	}
+
+	for _, f := range fields {
+		name := regexp.QuoteMeta(f)
+		xmlField := regexp.MustCompile(`(<` + name + `>)[^<]*(</` + name + `>)`)
+		body = xmlField.ReplaceAll(body, []byte("${1}"+ScrubbedValue+"${2}"))
+
+		jsonField := regexp.MustCompile(`("` + name + `"\s*:\s*")(?:[^"\\]|\\.)*(")`)
+		body = jsonField.ReplaceAll(body, []byte("${1}"+ScrubbedValue+"${2}"))
+	}
+
+	return body
+}
+
+// operationName returns the name of the API operation of the request. The
+// name is taken from the X-Amz-Target header of JSON protocol requests, and
+// the Action parameter of query protocol requests. The HTTP method and path
+// of the request are used for REST protocol requests.
+func operationName(req *http.Request, body []byte) string {
+	if target := req.Header.Get("X-Amz-Target"); len(target) != 0 {
+		return target
+	}
+
+	if isFormBody(req) {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			if action := values.Get("Action"); len(action) != 0 {
+				return action
+			}
+		}
+	}
+
+	return req.Method + " " + req.URL.EscapedPath()
+}
+
+// scrubQuery returns the URL query parameters scrubbed from recorded
+// interactions.
+func (r *Recorder) scrubQuery() []string {
+	if r.ScrubQuery == nil {
+		return DefaultScrubQuery
+	}
+	return r.ScrubQuery
+}
+
+// recordedMatchKey returns the key the recorded request is matched with,
+// computed from the request's URL, Content-Type header, and body with the
+// recorder's current IgnoreFields and ScrubQuery.
+func (r *Recorder) recordedMatchKey(req RecordedRequest) (string, error) {
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return "", err
+	}
+	body, err := decodeBody(req.Body, req.BodyEncoding)
+	if err != nil {
+		return "", err
+	}
+
+	return r.matchKey(u.Query(), req.Header.Get("Content-Type"), body), nil
+}
+
+// matchKey returns the canonicalized form of the request's query and body
+// requests are matched to interactions with. Fields ignored, and query
+// parameters scrubbed, are removed.
+func (r *Recorder) matchKey(query url.Values, contentType string, body []byte) string {
+	for _, k := range r.scrubQuery() {
+		query.Del(k)
+	}
+	for _, k := range r.IgnoreFields {
+		query.Del(k)
+	}
+
+	var canonicalBody string
+	switch {
+	case isFormContentType(contentType):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			canonicalBody = string(body)
+			break
+		}
+		for _, k := range r.IgnoreFields {
+			values.Del(k)
+		}
+		canonicalBody = values.Encode()
+
+	case isJSONBody(body):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			canonicalBody = string(body)
+			break
+		}
+		removeFields(v, r.IgnoreFields)
+		// Object keys are sorted when marshaled, providing a canonical
+		// document.
+		b, _ := json.Marshal(v)
+		canonicalBody = string(b)
+
+	default:
+		canonicalBody = string(body)
+	}
+
+	return query.Encode() + "\n" + canonicalBody
+}
+
+func isFormBody(req *http.Request) bool {
+	return isFormContentType(req.Header.Get("Content-Type"))
+}
+
+func isFormContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
+
+func isJSONBody(body []byte) bool {
+	trimmed := bytes.TrimSpace(body)
+	return len(trimmed) != 0 && (trimmed[0] == '{' || trimmed[0] == '[')
+}
+
+// removeFields removes the fields from all objects of the JSON value.
+func removeFields(v interface{}, fields []string) {
+	switch tv := v.(type) {
+	case map[string]interface{}:
+		for _, f := range fields {
+			delete(tv, f)
+		}
+		for _, elem := range tv {
+			removeFields(elem, fields)
+		}
+	case []interface{}:
+		for _, elem := range tv {
+			removeFields(elem, fields)
+		}
+	}
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+const base64Encoding = "base64"
+
+// encodeBody returns the body as a string, and the encoding of the string.
+// Bodies which are not valid UTF-8 are base64 encoded.
+func encodeBody(b []byte) (string, string) {
+	if utf8.Valid(b) {
+		return string(b), ""
+	}
+
+	return base64.StdEncoding.EncodeToString(b), base64Encoding
+}
+
+func decodeBody(body, encoding string) ([]byte, error) {
+	if encoding != base64Encoding {
+		return []byte(body), nil
+	}
+
+	return base64.StdEncoding.DecodeString(body)
+}
+
+// A Cassette is the list of HTTP interactions recorded.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// LoadCassette reads the cassette from the file.
+func LoadCassette(filename string) (*Cassette, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, awserr.New(ErrCodeCassette, "unable to read cassette file", err)
+	}
+
+	c := &Cassette{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, awserr.New(ErrCodeCassette, "unable to decode cassette file", err)
+	}
+
+	return c, nil
+}
+
+// Save writes the cassette to the file, creating the file's directory if it
+// does not exist.
+func (c *Cassette) Save(filename string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return awserr.New(ErrCodeCassette, "unable to encode cassette", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return awserr.New(ErrCodeCassette, "unable to create cassette directory", err)
+	}
+	if err := ioutil.WriteFile(filename, append(b, '\n'), 0644); err != nil {
+		return awserr.New(ErrCodeCassette, "unable to write cassette file", err)
+	}
+
+	return nil
+}
+
+// Operations returns the names of the operations of the interactions, in the
+// order they were recorded.
+func (c *Cassette) Operations() []string {
+	ops := make([]string, 0, len(c.Interactions))
+	for _, i := range c.Interactions {
+		ops = append(ops, i.Request.Operation)
+	}
+	return ops
+}
+
+// An Interaction is a request and the response received for it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// A RecordedRequest is a request recorded in a cassette, with its credentials
+// and signatures scrubbed.
+type RecordedRequest struct {
+	// The API operation of the request, which requests are matched with
+	// along with the request's canonicalized query and body.
+	Operation string `json:"operation"`
+
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header"`
+	Body         string      `json:"body"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+// A RecordedResponse is a response recorded in a cassette.
+type RecordedResponse struct {
+	StatusCode   int         `json:"statusCode"`
+	Header       http.Header `json:"header"`
+	Body         string      `json:"body"`
+	BodyEncoding string      `json:"bodyEncoding,omitempty"`
+}
+
+func (r RecordedResponse) body() ([]byte, error) {
+	return decodeBody(r.Body, r.BodyEncoding)
+}
@@ -0,0 +1,301 @@
+package recorder_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting/recorder"
+	"github.com/aws/aws-sdk-go/internal/sdktesting"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func newSession(rec *recorder.Recorder, endpoint string) *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(endpoint),
+		HTTPClient:  rec.HTTPClient(),
+		MaxRetries:  aws.Int(0),
+	}))
+}
+
+func tempCassette(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return filepath.Join(dir, "testdata", "cassette.json"), func() { os.RemoveAll(dir) }
+}
+
+func TestRecorder_RecordReplay(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		fmt.Fprintf(w, `{"Item":{"count":{"N":"%d"}}}`, count)
+	}))
+
+	rec, err := recorder.New(filename, recorder.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	svc := dynamodb.New(newSession(rec, server.URL))
+
+	getItem := func(svc *dynamodb.DynamoDB, id string) string {
+		out, err := svc.GetItem(&dynamodb.GetItemInput{
+			TableName: aws.String("table"),
+			Key: map[string]*dynamodb.AttributeValue{
+				"id": {S: aws.String(id)},
+			},
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		return aws.StringValue(out.Item["count"].N)
+	}
+
+	for i, id := range []string{"a", "a", "b"} {
+		if e, a := fmt.Sprintf("%d", i+1), getItem(svc, id); e != a {
+			t.Errorf("expect %v recorded count, got %v", e, a)
+		}
+	}
+	server.Close()
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	for _, secret := range []string{"AKID", "SESSION", "Signature="} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("expect %q to be scrubbed from cassette\n%s", secret, b)
+		}
+	}
+
+	// Replay in a different order than recorded.
+	rec, err = recorder.New(filename, recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := []string{"DynamoDB_20120810.GetItem", "DynamoDB_20120810.GetItem", "DynamoDB_20120810.GetItem"},
+		rec.Cassette().Operations(); strings.Join(e, ",") != strings.Join(a, ",") {
+		t.Errorf("expect %v operations, got %v", e, a)
+	}
+
+	svc = dynamodb.New(newSession(rec, server.URL))
+
+	for _, c := range []struct{ ID, Count string }{{"b", "3"}, {"a", "1"}, {"a", "2"}} {
+		if e, a := c.Count, getItem(svc, c.ID); e != a {
+			t.Errorf("expect %v replayed count for %v, got %v", e, c.ID, a)
+		}
+	}
+
+	_, err = svc.GetItem(&dynamodb.GetItemInput{
+		TableName: aws.String("table"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("a")},
+		},
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := recorder.ErrCodeInteractionNotFound, err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %v error, got %v", e, a)
+	}
+}
+
+func TestRecorder_IgnoreFields(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		fmt.Fprintf(w, `<SendMessageResponse><SendMessageResult><MessageId>%s</MessageId>`+
+			`<MD5OfMessageBody>5d41402abc4b2a76b9719d911017c592</MD5OfMessageBody>`+
+			`</SendMessageResult></SendMessageResponse>`, r.Form.Get("MessageDeduplicationId"))
+	}))
+	defer server.Close()
+
+	sendMessage := func(svc *sqs.SQS, dedupID string) (string, error) {
+		out, err := svc.SendMessage(&sqs.SendMessageInput{
+			QueueUrl:               aws.String(server.URL + "/queue"),
+			MessageBody:            aws.String("hello"),
+			MessageDeduplicationId: aws.String(dedupID),
+		})
+		return aws.StringValue(out.MessageId), err
+	}
+
+	rec, err := recorder.New(filename, recorder.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	rec.IgnoreFields = []string{"MessageDeduplicationId"}
+	svc := sqs.New(newSession(rec, server.URL))
+	if _, err := sendMessage(svc, "recorded"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	rec, err = recorder.New(filename, recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	rec.IgnoreFields = []string{"MessageDeduplicationId"}
+	svc = sqs.New(newSession(rec, server.URL))
+
+	id, err := sendMessage(svc, "replayed")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "recorded", id; e != a {
+		t.Errorf("expect %v message id, got %v", e, a)
+	}
+	if e, a := []string{"SendMessage"}, rec.Cassette().Operations(); strings.Join(e, ",") != strings.Join(a, ",") {
+		t.Errorf("expect %v operations, got %v", e, a)
+	}
+}
+
+func TestRecorder_IgnoreFieldsOnlyOnReplay(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.0")
+		fmt.Fprint(w, `{"Item":{"id":{"S":"recorded"}}}`)
+	}))
+	defer server.Close()
+
+	getItem := func(svc *dynamodb.DynamoDB, attr string) (*dynamodb.GetItemOutput, error) {
+		return svc.GetItem(&dynamodb.GetItemInput{
+			TableName:            aws.String("table"),
+			Key:                  map[string]*dynamodb.AttributeValue{"id": {S: aws.String("abc")}},
+			ProjectionExpression: aws.String(attr),
+		})
+	}
+
+	// The interaction is recorded without ignoring any fields.
+	rec, err := recorder.New(filename, recorder.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if _, err := getItem(dynamodb.New(newSession(rec, server.URL)), "recorded"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if strings.Contains(string(b), `"key"`) {
+		t.Errorf("expect match key not to be persisted, got %s", b)
+	}
+
+	// Fields ignored when replaying apply to the recorded requests.
+	rec, err = recorder.New(filename, recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	rec.IgnoreFields = []string{"ProjectionExpression"}
+	out, err := getItem(dynamodb.New(newSession(rec, server.URL)), "replayed")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "recorded", aws.StringValue(out.Item["id"].S); e != a {
+		t.Errorf("expect %v item, got %v", e, a)
+	}
+}
+
+func TestRecorder_ScrubResponseCredentials(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	filename, cleanup := tempCassette(t)
+	defer cleanup()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<AssumeRoleResponse><AssumeRoleResult><Credentials>` +
+			`<AccessKeyId>ASIA_ACCESS_KEY</AccessKeyId>` +
+			`<SecretAccessKey>secret_access_key</SecretAccessKey>` +
+			`<SessionToken>session_token</SessionToken>` +
+			`<Expiration>2019-11-09T13:34:41Z</Expiration>` +
+			`</Credentials></AssumeRoleResult></AssumeRoleResponse>`))
+	}))
+	defer server.Close()
+
+	rec, err := recorder.New(filename, recorder.ModeRecord)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	svc := sts.New(newSession(rec, server.URL))
+
+	out, err := svc.AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/role"),
+		RoleSessionName: aws.String("session"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "secret_access_key", aws.StringValue(out.Credentials.SecretAccessKey); e != a {
+		t.Errorf("expect %v secret returned to caller, got %v", e, a)
+	}
+
+	if err := rec.Save(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	c, err := recorder.LoadCassette(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	body := c.Interactions[0].Response.Body
+	for _, secret := range []string{"secret_access_key", "session_token"} {
+		if strings.Contains(body, secret) {
+			t.Errorf("expect %q to be scrubbed, got %s", secret, body)
+		}
+	}
+	if e, a := "<AccessKeyId>ASIA_ACCESS_KEY</AccessKeyId>", body; !strings.Contains(a, e) {
+		t.Errorf("expect %v not to be scrubbed, got %s", e, a)
+	}
+}
+
+func TestNew_CassetteNotExists(t *testing.T) {
+	_, err := recorder.New(filepath.Join("testdata", "not_exists.json"), recorder.ModeReplay)
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := recorder.ErrCodeCassette, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
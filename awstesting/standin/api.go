@@ -0,0 +1,51 @@
+// +build codegen
+
+package standin
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// NewService returns the stand-in Service of the API model. The Go types of
+// the operations' input and output shapes are taken from the XxxRequest
+// methods of the service's client, which is usually a nil pointer of the
+// client's type, e.g. (*sqs.SQS)(nil).
+//
+// Returns an error if the client does not have a method for an operation of
+// the API.
+func NewService(a *api.API, client interface{}) (*Service, error) {
+	svc := &Service{
+		ServiceID:    a.Metadata.ServiceID,
+		Protocol:     a.Metadata.Protocol,
+		TargetPrefix: a.Metadata.TargetPrefix,
+		JSONVersion:  a.Metadata.JSONVersion,
+		Operations:   map[string]*Operation{},
+		Errors:       map[string]int{},
+	}
+
+	t := reflect.TypeOf(client)
+	for _, op := range a.OperationList() {
+		m, ok := t.MethodByName(op.ExportedName + "Request")
+		if !ok {
+			return nil, fmt.Errorf("%v does not have a %sRequest method", t, op.ExportedName)
+		}
+
+		svc.Operations[op.Name] = &Operation{
+			Name:         op.Name,
+			HTTPMethod:   op.HTTP.Method,
+			HTTPPath:     op.HTTP.RequestURI,
+			ResponseCode: int(op.HTTP.ResponseCode),
+			InputType:    m.Type.In(1),
+			OutputType:   m.Type.Out(1),
+		}
+
+		for _, ref := range op.ErrorRefs {
+			svc.Errors[ref.Shape.ErrorName()] = ref.Shape.ErrorInfo.HTTPStatusCode
+		}
+	}
+
+	return svc, nil
+}
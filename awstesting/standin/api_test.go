@@ -0,0 +1,334 @@
+// +build go1.8,codegen
+
+package standin_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/awstesting/standin"
+	"github.com/aws/aws-sdk-go/internal/sdktesting"
+	"github.com/aws/aws-sdk-go/private/model/api"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+)
+
+func newServer(t *testing.T, model string, client interface{}) (*standin.Server, *httptest.Server) {
+	t.Helper()
+
+	apis, err := api.Loader{
+		BaseImport: "github.com/aws/aws-sdk-go/service",
+	}.Load([]string{filepath.Join("..", "..", "models", "apis", model, "api-2.json")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	var a *api.API
+	for _, a = range apis {
+	}
+	svc, err := standin.NewService(a, client)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	server := standin.NewServer(svc)
+	return server, httptest.NewServer(server)
+}
+
+func TestNewService_Query(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server, ts := newServer(t, "sns/2010-03-31", (*sns.SNS)(nil))
+	defer ts.Close()
+
+	var topics []string
+	server.Handle("CreateTopic", func(ctx aws.Context, input *sns.CreateTopicInput) (*sns.CreateTopicOutput, error) {
+		arn := "arn:aws:sns:us-west-2:123456789012:" + aws.StringValue(input.Name)
+		topics = append(topics, arn)
+		if e, a := "value", aws.StringValue(input.Attributes["DisplayName"]); e != a {
+			t.Errorf("expect %v attribute, got %v", e, a)
+		}
+		return &sns.CreateTopicOutput{TopicArn: aws.String(arn)}, nil
+	})
+	server.Handle("ListTopics", func(ctx aws.Context, input *sns.ListTopicsInput) (*sns.ListTopicsOutput, error) {
+		out := &sns.ListTopicsOutput{}
+		for _, arn := range topics {
+			out.Topics = append(out.Topics, &sns.Topic{TopicArn: aws.String(arn)})
+		}
+		return out, nil
+	})
+
+	svc := sns.New(newSession(ts.URL))
+	for _, name := range []string{"a", "b"} {
+		_, err := svc.CreateTopic(&sns.CreateTopicInput{
+			Name:       aws.String(name),
+			Attributes: map[string]*string{"DisplayName": aws.String("value")},
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+	}
+
+	var arns []string
+	err := svc.ListTopicsPages(&sns.ListTopicsInput{}, func(page *sns.ListTopicsOutput, lastPage bool) bool {
+		for _, topic := range page.Topics {
+			arns = append(arns, aws.StringValue(topic.TopicArn))
+		}
+		return true
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := topics, arns; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v topics, got %v", e, a)
+	}
+
+	_, err = svc.DeleteTopic(&sns.DeleteTopicInput{TopicArn: aws.String(arns[0])})
+	if e, a := standin.ErrCodeNotHandled, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+}
+
+func TestNewService_EC2(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server, ts := newServer(t, "ec2/2016-11-15", (*ec2.EC2)(nil))
+	defer ts.Close()
+
+	server.Handle("DescribeInstances", func(ctx aws.Context, input *ec2.DescribeInstancesInput) (*ec2.DescribeInstancesOutput, error) {
+		if e, a := 1, len(input.Filters); e != a {
+			t.Fatalf("expect %v filters, got %v", e, a)
+		}
+		filter := input.Filters[0]
+		if e, a := "tag:Name", aws.StringValue(filter.Name); e != a {
+			t.Errorf("expect %v filter name, got %v", e, a)
+		}
+
+		out := &ec2.DescribeInstancesOutput{}
+		for _, v := range filter.Values {
+			out.Reservations = append(out.Reservations, &ec2.Reservation{
+				Instances: []*ec2.Instance{{
+					InstanceId: aws.String("i-" + aws.StringValue(v)),
+					Tags:       []*ec2.Tag{{Key: aws.String("Name"), Value: v}},
+				}},
+			})
+		}
+		return out, nil
+	})
+	server.Handle("StopInstances", func(ctx aws.Context, input *ec2.StopInstancesInput) (*ec2.StopInstancesOutput, error) {
+		return nil, awserr.New("InvalidInstanceID.NotFound", "instance not found", nil)
+	})
+
+	svc := ec2.New(newSession(ts.URL))
+	out, err := svc.DescribeInstances(&ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("tag:Name"),
+			Values: []*string{aws.String("web"), aws.String("db")},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	var ids []string
+	for _, r := range out.Reservations {
+		for _, inst := range r.Instances {
+			ids = append(ids, aws.StringValue(inst.InstanceId))
+			if e, a := "Name", aws.StringValue(inst.Tags[0].Key); e != a {
+				t.Errorf("expect %v tag, got %v", e, a)
+			}
+		}
+	}
+	if e, a := []string{"i-web", "i-db"}, ids; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v instances, got %v", e, a)
+	}
+
+	_, err = svc.StopInstances(&ec2.StopInstancesInput{InstanceIds: []*string{aws.String("i-web")}})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "InvalidInstanceID.NotFound", err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := "instance not found", err.(awserr.Error).Message(); e != a {
+		t.Errorf("expect %v error message, got %v", e, a)
+	}
+}
+
+func TestNewService_RESTJSON(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server, ts := newServer(t, "lambda/2015-03-31", (*lambda.Lambda)(nil))
+	defer ts.Close()
+
+	server.Handle("Invoke", func(ctx aws.Context, input *lambda.InvokeInput) (*lambda.InvokeOutput, error) {
+		if aws.StringValue(input.FunctionName) != "function" {
+			return nil, &lambda.ResourceNotFoundException{Message_: aws.String("function not found")}
+		}
+		if e, a := lambda.InvocationTypeRequestResponse, aws.StringValue(input.InvocationType); e != a {
+			t.Errorf("expect %v invocation type, got %v", e, a)
+		}
+		if e, a := "$LATEST", aws.StringValue(input.Qualifier); e != a {
+			t.Errorf("expect %v qualifier, got %v", e, a)
+		}
+		return &lambda.InvokeOutput{
+			Payload:         bytes.ToUpper(input.Payload),
+			ExecutedVersion: aws.String("1"),
+		}, nil
+	})
+	server.Handle("ListFunctions", func(ctx aws.Context, input *lambda.ListFunctionsInput) (*lambda.ListFunctionsOutput, error) {
+		return &lambda.ListFunctionsOutput{
+			Functions: []*lambda.FunctionConfiguration{{
+				FunctionName: aws.String("function"),
+				MemorySize:   input.MaxItems,
+			}},
+		}, nil
+	})
+
+	svc := lambda.New(newSession(ts.URL))
+	out, err := svc.Invoke(&lambda.InvokeInput{
+		FunctionName:   aws.String("function"),
+		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
+		Qualifier:      aws.String("$LATEST"),
+		Payload:        []byte(`{"key":"value"}`),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := `{"KEY":"VALUE"}`, string(out.Payload); e != a {
+		t.Errorf("expect %v payload, got %v", e, a)
+	}
+	if e, a := "1", aws.StringValue(out.ExecutedVersion); e != a {
+		t.Errorf("expect %v version, got %v", e, a)
+	}
+	if e, a := int64(http.StatusOK), aws.Int64Value(out.StatusCode); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+
+	list, err := svc.ListFunctions(&lambda.ListFunctionsInput{MaxItems: aws.Int64(5)})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := int64(5), aws.Int64Value(list.Functions[0].MemorySize); e != a {
+		t.Errorf("expect %v max items, got %v", e, a)
+	}
+
+	_, err = svc.Invoke(&lambda.InvokeInput{FunctionName: aws.String("other")})
+	if _, ok := err.(*lambda.ResourceNotFoundException); !ok {
+		t.Fatalf("expect ResourceNotFoundException, got %T, %v", err, err)
+	}
+	if e, a := http.StatusNotFound, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v modeled status code, got %v", e, a)
+	}
+}
+
+func TestNewService_RESTXML(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server, ts := newServer(t, "s3/2006-03-01", (*s3.S3)(nil))
+	defer ts.Close()
+
+	objects := map[string]string{}
+	server.Handle("PutObject", func(ctx aws.Context, input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+		b, err := ioutil.ReadAll(input.Body)
+		if err != nil {
+			return nil, err
+		}
+		objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)] = string(b)
+		return &s3.PutObjectOutput{ETag: aws.String(`"etag"`)}, nil
+	})
+	server.Handle("GetObject", func(ctx aws.Context, input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+		content, ok := objects[aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Key)]
+		if !ok {
+			return nil, awserr.NewRequestFailure(
+				awserr.New(s3.ErrCodeNoSuchKey, "key not found", nil), http.StatusNotFound, "")
+		}
+		return &s3.GetObjectOutput{
+			Body:        ioutil.NopCloser(strings.NewReader(content)),
+			ContentType: aws.String("text/plain"),
+			Metadata:    map[string]*string{"Owner": aws.String("me")},
+		}, nil
+	})
+	server.Handle("ListObjectsV2", func(ctx aws.Context, input *s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error) {
+		out := &s3.ListObjectsV2Output{Prefix: input.Prefix}
+		for key := range objects {
+			if strings.HasPrefix(key, aws.StringValue(input.Bucket)+"/"+aws.StringValue(input.Prefix)) {
+				out.Contents = append(out.Contents, &s3.Object{Key: aws.String(key)})
+			}
+		}
+		return out, nil
+	})
+
+	svc := s3.New(newSession(ts.URL), &aws.Config{S3ForcePathStyle: aws.Bool(true)})
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/key+name"),
+		Body:   strings.NewReader("hello"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := map[string]string{"bucket/dir/key+name": "hello"}, objects; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v objects, got %v", e, a)
+	}
+
+	get, err := svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("dir/key+name"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	b, _ := ioutil.ReadAll(get.Body)
+	get.Body.Close()
+	if e, a := "hello", string(b); e != a {
+		t.Errorf("expect %v content, got %v", e, a)
+	}
+	if e, a := "text/plain", aws.StringValue(get.ContentType); e != a {
+		t.Errorf("expect %v content type, got %v", e, a)
+	}
+	if e, a := "me", aws.StringValue(get.Metadata["Owner"]); e != a {
+		t.Errorf("expect %v metadata, got %v", e, a)
+	}
+
+	list, err := svc.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket: aws.String("bucket"),
+		Prefix: aws.String("dir/"),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(list.Contents); e != a {
+		t.Fatalf("expect %v objects, got %v", e, a)
+	}
+	if e, a := "dir/", aws.StringValue(list.Prefix); e != a {
+		t.Errorf("expect %v prefix, got %v", e, a)
+	}
+
+	_, err = svc.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("missing"),
+	})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := s3.ErrCodeNoSuchKey, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := http.StatusNotFound, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+}
@@ -0,0 +1,15 @@
+// +build go1.7
+
+package standin
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// requestContext returns the context of the request, passed to the request's
+// operation handler.
+func requestContext(r *http.Request) aws.Context {
+	return r.Context()
+}
@@ -0,0 +1,15 @@
+// +build !go1.7
+
+package standin
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// requestContext returns a background context, as HTTP requests do not have
+// a context before Go 1.7.
+func requestContext(r *http.Request) aws.Context {
+	return aws.BackgroundContext()
+}
@@ -0,0 +1,389 @@
+package standin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/private/protocol"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+)
+
+// decodeInput decodes the request into the operation's input, the reverse of
+// the service client's request build handlers.
+func (s *Server) decodeInput(r *http.Request, labels map[string]string, input interface{}) error {
+	switch s.service.Protocol {
+	case ProtocolJSON:
+		return jsonutil.UnmarshalJSON(input, r.Body)
+	case ProtocolQuery, ProtocolEC2:
+		d := queryDecoder{values: r.Form, isEC2: s.service.Protocol == ProtocolEC2}
+		return d.decodeStruct(reflect.ValueOf(input), "")
+	default:
+		return s.decodeREST(r, labels, input)
+	}
+}
+
+func (s *Server) decodeREST(r *http.Request, labels map[string]string, input interface{}) error {
+	// The request's headers are decoded the same as response headers.
+	if err := rest.UnmarshalResponse(&http.Response{Header: r.Header}, input, false); err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(input).Elem()
+	t := v.Type()
+	query := r.URL.Query()
+
+	bound := map[string]bool{}
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.Tag.Get("location") == "querystring" {
+			bound[field.Tag.Get("locationName")] = true
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+		name := field.Tag.Get("locationName")
+		if name == "" {
+			name = field.Name
+		}
+
+		var err error
+		switch field.Tag.Get("location") {
+		case "uri":
+			if label, ok := labels[name]; ok {
+				err = setScalar(v.Field(i), label, field.Tag, protocol.ISO8601TimeFormatName)
+			}
+		case "querystring":
+			err = decodeQueryString(v.Field(i), query, name, field.Tag, bound)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to decode %s, %v", name, err)
+		}
+	}
+
+	switch rest.PayloadType(input) {
+	case "", "structure":
+		if s.service.Protocol == ProtocolRESTJSON {
+			return jsonutil.UnmarshalJSON(input, r.Body)
+		}
+		return xmlutil.UnmarshalXML(input, xml.NewDecoder(r.Body), "")
+	default:
+		return decodePayload(v, r.Body)
+	}
+}
+
+// decodePayload sets the input's payload member to the request's body, for
+// blob and string payloads.
+func decodePayload(v reflect.Value, body io.Reader) error {
+	b, err := ioutil.ReadAll(body)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+
+	field, _ := v.Type().FieldByName("_")
+	payload := v.FieldByName(field.Tag.Get("payload"))
+	switch payload.Interface().(type) {
+	case []byte:
+		payload.Set(reflect.ValueOf(b))
+	case *string:
+		str := string(b)
+		payload.Set(reflect.ValueOf(&str))
+	default:
+		// Streaming payloads, e.g. io.ReadSeeker.
+		r := reflect.ValueOf(bytes.NewReader(b))
+		if !r.Type().AssignableTo(payload.Type()) {
+			return fmt.Errorf("unsupported payload type %s", payload.Type())
+		}
+		payload.Set(r)
+	}
+	return nil
+}
+
+// decodeQueryString decodes the querystring member from the request's query
+// string. Map members are decoded from the query string parameters not bound
+// to other members.
+func decodeQueryString(v reflect.Value, query url.Values, name string, tag reflect.StructTag, bound map[string]bool) error {
+	switch v.Kind() {
+	case reflect.Map:
+		return decodeQueryStringMap(v, query, bound)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		for _, s := range query[name] {
+			item := reflect.New(v.Type().Elem()).Elem()
+			if err := setScalar(item, s, tag, protocol.ISO8601TimeFormatName); err != nil {
+				return err
+			}
+			v.Set(reflect.Append(v, item))
+		}
+		return nil
+	}
+
+	if vs, ok := query[name]; ok && len(vs) != 0 {
+		return setScalar(v, vs[0], tag, protocol.ISO8601TimeFormatName)
+	}
+	return nil
+}
+
+func decodeQueryStringMap(v reflect.Value, query url.Values, bound map[string]bool) error {
+	m := reflect.MakeMap(v.Type())
+	for k, vs := range query {
+		if bound[k] {
+			continue
+		}
+
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if elem.Kind() == reflect.Slice {
+			for _, s := range vs {
+				item := reflect.New(elem.Type().Elem()).Elem()
+				if err := setScalar(item, s, "", protocol.ISO8601TimeFormatName); err != nil {
+					return err
+				}
+				elem = reflect.Append(elem, item)
+			}
+		} else if err := setScalar(elem, vs[0], "", protocol.ISO8601TimeFormatName); err != nil {
+			return err
+		}
+		m.SetMapIndex(reflect.ValueOf(k), elem)
+	}
+
+	if m.Len() != 0 {
+		v.Set(m)
+	}
+	return nil
+}
+
+// setScalar sets the scalar value from its string representation. Timestamps
+// without a timestampFormat tag are parsed with the default format.
+func setScalar(v reflect.Value, s string, tag reflect.StructTag, timeFormat string) error {
+	switch v.Interface().(type) {
+	case *string:
+		v.Set(reflect.ValueOf(&s))
+	case []byte:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(b))
+	case *bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&b))
+	case *int64:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&i))
+	case *float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&f))
+	case *time.Time:
+		if format := tag.Get("timestampFormat"); len(format) != 0 {
+			timeFormat = format
+		}
+		t, err := protocol.ParseTime(timeFormat, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&t))
+	default:
+		return fmt.Errorf("unsupported value type %s", v.Type())
+	}
+	return nil
+}
+
+// A queryDecoder decodes query and EC2 query request parameters into the
+// input shape, the reverse of queryutil.Parse.
+type queryDecoder struct {
+	values url.Values
+	isEC2  bool
+}
+
+// has returns if the request has the parameter, or parameters nested within
+// it.
+func (d queryDecoder) has(name string) bool {
+	if _, ok := d.values[name]; ok {
+		return true
+	}
+	for k := range d.values {
+		if strings.HasPrefix(k, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// empty returns if the request has the parameter set to an empty value, the
+// encoding of empty lists and maps.
+func (d queryDecoder) empty(name string) bool {
+	vs, ok := d.values[name]
+	return ok && len(vs) != 0 && vs[0] == ""
+}
+
+func (d queryDecoder) decodeValue(v reflect.Value, name string, tag reflect.StructTag) error {
+	if !d.has(name) {
+		return nil
+	}
+
+	t := tag.Get("type")
+	if t == "" {
+		vt := v.Type()
+		if vt.Kind() == reflect.Ptr {
+			vt = vt.Elem()
+		}
+		switch vt.Kind() {
+		case reflect.Struct:
+			if vt != reflect.TypeOf(time.Time{}) {
+				t = "structure"
+			}
+		case reflect.Slice:
+			if vt.Elem().Kind() != reflect.Uint8 {
+				t = "list"
+			}
+		case reflect.Map:
+			t = "map"
+		}
+	}
+
+	switch t {
+	case "structure":
+		return d.decodeStruct(v, name)
+	case "list":
+		return d.decodeList(v, name, tag)
+	case "map":
+		return d.decodeMap(v, name, tag)
+	default:
+		return setScalar(v, d.values.Get(name), tag, protocol.ISO8601TimeFormatName)
+	}
+}
+
+func (d queryDecoder) decodeStruct(v reflect.Value, prefix string) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+		if field.Tag.Get("ignore") != "" {
+			continue
+		}
+
+		var name string
+		if d.isEC2 {
+			name = field.Tag.Get("queryName")
+		}
+		if name == "" {
+			if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+				name = field.Tag.Get("locationNameList")
+			} else if locName := field.Tag.Get("locationName"); locName != "" {
+				name = locName
+			}
+			if name != "" && d.isEC2 {
+				name = strings.ToUpper(name[0:1]) + name[1:]
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		if err := d.decodeValue(v.Field(i), queryutil.JoinName(prefix, name), field.Tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d queryDecoder) decodeList(v reflect.Value, prefix string, tag reflect.StructTag) error {
+	if d.empty(prefix) {
+		v.Set(reflect.MakeSlice(v.Type(), 0, 0))
+		return nil
+	}
+
+	if !d.isEC2 && tag.Get("flattened") == "" {
+		if listName := tag.Get("locationNameList"); listName == "" {
+			prefix += ".member"
+		} else {
+			prefix += "." + listName
+		}
+	}
+
+	for i := 1; ; i++ {
+		name := queryutil.JoinName(prefix, strconv.Itoa(i))
+		if !d.has(name) {
+			break
+		}
+		item := reflect.New(v.Type().Elem()).Elem()
+		if err := d.decodeValue(item, name, ""); err != nil {
+			return err
+		}
+		v.Set(reflect.Append(v, item))
+	}
+	return nil
+}
+
+func (d queryDecoder) decodeMap(v reflect.Value, prefix string, tag reflect.StructTag) error {
+	if v.IsNil() {
+		v.Set(reflect.MakeMap(v.Type()))
+	}
+	if d.empty(prefix) {
+		return nil
+	}
+
+	if !d.isEC2 && tag.Get("flattened") == "" {
+		prefix += ".entry"
+	}
+
+	kname := tag.Get("locationNameKey")
+	if kname == "" {
+		kname = "key"
+	}
+	vname := tag.Get("locationNameValue")
+	if vname == "" {
+		vname = "value"
+	}
+
+	for i := 1; ; i++ {
+		entry := queryutil.JoinName(prefix, strconv.Itoa(i))
+		if !d.has(entry) {
+			break
+		}
+		key := reflect.New(v.Type().Key()).Elem()
+		key.SetString(d.values.Get(entry + "." + kname))
+
+		value := reflect.New(v.Type().Elem()).Elem()
+		if err := d.decodeValue(value, entry+"."+vname, ""); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, value)
+	}
+	return nil
+}
@@ -0,0 +1,353 @@
+package standin
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+)
+
+// writeOutput encodes the operation's output as the response, the reverse of
+// the service client's response unmarshal handlers. Nothing is written if the
+// output fails to encode.
+func (s *Server) writeOutput(w http.ResponseWriter, op *Operation, reqID string, output interface{}) error {
+	status := op.ResponseCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	header := http.Header{}
+	var body []byte
+	var err error
+
+	switch s.service.Protocol {
+	case ProtocolJSON:
+		header.Set("Content-Type", "application/x-amz-json-"+s.service.JSONVersion)
+		body, err = jsonutil.BuildJSON(output)
+	case ProtocolQuery, ProtocolEC2:
+		header.Set("Content-Type", "text/xml")
+		body, err = buildQueryResponse(op, reqID, output, s.service.Protocol == ProtocolEC2)
+	default:
+		body, err = s.buildRESTResponse(header, &status, op, output)
+	}
+	if err != nil {
+		return err
+	}
+
+	for k, vs := range header {
+		w.Header()[k] = vs
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+
+	return nil
+}
+
+// buildQueryResponse returns the XML response of the query, or EC2 query,
+// operation. Query protocol outputs are wrapped in the operation's result
+// element.
+func buildQueryResponse(op *Operation, reqID string, output interface{}, isEC2 bool) ([]byte, error) {
+	resp := xmlutil.NewXMLElement(xml.Name{Local: op.Name + "Response"})
+
+	result, err := buildXMLNode(output)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = xmlutil.NewXMLElement(xml.Name{})
+	}
+
+	if isEC2 {
+		resp.Attr = append(resp.Attr, result.Attr...)
+		for _, children := range result.Children {
+			for _, child := range children {
+				resp.AddChild(child)
+			}
+		}
+		resp.AddChild(newXMLText("requestId", reqID))
+	} else {
+		result.Name = xml.Name{Local: op.Name + "Result"}
+		resp.AddChild(result)
+
+		meta := xmlutil.NewXMLElement(xml.Name{Local: "ResponseMetadata"})
+		meta.AddChild(newXMLText("RequestId", reqID))
+		resp.AddChild(meta)
+	}
+
+	var buf bytes.Buffer
+	if err := xmlutil.StructToXML(xml.NewEncoder(&buf), resp, false); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// buildXMLNode returns the XMLNode of the output's body members. Flattened
+// list members named only by locationNameList are renamed to the name the
+// service client unmarshals them with, instead of the Go field name the SDK
+// builds them with.
+func buildXMLNode(output interface{}) (*xmlutil.XMLNode, error) {
+	node, err := xmlutil.BuildXMLNode(output)
+	if err != nil || node == nil {
+		return node, err
+	}
+	renameFlattenedLists(reflect.ValueOf(output), node)
+	return node, nil
+}
+
+func renameFlattenedLists(v reflect.Value, node *xmlutil.XMLNode) {
+	v = reflect.Indirect(v)
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || field.Tag.Get("location") != "" {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+		if name == "" {
+			name = field.Name
+		}
+		children := node.Children[name]
+
+		switch member := v.Field(i); member.Kind() {
+		case reflect.Ptr, reflect.Struct:
+			if len(children) != 0 {
+				renameFlattenedLists(member, children[0])
+			}
+		case reflect.Slice:
+			if field.Tag.Get("flattened") == "" {
+				if len(children) == 0 {
+					continue
+				}
+				itemName := field.Tag.Get("locationNameList")
+				if itemName == "" {
+					itemName = "member"
+				}
+				children = children[0].Children[itemName]
+			} else if listName := field.Tag.Get("locationNameList"); field.Tag.Get("locationName") == "" && listName != "" {
+				delete(node.Children, name)
+				for _, child := range children {
+					child.Name.Local = listName
+				}
+				node.Children[listName] = children
+			}
+			for j := 0; j < member.Len() && j < len(children); j++ {
+				renameFlattenedLists(member.Index(j), children[j])
+			}
+		}
+	}
+}
+
+func newXMLText(name, text string) *xmlutil.XMLNode {
+	node := xmlutil.NewXMLElement(xml.Name{Local: name})
+	node.Text = text
+	return node
+}
+
+// buildRESTResponse returns the body of the REST operation's response, and
+// adds the output's header members to the response header.
+func (s *Server) buildRESTResponse(header http.Header, status *int, op *Operation, output interface{}) ([]byte, error) {
+	v := reflect.ValueOf(output).Elem()
+
+	// The output's header members are built the same as request headers. The
+	// payload member is encoded separately, as streaming payloads of outputs
+	// are not io.ReadSeekers.
+	var payload reflect.Value
+	params := reflect.New(v.Type())
+	params.Elem().Set(v)
+	if field, ok := v.Type().FieldByName("_"); ok {
+		if name := field.Tag.Get("payload"); name != "" {
+			payload = v.FieldByName(name)
+			member := params.Elem().FieldByName(name)
+			member.Set(reflect.Zero(member.Type()))
+		}
+	}
+
+	req := request.New(aws.Config{}, metadata.ClientInfo{}, request.Handlers{}, nil,
+		&request.Operation{Name: op.Name, HTTPMethod: "GET", HTTPPath: "/"},
+		params.Interface(), nil)
+	rest.Build(req)
+	if req.Error != nil {
+		return nil, req.Error
+	}
+	for k, vs := range req.HTTPRequest.Header {
+		header[k] = vs
+	}
+	// The length of the body is set by the server.
+	header.Del("Content-Length")
+
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("location") == "statusCode" {
+			if code := v.Field(i); code.Kind() == reflect.Ptr && !code.IsNil() {
+				*status = int(code.Elem().Int())
+			}
+		}
+	}
+
+	switch rest.PayloadType(output) {
+	case "", "structure":
+		if s.service.Protocol == ProtocolRESTJSON {
+			header.Set("Content-Type", "application/json")
+			return jsonutil.BuildJSON(output)
+		}
+		return buildRESTXMLBody(op, output)
+	default:
+		return readPayload(payload)
+	}
+}
+
+func buildRESTXMLBody(op *Operation, output interface{}) ([]byte, error) {
+	node, err := buildXMLNode(output)
+	if err != nil || node == nil {
+		return nil, err
+	}
+	if node.Name.Local == "" {
+		node.Name = xml.Name{Local: op.Name + "Result"}
+	}
+
+	var buf bytes.Buffer
+	if err := xmlutil.StructToXML(xml.NewEncoder(&buf), node, false); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// readPayload returns the content of a blob or string payload member.
+func readPayload(payload reflect.Value) ([]byte, error) {
+	if !payload.IsValid() {
+		return nil, nil
+	}
+
+	switch p := payload.Interface().(type) {
+	case []byte:
+		return p, nil
+	case *string:
+		return []byte(aws.StringValue(p)), nil
+	case io.Reader:
+		if c, ok := p.(io.Closer); ok {
+			defer c.Close()
+		}
+		return ioutil.ReadAll(p)
+	}
+	return nil, nil
+}
+
+// writeError encodes the error as the service's error response.
+func (s *Server) writeError(w http.ResponseWriter, reqID string, err error) {
+	code, message, status := s.errorInfo(err)
+
+	var body []byte
+	switch s.service.Protocol {
+	case ProtocolJSON:
+		w.Header().Set("Content-Type", "application/x-amz-json-"+s.service.JSONVersion)
+		body = buildJSONError(err, "__type", code, message)
+	case ProtocolRESTJSON:
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Amzn-Errortype", code)
+		body = buildJSONError(err, "code", code, message)
+	case ProtocolEC2:
+		w.Header().Set("Content-Type", "text/xml")
+		body, _ = xml.Marshal(ec2ErrorResponse{
+			Code: code, Message: message, RequestID: reqID,
+		})
+	default:
+		w.Header().Set("Content-Type", "text/xml")
+		if s.service.Protocol == ProtocolRESTXML && s.service.ServiceID == "S3" {
+			body, _ = xml.Marshal(s3ErrorResponse{
+				Code: code, Message: message, RequestID: reqID,
+			})
+			break
+		}
+
+		errType := "Sender"
+		if status >= 500 {
+			errType = "Receiver"
+		}
+		body, _ = xml.Marshal(xmlErrorResponse{
+			Type: errType, Code: code, Message: message, RequestID: reqID,
+		})
+	}
+
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// errorInfo returns the error code, message, and HTTP status code of the
+// error. Errors which are not awserr.Errors are internal failures.
+func (s *Server) errorInfo(err error) (code, message string, status int) {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return ErrCodeInternalFailure, err.Error(), http.StatusInternalServerError
+	}
+
+	code, message = aerr.Code(), aerr.Message()
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() != 0 {
+		return code, message, reqErr.StatusCode()
+	}
+	if status := s.service.Errors[code]; status != 0 {
+		return code, message, status
+	}
+	return code, message, http.StatusBadRequest
+}
+
+// buildJSONError returns the JSON error response body. The members of modeled
+// error shapes are included in the body.
+func buildJSONError(err error, codeKey, code, message string) []byte {
+	fields := map[string]interface{}{}
+
+	v := reflect.Indirect(reflect.ValueOf(err))
+	if v.Kind() == reflect.Struct {
+		if _, ok := v.Type().FieldByName("_"); ok {
+			if b, err := jsonutil.BuildJSON(err); err == nil {
+				json.Unmarshal(b, &fields)
+			}
+		}
+	}
+
+	fields[codeKey] = code
+	_, hasLower := fields["message"]
+	_, hasUpper := fields["Message"]
+	if !hasLower && !hasUpper {
+		fields["message"] = message
+	}
+
+	b, _ := json.Marshal(fields)
+	return b
+}
+
+type xmlErrorResponse struct {
+	XMLName   xml.Name `xml:"ErrorResponse"`
+	Type      string   `xml:"Error>Type"`
+	Code      string   `xml:"Error>Code"`
+	Message   string   `xml:"Error>Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type s3ErrorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	RequestID string   `xml:"RequestId"`
+}
+
+type ec2ErrorResponse struct {
+	XMLName   xml.Name `xml:"Response"`
+	Code      string   `xml:"Errors>Error>Code"`
+	Message   string   `xml:"Errors>Error>Message"`
+	RequestID string   `xml:"RequestID"`
+}
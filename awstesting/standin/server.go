@@ -0,0 +1,334 @@
+package standin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+var (
+	contextType = reflect.TypeOf((*aws.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// A Server is an HTTP handler standing in for a service. Requests are decoded
+// into the input of the requested operation, and served by the handler
+// registered for the operation. Server is safe to use concurrently.
+type Server struct {
+	// Number of requests served, used for generating request IDs. Must be
+	// the first field for 64 bit atomic alignment.
+	requests uint64
+
+	service *Service
+	routes  []route
+
+	mu       sync.RWMutex
+	handlers map[string]reflect.Value
+}
+
+// NewServer returns a Server standing in for the service, with no operation
+// handlers registered.
+func NewServer(svc *Service) *Server {
+	s := &Server{
+		service:  svc,
+		handlers: map[string]reflect.Value{},
+	}
+
+	switch svc.Protocol {
+	case ProtocolRESTJSON, ProtocolRESTXML:
+		s.routes = newRoutes(svc.Operations)
+	}
+
+	return s
+}
+
+// Handle registers the handler serving the requests of the operation,
+// replacing the operation's current handler if one is registered.
+//
+// The handler must be a func taking an aws.Context and the operation's input,
+// returning the operation's output and an error, e.g.
+//
+//    func(aws.Context, *sqs.SendMessageInput) (*sqs.SendMessageOutput, error)
+//
+// Errors returned by the handler are encoded as the service's error
+// responses. The HTTP status code of the error is taken from the error if it
+// is an awserr.RequestFailure, or from the service's modeled errors.
+func (s *Server) Handle(operation string, handler interface{}) error {
+	op, ok := s.service.Operations[operation]
+	if !ok {
+		return awserr.New(ErrCodeInvalidHandler,
+			fmt.Sprintf("%s is not an operation of the service", operation), nil)
+	}
+
+	fn := reflect.ValueOf(handler)
+	if fn.Kind() != reflect.Func || !isHandlerType(fn.Type(), op) {
+		return awserr.New(ErrCodeInvalidHandler,
+			fmt.Sprintf("%s handler must be func(aws.Context, %v) (%v, error), got %T",
+				operation, op.InputType, op.OutputType, handler), nil)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[operation] = fn
+	return nil
+}
+
+func isHandlerType(t reflect.Type, op *Operation) bool {
+	return t.NumIn() == 2 && contextType.AssignableTo(t.In(0)) && t.In(1) == op.InputType &&
+		t.NumOut() == 2 && t.Out(0) == op.OutputType && t.Out(1) == errorType
+}
+
+func (s *Server) handler(operation string) (reflect.Value, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	fn, ok := s.handlers[operation]
+	return fn, ok
+}
+
+// ServeHTTP serves the request made to the service by the handler of the
+// requested operation.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	reqID := fmt.Sprintf("standin-%d", atomic.AddUint64(&s.requests, 1))
+	w.Header().Set("X-Amzn-Requestid", reqID)
+	if s.service.Protocol == ProtocolRESTXML {
+		w.Header().Set("X-Amz-Request-Id", reqID)
+	}
+
+	op, labels, err := s.operation(r)
+	if err != nil {
+		s.writeError(w, reqID, err)
+		return
+	}
+
+	input := reflect.New(op.InputType.Elem())
+	if err := s.decodeInput(r, labels, input.Interface()); err != nil {
+		s.writeError(w, reqID, awserr.NewRequestFailure(
+			awserr.New(ErrCodeSerialization,
+				fmt.Sprintf("failed to decode %s request", op.Name), err),
+			http.StatusBadRequest, reqID))
+		return
+	}
+
+	fn, ok := s.handler(op.Name)
+	if !ok {
+		s.writeError(w, reqID, awserr.NewRequestFailure(
+			awserr.New(ErrCodeNotHandled,
+				fmt.Sprintf("%s operation does not have a handler", op.Name), nil),
+			http.StatusNotImplemented, reqID))
+		return
+	}
+
+	results := fn.Call([]reflect.Value{reflect.ValueOf(requestContext(r)), input})
+	if err, _ := results[1].Interface().(error); err != nil {
+		s.writeError(w, reqID, err)
+		return
+	}
+
+	output := results[0]
+	if output.IsNil() {
+		output = reflect.New(op.OutputType.Elem())
+	}
+	if err := s.writeOutput(w, op, reqID, output.Interface()); err != nil {
+		s.writeError(w, reqID, awserr.NewRequestFailure(
+			awserr.New(ErrCodeInternalFailure,
+				fmt.Sprintf("failed to encode %s response", op.Name), err),
+			http.StatusInternalServerError, reqID))
+	}
+}
+
+// operation returns the operation of the request, and the values of the
+// operation's URI labels for REST protocols.
+func (s *Server) operation(r *http.Request) (*Operation, map[string]string, error) {
+	var name string
+	var labels map[string]string
+
+	switch s.service.Protocol {
+	case ProtocolJSON:
+		target := r.Header.Get("X-Amz-Target")
+		if strings.HasPrefix(target, s.service.TargetPrefix+".") {
+			name = target[len(s.service.TargetPrefix)+1:]
+		}
+	case ProtocolQuery, ProtocolEC2:
+		if err := r.ParseForm(); err != nil {
+			return nil, nil, awserr.New(ErrCodeSerialization, "failed to parse request form", err)
+		}
+		name = r.Form.Get("Action")
+	case ProtocolRESTJSON, ProtocolRESTXML:
+		for _, rt := range s.routes {
+			if l, ok := rt.match(r); ok {
+				name, labels = rt.operation.Name, l
+				break
+			}
+		}
+	default:
+		return nil, nil, awserr.New(ErrCodeUnknownOperation,
+			fmt.Sprintf("%s protocol is not supported", s.service.Protocol), nil)
+	}
+
+	op, ok := s.service.Operations[name]
+	if !ok {
+		return nil, nil, awserr.New(ErrCodeUnknownOperation,
+			fmt.Sprintf("no operation found for %s %s", r.Method, r.URL.Path), nil)
+	}
+
+	return op, labels, nil
+}
+
+// A route matches the requests of a REST protocol operation by the
+// operation's HTTP method and request URI template.
+type route struct {
+	operation *Operation
+	method    string
+	segments  []string
+	query     url.Values
+	headers   []string
+	score     int
+}
+
+func newRoutes(ops map[string]*Operation) []route {
+	routes := make([]route, 0, len(ops))
+	for _, op := range ops {
+		rt := route{
+			operation: op,
+			method:    op.HTTPMethod,
+			query:     url.Values{},
+		}
+
+		path, rawQuery := op.HTTPPath, ""
+		if i := strings.Index(path, "?"); i >= 0 {
+			path, rawQuery = path[:i], path[i+1:]
+		}
+
+		rt.segments = strings.Split(strings.TrimPrefix(path, "/"), "/")
+		for _, seg := range rt.segments {
+			if !isLabel(seg) {
+				rt.score += 100
+			}
+		}
+
+		for _, kv := range strings.Split(rawQuery, "&") {
+			if kv == "" {
+				continue
+			}
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) == 1 || strings.HasPrefix(parts[1], "{") {
+				parts = []string{parts[0], ""}
+			}
+			rt.query.Set(parts[0], parts[1])
+			rt.score += 10
+		}
+
+		// Operations sharing a request URI are distinguished by their required
+		// headers, e.g. S3's CopyObject and PutObject.
+		if t := op.InputType; t != nil && t.Kind() == reflect.Ptr {
+			for i := 0; i < t.Elem().NumField(); i++ {
+				tag := t.Elem().Field(i).Tag
+				if tag.Get("location") == "header" && tag.Get("required") == "true" {
+					rt.headers = append(rt.headers, tag.Get("locationName"))
+					rt.score += 10
+				}
+			}
+		}
+
+		routes = append(routes, rt)
+	}
+
+	// The most specific route matching the request is used, e.g. /{Bucket}?acl
+	// is matched before /{Bucket}.
+	sort.Sort(routesByScore(routes))
+	return routes
+}
+
+func isLabel(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+// match returns the values of the route's URI labels if the request matches
+// the route.
+func (rt route) match(r *http.Request) (map[string]string, bool) {
+	if r.Method != rt.method {
+		return nil, false
+	}
+
+	query := r.URL.Query()
+	for k := range rt.query {
+		vs, ok := query[k]
+		if !ok {
+			return nil, false
+		}
+		if v := rt.query.Get(k); v != "" && (len(vs) == 0 || vs[0] != v) {
+			return nil, false
+		}
+	}
+
+	for _, h := range rt.headers {
+		if len(r.Header.Get(h)) == 0 {
+			return nil, false
+		}
+	}
+
+	segments := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	labels := map[string]string{}
+	for i, seg := range rt.segments {
+		if i >= len(segments) {
+			return nil, false
+		}
+
+		if !isLabel(seg) {
+			if seg != segments[i] {
+				return nil, false
+			}
+			continue
+		}
+
+		name := seg[1 : len(seg)-1]
+		if strings.HasSuffix(name, "+") {
+			// Greedy labels match the remainder of the path.
+			v, err := unescapePath(strings.Join(segments[i:], "/"))
+			if err != nil || v == "" {
+				return nil, false
+			}
+			labels[strings.TrimSuffix(name, "+")] = v
+			return labels, true
+		}
+
+		v, err := unescapePath(segments[i])
+		if err != nil || v == "" {
+			return nil, false
+		}
+		labels[name] = v
+	}
+
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+
+	return labels, true
+}
+
+func unescapePath(p string) (string, error) {
+	// Plus signs are not escaped in paths, and must not be unescaped as
+	// spaces.
+	return url.QueryUnescape(strings.Replace(p, "+", "%2B", -1))
+}
+
+type routesByScore []route
+
+func (rs routesByScore) Len() int      { return len(rs) }
+func (rs routesByScore) Swap(i, j int) { rs[i], rs[j] = rs[j], rs[i] }
+func (rs routesByScore) Less(i, j int) bool {
+	if rs[i].score != rs[j].score {
+		return rs[i].score > rs[j].score
+	}
+	return rs[i].operation.Name < rs[j].operation.Name
+}
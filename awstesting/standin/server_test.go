@@ -0,0 +1,291 @@
+package standin_test
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/awstesting/standin"
+	"github.com/aws/aws-sdk-go/internal/sdktesting"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func newSession(endpoint string) *session.Session {
+	return session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", "SESSION"),
+		Region:      aws.String("us-west-2"),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(0),
+	}))
+}
+
+func md5Sum(s string) *string {
+	sum := md5.Sum([]byte(s))
+	return aws.String(hex.EncodeToString(sum[:]))
+}
+
+var sqsService = &standin.Service{
+	ServiceID: "SQS",
+	Protocol:  standin.ProtocolQuery,
+	Operations: map[string]*standin.Operation{
+		"SendMessage": {
+			Name:       "SendMessage",
+			InputType:  reflect.TypeOf(&sqs.SendMessageInput{}),
+			OutputType: reflect.TypeOf(&sqs.SendMessageOutput{}),
+		},
+		"ReceiveMessage": {
+			Name:       "ReceiveMessage",
+			InputType:  reflect.TypeOf(&sqs.ReceiveMessageInput{}),
+			OutputType: reflect.TypeOf(&sqs.ReceiveMessageOutput{}),
+		},
+	},
+	Errors: map[string]int{
+		sqs.ErrCodeQueueDoesNotExist: 400,
+	},
+}
+
+func TestServer_Query(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	var sent []*sqs.SendMessageInput
+	server := standin.NewServer(sqsService)
+	err := server.Handle("SendMessage", func(ctx aws.Context, input *sqs.SendMessageInput) (*sqs.SendMessageOutput, error) {
+		sent = append(sent, input)
+		return &sqs.SendMessageOutput{
+			MessageId:        aws.String("message-id"),
+			MD5OfMessageBody: md5Sum(aws.StringValue(input.MessageBody)),
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	err = server.Handle("ReceiveMessage", func(ctx aws.Context, input *sqs.ReceiveMessageInput) (*sqs.ReceiveMessageOutput, error) {
+		if e, a := []string{"All"}, aws.StringValueSlice(input.MessageAttributeNames); !reflect.DeepEqual(e, a) {
+			t.Errorf("expect %v attribute names, got %v", e, a)
+		}
+		var msgs []*sqs.Message
+		for i, in := range sent {
+			msgs = append(msgs, &sqs.Message{
+				MessageId:         aws.String(strconv.Itoa(i)),
+				Body:              in.MessageBody,
+				MD5OfBody:         md5Sum(aws.StringValue(in.MessageBody)),
+				MessageAttributes: in.MessageAttributes,
+			})
+		}
+		return &sqs.ReceiveMessageOutput{Messages: msgs}, nil
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	svc := sqs.New(newSession(ts.URL))
+
+	queueURL := ts.URL + "/123456789012/queue"
+	for _, body := range []string{"hello", "world"} {
+		out, err := svc.SendMessage(&sqs.SendMessageInput{
+			QueueUrl:    aws.String(queueURL),
+			MessageBody: aws.String(body),
+			MessageAttributes: map[string]*sqs.MessageAttributeValue{
+				"color": {DataType: aws.String("String"), StringValue: aws.String("blue")},
+				"size":  {DataType: aws.String("Number"), StringValue: aws.String("1")},
+			},
+		})
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		if e, a := "message-id", aws.StringValue(out.MessageId); e != a {
+			t.Errorf("expect %v message id, got %v", e, a)
+		}
+	}
+
+	if e, a := queueURL, aws.StringValue(sent[0].QueueUrl); e != a {
+		t.Errorf("expect %v queue url, got %v", e, a)
+	}
+
+	out, err := svc.ReceiveMessage(&sqs.ReceiveMessageInput{
+		QueueUrl:              aws.String(queueURL),
+		MessageAttributeNames: []*string{aws.String("All")},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(out.Messages); e != a {
+		t.Fatalf("expect %v messages, got %v", e, a)
+	}
+	for i, body := range []string{"hello", "world"} {
+		msg := out.Messages[i]
+		if e, a := body, aws.StringValue(msg.Body); e != a {
+			t.Errorf("expect %v body, got %v", e, a)
+		}
+		if e, a := "blue", aws.StringValue(msg.MessageAttributes["color"].StringValue); e != a {
+			t.Errorf("expect %v attribute, got %v", e, a)
+		}
+		if e, a := "Number", aws.StringValue(msg.MessageAttributes["size"].DataType); e != a {
+			t.Errorf("expect %v attribute type, got %v", e, a)
+		}
+	}
+}
+
+func TestServer_QueryError(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server := standin.NewServer(sqsService)
+	server.Handle("SendMessage", func(ctx aws.Context, input *sqs.SendMessageInput) (*sqs.SendMessageOutput, error) {
+		return nil, awserr.New(sqs.ErrCodeQueueDoesNotExist, "queue does not exist", nil)
+	})
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	svc := sqs.New(newSession(ts.URL))
+
+	cases := map[string]struct {
+		Send   func() error
+		Code   string
+		Status int
+	}{
+		"modeled error": {
+			Send: func() error {
+				_, err := svc.SendMessage(&sqs.SendMessageInput{
+					QueueUrl:    aws.String(ts.URL + "/queue"),
+					MessageBody: aws.String("hello"),
+				})
+				return err
+			},
+			Code:   sqs.ErrCodeQueueDoesNotExist,
+			Status: 400,
+		},
+		"not handled": {
+			Send: func() error {
+				_, err := svc.ReceiveMessage(&sqs.ReceiveMessageInput{
+					QueueUrl: aws.String(ts.URL + "/queue"),
+				})
+				return err
+			},
+			Code:   standin.ErrCodeNotHandled,
+			Status: 501,
+		},
+		"unknown operation": {
+			Send: func() error {
+				_, err := svc.DeleteQueue(&sqs.DeleteQueueInput{
+					QueueUrl: aws.String(ts.URL + "/queue"),
+				})
+				return err
+			},
+			Code:   standin.ErrCodeUnknownOperation,
+			Status: 400,
+		},
+	}
+
+	for name, c := range cases {
+		err := c.Send()
+		if err == nil {
+			t.Fatalf("%s, expect error, got none", name)
+		}
+		reqErr := err.(awserr.RequestFailure)
+		if e, a := c.Code, reqErr.Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+		if e, a := c.Status, reqErr.StatusCode(); e != a {
+			t.Errorf("%s, expect %v status code, got %v", name, e, a)
+		}
+		if len(reqErr.RequestID()) == 0 {
+			t.Errorf("%s, expect request id, got none", name)
+		}
+	}
+}
+
+func TestServer_JSON(t *testing.T) {
+	restoreEnvFn := sdktesting.StashEnv()
+	defer restoreEnvFn()
+
+	server := standin.NewServer(&standin.Service{
+		ServiceID:    "DynamoDB",
+		Protocol:     standin.ProtocolJSON,
+		TargetPrefix: "DynamoDB_20120810",
+		JSONVersion:  "1.0",
+		Operations: map[string]*standin.Operation{
+			"GetItem": {
+				Name:       "GetItem",
+				InputType:  reflect.TypeOf(&dynamodb.GetItemInput{}),
+				OutputType: reflect.TypeOf(&dynamodb.GetItemOutput{}),
+			},
+		},
+	})
+	server.Handle("GetItem", func(ctx aws.Context, input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+		if aws.StringValue(input.TableName) != "table" {
+			return nil, &dynamodb.ResourceNotFoundException{Message_: aws.String("table not found")}
+		}
+		return &dynamodb.GetItemOutput{Item: input.Key}, nil
+	})
+
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+	svc := dynamodb.New(newSession(ts.URL))
+
+	key := map[string]*dynamodb.AttributeValue{
+		"id": {S: aws.String("abc")},
+	}
+	out, err := svc.GetItem(&dynamodb.GetItemInput{TableName: aws.String("table"), Key: key})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := key, out.Item; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v item, got %v", e, a)
+	}
+
+	_, err = svc.GetItem(&dynamodb.GetItemInput{TableName: aws.String("other"), Key: key})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if _, ok := err.(*dynamodb.ResourceNotFoundException); !ok {
+		t.Fatalf("expect ResourceNotFoundException, got %T, %v", err, err)
+	}
+	if e, a := "table not found", err.(awserr.Error).Message(); e != a {
+		t.Errorf("expect %v message, got %v", e, a)
+	}
+	if e, a := http.StatusBadRequest, err.(awserr.RequestFailure).StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+}
+
+func TestServer_Handle(t *testing.T) {
+	server := standin.NewServer(sqsService)
+
+	cases := map[string]interface{}{
+		"unknown operation": "DeleteQueue",
+		"not a func":        "SendMessage",
+		"wrong input": func(aws.Context, *sqs.ReceiveMessageInput) (*sqs.SendMessageOutput, error) {
+			return nil, nil
+		},
+		"missing context": func(*sqs.SendMessageInput) (*sqs.SendMessageOutput, error) {
+			return nil, nil
+		},
+	}
+
+	for name, handler := range cases {
+		op := "SendMessage"
+		if name == "unknown operation" {
+			op = "DeleteQueue"
+		}
+		err := server.Handle(op, handler)
+		if err == nil {
+			t.Fatalf("%s, expect error, got none", name)
+		}
+		if e, a := standin.ErrCodeInvalidHandler, err.(awserr.Error).Code(); e != a {
+			t.Errorf("%s, expect %v error code, got %v", name, e, a)
+		}
+	}
+}
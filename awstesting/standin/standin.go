@@ -0,0 +1,108 @@
+// Package standin provides in-process stand-ins for AWS services, for testing
+// code using the SDK's service clients against a local HTTP server.
+//
+// A stand-in service is described by the protocol and operations of the
+// service's API model. The Server decodes the requests made by the SDK's
+// service client into the operation's input shape, calls the Go handler
+// registered for the operation, and encodes the handler's output, or error,
+// in the wire format of the service's protocol. The json, rest-json,
+// rest-xml, query, and ec2 protocols are supported.
+//
+// The Service of an API model is created with NewService, built with the
+// codegen build tag.
+//
+//    svc, err := standin.NewService(sqsAPI, (*sqs.SQS)(nil))
+//    server := standin.NewServer(svc)
+//    server.Handle("SendMessage", func(ctx aws.Context, input *sqs.SendMessageInput) (*sqs.SendMessageOutput, error) {
+//        return &sqs.SendMessageOutput{MessageId: aws.String("id")}, nil
+//    })
+//
+//    ts := httptest.NewServer(server)
+//    defer ts.Close()
+//
+//    client := sqs.New(sess, &aws.Config{Endpoint: aws.String(ts.URL)})
+package standin
+
+import (
+	"reflect"
+)
+
+const (
+	// ErrCodeUnknownOperation is the error code returned to the client for
+	// requests which do not match any operation of the service.
+	ErrCodeUnknownOperation = "UnknownOperationException"
+
+	// ErrCodeNotHandled is the error code returned to the client for
+	// requests to operations without a registered handler.
+	ErrCodeNotHandled = "NotHandled"
+
+	// ErrCodeSerialization is the error code returned to the client for
+	// requests which could not be decoded into the operation's input.
+	ErrCodeSerialization = "SerializationException"
+
+	// ErrCodeInternalFailure is the error code returned to the client when a
+	// handler returns an error that is not an awserr.Error, or the output of
+	// the handler could not be encoded.
+	ErrCodeInternalFailure = "InternalFailure"
+
+	// ErrCodeInvalidHandler is the error code of the error returned by
+	// Server.Handle for handlers which do not match the operation's input
+	// and output.
+	ErrCodeInvalidHandler = "InvalidHandler"
+)
+
+// Protocols supported by the stand-in services, named the same as the
+// protocols of the API models.
+const (
+	ProtocolJSON     = "json"
+	ProtocolRESTJSON = "rest-json"
+	ProtocolRESTXML  = "rest-xml"
+	ProtocolQuery    = "query"
+	ProtocolEC2      = "ec2"
+)
+
+// A Service describes the wire protocol and operations of the service a
+// Server stands in for.
+type Service struct {
+	// The ID of the service, e.g. "SQS".
+	ServiceID string
+
+	// The wire protocol of the service.
+	Protocol string
+
+	// The prefix of the X-Amz-Target header of requests to the service's
+	// operations, for the json protocol.
+	TargetPrefix string
+
+	// The version of the JSON content type of the service, e.g. "1.0", for
+	// the json protocol.
+	JSONVersion string
+
+	// The service's operations, by operation name.
+	Operations map[string]*Operation
+
+	// The HTTP status code of the service's modeled errors, by error code.
+	// Errors without a status code use 400.
+	Errors map[string]int
+}
+
+// An Operation describes an API operation of a stand-in Service.
+type Operation struct {
+	// The name of the operation, e.g. "SendMessage".
+	Name string
+
+	// The HTTP method and request URI template of the operation, for the
+	// rest-json and rest-xml protocols. The template's path labels, e.g.
+	// {Bucket} or {Key+}, are bound to the input's uri members, and the
+	// template's query string keys must be present in the request.
+	HTTPMethod string
+	HTTPPath   string
+
+	// The HTTP status code of successful responses. Defaults to 200.
+	ResponseCode int
+
+	// The Go types of the operation's input and output shapes, pointers to
+	// the shape structs, e.g. *sqs.SendMessageInput.
+	InputType  reflect.Type
+	OutputType reflect.Type
+}
@@ -0,0 +1,64 @@
+// +build codegen
+
+// Command gen-client generates a Go client package from an API model, for
+// APIs outside of the SDK. The client package is written to the output path,
+// and imported by the import path.
+//
+//    go run -tags codegen github.com/aws/aws-sdk-go/codegen/cli/gen-client \
+//        -import-path example.com/myservice \
+//        -path ./myservice \
+//        ./models/myservice/2020-01-01/api-2.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/codegen"
+)
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `Usage: gen-client <options> <model file path>
+Loads the API model from file and generates a Go client package from the model.
+
+The model file is an API model, api-2.json, or a Smithy JSON AST model. The
+documentation, paginators, waiters, and examples of API models are loaded from
+the docs-2.json, paginators-1.json, waiters-2.json, and examples-1.json files in
+the model file's directory.
+
+Flags:`)
+	flag.PrintDefaults()
+}
+
+func main() {
+	var opts codegen.Options
+	flag.StringVar(&opts.OutputDir, "path", "",
+		"The `path` to write the generated client package to.",
+	)
+	flag.StringVar(&opts.ImportPath, "import-path", "",
+		"The Go `import path` of the generated client package.",
+	)
+	flag.StringVar(&opts.Writer.Generator, "generator", "",
+		"The `name` of the generator in the generated files' header. Defaults to "+codegen.DefaultGenerator+".",
+	)
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() != 1 || len(opts.OutputDir) == 0 || len(opts.ImportPath) == 0 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	opts.ModelPath = filepath.FromSlash(flag.Arg(0))
+	opts.OutputDir = filepath.FromSlash(opts.OutputDir)
+
+	a, err := codegen.GenerateClient(opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to generate client,", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Generated %s (%s) in %s\n",
+		a.PackageName(), a.Metadata.APIVersion, opts.OutputDir)
+}
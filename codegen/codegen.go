@@ -0,0 +1,79 @@
+// +build codegen
+
+// Package codegen generates Go clients for APIs described by API models,
+// outside of the SDK's own service packages. The generated client packages
+// depend only on the SDK's packages, and can be written to any directory and
+// import path, e.g. for private APIs, or services fronted by API Gateway.
+//
+// Models are in the SDK's JSON API model format, api-2.json, or are Smithy
+// JSON AST models. The documentation, paginators, waiters, and examples of
+// API models are loaded from the docs-2.json, paginators-1.json,
+// waiters-2.json, and examples-1.json files in the model's directory.
+//
+// The package must be built with the codegen build tag.
+//
+//    go run -tags codegen github.com/aws/aws-sdk-go/codegen/cli/gen-client \
+//        -import-path example.com/myservice \
+//        -path ./myservice \
+//        ./models/myservice/2020-01-01/api-2.json
+package codegen
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/aws/aws-sdk-go/private/model/api"
+)
+
+// Options provides the options for generating a client package.
+type Options struct {
+	// The path of the API model file.
+	ModelPath string
+
+	// The directory the client package is written to. The client's interface
+	// and fake packages are written to subdirectories of the directory.
+	OutputDir string
+
+	// The Go import path of the client package written to the OutputDir.
+	ImportPath string
+
+	// Writes the files of the client package. The zero value Writer is used
+	// if not set.
+	Writer Writer
+}
+
+// GenerateClient loads the API model, and writes the Go client package
+// generated from the model. Returns the loaded API.
+//
+// The SDK's service specific customizations are not applied to the API, so
+// clients of models sharing the name of an SDK service are generated as
+// modeled.
+func GenerateClient(opts Options) (*api.API, error) {
+	if len(opts.ModelPath) == 0 || len(opts.OutputDir) == 0 || len(opts.ImportPath) == 0 {
+		return nil, fmt.Errorf("model path, output directory, and import path are required")
+	}
+
+	loader := api.Loader{
+		BaseImport:          path.Dir(opts.ImportPath),
+		NoSDKCustomizations: true,
+	}
+	apis, err := loader.Load([]string{opts.ModelPath})
+	if err != nil {
+		return nil, err
+	}
+
+	var a *api.API
+	for _, v := range apis {
+		a = v
+	}
+	if len(a.Operations) == 0 {
+		return nil, fmt.Errorf("API model %s has no operations", opts.ModelPath)
+	}
+	a.ClientImportPath = opts.ImportPath
+
+	if err := opts.Writer.WriteClient(a, opts.OutputDir); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
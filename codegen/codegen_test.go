@@ -0,0 +1,122 @@
+// +build codegen
+
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testModel = `{
+	"version": "2.0",
+	"metadata": {
+		"apiVersion": "2020-01-01",
+		"endpointPrefix": "sqs",
+		"jsonVersion": "1.1",
+		"protocol": "json",
+		"serviceAbbreviation": "Amazon SQS",
+		"serviceFullName": "Private Queue Service",
+		"serviceId": "SQS",
+		"signatureVersion": "v4",
+		"targetPrefix": "PrivateQueue"
+	},
+	"operations": {
+		"SendMessage": {
+			"name": "SendMessage",
+			"http": {"method": "POST", "requestUri": "/"},
+			"input": {"shape": "SendMessageRequest"},
+			"output": {"shape": "SendMessageResult"}
+		}
+	},
+	"shapes": {
+		"SendMessageRequest": {
+			"type": "structure",
+			"required": ["Body"],
+			"members": {
+				"Body": {"shape": "String"}
+			}
+		},
+		"SendMessageResult": {
+			"type": "structure",
+			"members": {
+				"MessageId": {"shape": "String"}
+			}
+		},
+		"String": {"type": "string"}
+	}
+}`
+
+func TestGenerateClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "codegen")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	modelDir := filepath.Join(dir, "models", "privatequeue", "2020-01-01")
+	if err := os.MkdirAll(modelDir, 0775); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	modelPath := filepath.Join(modelDir, "api-2.json")
+	if err := ioutil.WriteFile(modelPath, []byte(testModel), 0664); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	outDir := filepath.Join(dir, "client")
+	a, err := GenerateClient(Options{
+		ModelPath:  modelPath,
+		OutputDir:  outDir,
+		ImportPath: "example.com/internal/queue",
+		Writer:     Writer{Generator: "example.com/generator"},
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "sqs", a.PackageName(); e != a {
+		t.Errorf("expect %v package name, got %v", e, a)
+	}
+
+	for _, f := range []string{"api.go", "doc.go", "errors.go", "service.go",
+		filepath.Join("sqsiface", "interface.go"), filepath.Join("sqsfake", "fake.go"),
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(outDir, f))
+		if err != nil {
+			t.Errorf("expect %v to be generated, %v", f, err)
+			continue
+		}
+		if e, a := "// Code generated by example.com/generator. DO NOT EDIT.", string(b); !strings.HasPrefix(a, e) {
+			t.Errorf("expect %v header %q, got %q", f, e, strings.SplitN(a, "\n", 2)[0])
+		}
+	}
+
+	// The SDK's customizations for its SQS client are not applied.
+	if _, err := os.Stat(filepath.Join(outDir, "api_marshalers.go")); !os.IsNotExist(err) {
+		t.Errorf("expect no field marshalers to be generated, got %v", err)
+	}
+
+	b, err := ioutil.ReadFile(filepath.Join(outDir, "sqsiface", "interface.go"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := `"example.com/internal/queue"`, string(b); !strings.Contains(a, e) {
+		t.Errorf("expect interface to import %v", e)
+	}
+
+	b, err = ioutil.ReadFile(filepath.Join(outDir, "doc.go"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "sdk-for-go/api/service/", string(b); strings.Contains(a, e) {
+		t.Errorf("expect package doc not to link to SDK service package, got %v", a)
+	}
+}
+
+func TestGenerateClient_MissingOptions(t *testing.T) {
+	_, err := GenerateClient(Options{ModelPath: "api-2.json"})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+}
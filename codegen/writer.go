@@ -0,0 +1,230 @@
+// +build codegen
+
+package codegen
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/private/model/api"
+	"github.com/aws/aws-sdk-go/private/util"
+)
+
+// DefaultGenerator is the name of the generator included in the header of
+// the generated files, if the Writer's Generator is not set.
+const DefaultGenerator = "github.com/aws/aws-sdk-go/codegen"
+
+// A Writer writes the Go files of the client packages generated from API
+// models.
+type Writer struct {
+	// The name of the generator included in the "Code generated by" header
+	// of the generated files. Defaults to DefaultGenerator.
+	Generator string
+}
+
+// WriteClient writes the files of the API's client package to the directory.
+// The client's interface and fake packages are written to subdirectories of
+// the directory. The API must be loaded, e.g. by an api.Loader.
+func (w Writer) WriteClient(a *api.API, dir string) (err error) {
+	// Generating the API's code panics if the API cannot be generated.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to generate %s client, %v", a.PackageName(), r)
+		}
+	}()
+
+	for _, d := range []string{a.InterfacePackageName(), a.FakePackageName()} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0775); err != nil {
+			return err
+		}
+	}
+
+	writers := []func(*api.API, string) error{
+		w.writeServiceDocFile,
+		w.writeAPIFile,
+		w.writeServiceFile,
+		w.writeInterfaceFile,
+		w.writeFakeFile,
+		w.writeWaitersFile,
+		w.writePaginatorsFile,
+		w.writeAPIErrorsFile,
+		w.writeAPIMarshalersFile,
+		w.writeExamplesFile,
+		w.writeAPIEventStreamTestFile,
+		w.writeAPISmokeTestsFile,
+	}
+	for _, fn := range writers {
+		if err := fn(a, dir); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const codeLayout = `// Code generated by %s. DO NOT EDIT.
+
+%s
+package %s
+
+%s
+`
+
+// WriteGoFile writes the Go code of the package to the file, with the
+// generated code header and package documentation.
+func (w Writer) WriteGoFile(file, doc, pkgName, code string) error {
+	generator := w.Generator
+	if len(generator) == 0 {
+		generator = DefaultGenerator
+	}
+
+	src := util.GoFmt(fmt.Sprintf(codeLayout, generator, doc, pkgName, code))
+	return ioutil.WriteFile(file, []byte(src), 0664)
+}
+
+// writeServiceDocFile generates the documentation for service package.
+func (w Writer) writeServiceDocFile(a *api.API, dir string) error {
+	return w.WriteGoFile(filepath.Join(dir, "doc.go"),
+		strings.TrimSpace(a.ServicePackageDoc()),
+		a.PackageName(),
+		"",
+	)
+}
+
+// writeExamplesFile writes out the service example file.
+func (w Writer) writeExamplesFile(a *api.API, dir string) error {
+	code := a.ExamplesGoCode()
+	if len(code) == 0 {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "examples_test.go"),
+		"",
+		a.PackageName()+"_test",
+		code,
+	)
+}
+
+// writeServiceFile writes out the service initialization file.
+func (w Writer) writeServiceFile(a *api.API, dir string) error {
+	return w.WriteGoFile(filepath.Join(dir, "service.go"),
+		"",
+		a.PackageName(),
+		a.ServiceGoCode(),
+	)
+}
+
+// writeInterfaceFile writes out the service interface file.
+func (w Writer) writeInterfaceFile(a *api.API, dir string) error {
+	const pkgDoc = `
+// Package %s provides an interface to enable mocking the %s service client
+// for testing your code.
+//
+// It is important to note that this interface will have breaking changes
+// when the service model is updated and adds new API operations, paginators,
+// and waiters.`
+	return w.WriteGoFile(filepath.Join(dir, a.InterfacePackageName(), "interface.go"),
+		fmt.Sprintf(pkgDoc, a.InterfacePackageName(), a.Metadata.ServiceFullName),
+		a.InterfacePackageName(),
+		a.InterfaceGoCode(),
+	)
+}
+
+// writeFakeFile writes out the service fake client file.
+func (w Writer) writeFakeFile(a *api.API, dir string) error {
+	const pkgDoc = `
+// Package %s provides an in-memory fake of the %s service client
+// for testing your code.
+//
+// It is important to note that the fake client will have breaking changes
+// when the service model is updated and adds new API operations, paginators,
+// and waiters.`
+	return w.WriteGoFile(filepath.Join(dir, a.FakePackageName(), "fake.go"),
+		fmt.Sprintf(pkgDoc, a.FakePackageName(), a.Metadata.ServiceFullName),
+		a.FakePackageName(),
+		a.FakeGoCode(),
+	)
+}
+
+func (w Writer) writeWaitersFile(a *api.API, dir string) error {
+	if len(a.Waiters) == 0 {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "waiters.go"),
+		"",
+		a.PackageName(),
+		a.WaitersGoCode(),
+	)
+}
+
+// writePaginatorsFile writes out the service typed paginators file.
+func (w Writer) writePaginatorsFile(a *api.API, dir string) error {
+	if !a.HasPaginators() {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "paginators.go"),
+		"",
+		a.PackageName(),
+		a.PaginatorsGoCode(),
+	)
+}
+
+// writeAPIFile writes out the service API file.
+func (w Writer) writeAPIFile(a *api.API, dir string) error {
+	return w.WriteGoFile(filepath.Join(dir, "api.go"),
+		"",
+		a.PackageName(),
+		a.APIGoCode(),
+	)
+}
+
+// writeAPIErrorsFile writes out the service API errors file.
+func (w Writer) writeAPIErrorsFile(a *api.API, dir string) error {
+	return w.WriteGoFile(filepath.Join(dir, "errors.go"),
+		"",
+		a.PackageName(),
+		a.APIErrorsGoCode(),
+	)
+}
+
+// writeAPIMarshalersFile writes out the service API field marshalers file.
+func (w Writer) writeAPIMarshalersFile(a *api.API, dir string) error {
+	if !a.HasFieldMarshalers() {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "api_marshalers.go"),
+		"",
+		a.PackageName(),
+		a.APIMarshalersGoCode(),
+	)
+}
+
+func (w Writer) writeAPIEventStreamTestFile(a *api.API, dir string) error {
+	if !a.HasEventStream {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "eventstream_test.go"),
+		"// +build go1.10\n",
+		a.PackageName(),
+		a.APIEventStreamTestGoCode(),
+	)
+}
+
+func (w Writer) writeAPISmokeTestsFile(a *api.API, dir string) error {
+	if len(a.SmokeTests.TestCases) == 0 {
+		return nil
+	}
+
+	return w.WriteGoFile(filepath.Join(dir, "integ_test.go"),
+		"// +build go1.10,integration\n",
+		a.PackageName()+"_test",
+		a.APISmokeTestsGoCode(),
+	)
+}
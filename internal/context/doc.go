@@ -0,0 +1,3 @@
+// Package context provides a Go 1.6 and before compatible background Context
+// shared by the SDK packages.
+package context
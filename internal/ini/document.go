@@ -0,0 +1,482 @@
+package ini
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// ErrCodeUnableToWriteFile is used when a file is failed to be
+// written to.
+const ErrCodeUnableToWriteFile = "FailedWrite"
+
+// ErrCodeInvalidDocumentValue is used when a section name, key, or value
+// cannot be written to a Document.
+const ErrCodeInvalidDocumentValue = "InvalidDocumentValue"
+
+type documentLineKind int
+
+const (
+	documentLineOther documentLineKind = iota
+	documentLineSection
+	documentLineKey
+	documentLineContinuation
+)
+
+// documentLine is a single line of a Document. The raw text of the line,
+// without its line ending, is retained so that lines not modified are
+// written back exactly as read.
+type documentLine struct {
+	raw  string
+	kind documentLineKind
+
+	// section is the name of the section the line belongs to. For section
+	// lines this is the name of the section the line starts.
+	section string
+	// key is the name of the key for key and continuation lines.
+	key string
+}
+
+// Document is an editable representation of an ini file that preserves the
+// comments, whitespace, and order of the file's sections and keys. Unlike
+// Sections, a Document can be modified and written back to a file. Only the
+// lines of the keys and sections modified are changed when the Document is
+// written.
+//
+//	Example:
+//	doc, err := ini.OpenDocument("/path/to/file")
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	if err := doc.SetValue("profile foo", "region", "us-west-2"); err != nil {
+//		panic(err)
+//	}
+//	if err := doc.WriteFile("/path/to/file", 0600); err != nil {
+//		panic(err)
+//	}
+type Document struct {
+	lines   []documentLine
+	newline string
+}
+
+// OpenDocument reads and parses the file at the path into a Document. If the
+// file does not exist an empty Document is returned, so that new files can be
+// created.
+func OpenDocument(path string) (*Document, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ParseDocument(nil)
+		}
+		return nil, awserr.New(ErrCodeUnableToReadFile, "unable to open file", err)
+	}
+
+	return ParseDocument(b)
+}
+
+// ParseDocument parses the bytes of an ini file into a Document. The bytes
+// are validated with the same parser as Parse, and an error is returned if
+// they are not valid.
+func ParseDocument(b []byte) (*Document, error) {
+	if _, err := ParseBytes(b); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{newline: "\n"}
+	if bytes.Contains(b, []byte("\r\n")) {
+		doc.newline = "\r\n"
+	}
+
+	text := strings.Replace(string(b), "\r\n", "\n", -1)
+	text = strings.TrimSuffix(text, "\n")
+	if len(text) == 0 {
+		return doc, nil
+	}
+
+	var section, key string
+	for _, raw := range strings.Split(text, "\n") {
+		line := documentLine{raw: raw, section: section}
+		trimmed := strings.TrimSpace(raw)
+
+		switch {
+		case len(trimmed) == 0, isCommentLine(trimmed):
+			key = ""
+		case strings.HasPrefix(trimmed, "["):
+			section = parseSectionName(trimmed)
+			key = ""
+			line.kind = documentLineSection
+			line.section = section
+		case len(key) != 0 && raw != trimmed:
+			// Indented lines following a key are part of that key's value,
+			// such as nested values.
+			line.kind = documentLineContinuation
+			line.key = key
+		default:
+			if i := strings.IndexAny(trimmed, "=:"); i > 0 {
+				key = strings.TrimSpace(trimmed[:i])
+				line.kind = documentLineKey
+				line.key = key
+			}
+		}
+
+		doc.lines = append(doc.lines, line)
+	}
+
+	return doc, nil
+}
+
+func isCommentLine(trimmed string) bool {
+	return strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";")
+}
+
+func parseSectionName(trimmed string) string {
+	name := strings.TrimPrefix(trimmed, "[")
+	if i := strings.Index(name, "]"); i >= 0 {
+		name = name[:i]
+	}
+	return strings.TrimSpace(name)
+}
+
+// Sections returns the names of the sections of the document in the order
+// they are defined.
+func (d *Document) Sections() []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, line := range d.lines {
+		if line.kind == documentLineSection && !seen[line.section] {
+			seen[line.section] = true
+			names = append(names, line.section)
+		}
+	}
+	return names
+}
+
+// HasSection returns if the section is defined in the document.
+func (d *Document) HasSection(section string) bool {
+	for _, line := range d.lines {
+		if line.kind == documentLineSection && line.section == section {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys returns the names of the keys of the section in the order they are
+// defined.
+func (d *Document) Keys(section string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, line := range d.lines {
+		if line.kind == documentLineKey && line.section == section && !seen[line.key] {
+			seen[line.key] = true
+			keys = append(keys, line.key)
+		}
+	}
+	return keys
+}
+
+// Value returns the value of the key in the section. Quotes surrounding the
+// value and trailing comments are removed. If the key is defined multiple
+// times, the last value is returned.
+func (d *Document) Value(section, key string) (string, bool) {
+	i := d.lastKeyLine(section, key)
+	if i < 0 {
+		return "", false
+	}
+
+	return parseLineValue(d.lines[i].raw), true
+}
+
+func parseLineValue(raw string) string {
+	v := raw[strings.IndexAny(raw, "=:")+1:]
+	v = strings.TrimSpace(v)
+
+	if len(v) >= 2 && v[0] == '"' {
+		if i := strings.LastIndex(v, `"`); i > 0 {
+			return v[1:i]
+		}
+	}
+
+	for i := 1; i < len(v); i++ {
+		if (v[i] == '#' || v[i] == ';') && (v[i-1] == ' ' || v[i-1] == '\t') {
+			return strings.TrimSpace(v[:i])
+		}
+	}
+
+	return v
+}
+
+// SetValue sets the value of the key in the section. If the key is already
+// defined, the line of its last definition is replaced, otherwise the key is
+// added after the last key of the section. The section is added to the end of
+// the document if it is not defined.
+//
+// An error is returned if the key is not a valid key name, or the value would
+// not be read back as set, such as values with line breaks, brackets, or
+// comments. The document is not modified if an error is returned.
+func (d *Document) SetValue(section, key, value string) error {
+	if err := validateSectionName(section); err != nil {
+		return err
+	}
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	if err := ValidateValue(key, value); err != nil {
+		return err
+	}
+
+	line := documentLine{
+		raw:     key + " = " + value,
+		kind:    documentLineKey,
+		section: section,
+		key:     key,
+	}
+
+	if i := d.lastKeyLine(section, key); i >= 0 {
+		end := d.keyEnd(i)
+		d.replaceLines(i, end, line)
+		return nil
+	}
+
+	if err := d.AddSection(section); err != nil {
+		return err
+	}
+
+	d.insertLine(d.sectionInsertPos(section), line)
+	return nil
+}
+
+// DeleteValue removes all definitions of the key from the section. Returns
+// false if the key was not defined.
+func (d *Document) DeleteValue(section, key string) bool {
+	var found bool
+	for i := len(d.lines) - 1; i >= 0; i-- {
+		line := d.lines[i]
+		if line.kind == documentLineKey && line.section == section && line.key == key {
+			d.replaceLines(i, d.keyEnd(i))
+			found = true
+		}
+	}
+	return found
+}
+
+// AddSection adds an empty section to the end of the document. A blank line
+// separates the section from the document's existing content. AddSection
+// does nothing if the section is already defined. An error is returned if the
+// section name is not valid, such as names with line breaks or brackets.
+func (d *Document) AddSection(section string) error {
+	if err := validateSectionName(section); err != nil {
+		return err
+	}
+	if d.HasSection(section) {
+		return nil
+	}
+
+	if n := len(d.lines); n != 0 && len(strings.TrimSpace(d.lines[n-1].raw)) != 0 {
+		d.lines = append(d.lines, documentLine{section: d.lines[n-1].section})
+	}
+	d.lines = append(d.lines, documentLine{
+		raw:     "[" + section + "]",
+		kind:    documentLineSection,
+		section: section,
+	})
+	return nil
+}
+
+// DeleteSection removes the section, its keys, and the comments within the
+// section from the document. Comment lines directly above a section header
+// belong to that section, so the comments above the deleted section's header
+// are removed, and the comments above the header of the section following it
+// are kept. Returns false if the section was not defined.
+func (d *Document) DeleteSection(section string) bool {
+	var found bool
+	for {
+		start := -1
+		for i, line := range d.lines {
+			if line.kind == documentLineSection && line.section == section {
+				start = i
+				break
+			}
+		}
+		if start < 0 {
+			break
+		}
+		found = true
+
+		end := start + 1
+		for end < len(d.lines) && d.lines[end].kind != documentLineSection {
+			end++
+		}
+		if end < len(d.lines) {
+			end = d.headerCommentsStart(end)
+		}
+		d.replaceLines(d.headerCommentsStart(start), end)
+	}
+
+	if found {
+		// Remove trailing blank lines left by the removed section.
+		for n := len(d.lines); n != 0 && len(strings.TrimSpace(d.lines[n-1].raw)) == 0; n-- {
+			d.lines = d.lines[:n-1]
+		}
+	}
+
+	return found
+}
+
+// Bytes returns the serialized document.
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	for _, line := range d.lines {
+		buf.WriteString(line.raw)
+		buf.WriteString(d.newline)
+	}
+	return buf.Bytes()
+}
+
+// WriteFile writes the document to the file at the path atomically. The
+// document is written to a temporary file in the same directory which is
+// renamed to the path once it is written completely, so that readers never
+// observe a partially written file. If the file exists, its permissions are
+// preserved, otherwise perm is used.
+func (d *Document) WriteFile(path string, perm os.FileMode) error {
+	if fi, err := os.Stat(path); err == nil {
+		perm = fi.Mode().Perm()
+	}
+
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return awserr.New(ErrCodeUnableToWriteFile, "unable to create directory", err)
+	}
+
+	f, err := ioutil.TempFile(dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return awserr.New(ErrCodeUnableToWriteFile, "unable to create temporary file", err)
+	}
+	tmpName := f.Name()
+
+	_, err = f.Write(d.Bytes())
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpName, perm)
+	}
+	if err == nil {
+		err = os.Rename(tmpName, path)
+	}
+	if err != nil {
+		os.Remove(tmpName)
+		return awserr.New(ErrCodeUnableToWriteFile, "unable to write file", err)
+	}
+
+	return nil
+}
+
+// headerCommentsStart returns the index of the first of the comment lines
+// directly above the section header at line i, or i if there are none.
+func (d *Document) headerCommentsStart(i int) int {
+	for i > 0 && isCommentLine(strings.TrimSpace(d.lines[i-1].raw)) {
+		i--
+	}
+	return i
+}
+
+// lastKeyLine returns the index of the last line defining the key in the
+// section, or -1 if the key is not defined.
+func (d *Document) lastKeyLine(section, key string) int {
+	for i := len(d.lines) - 1; i >= 0; i-- {
+		line := d.lines[i]
+		if line.kind == documentLineKey && line.section == section && line.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// keyEnd returns the index after the last continuation line of the key
+// defined at line i.
+func (d *Document) keyEnd(i int) int {
+	end := i + 1
+	for end < len(d.lines) && d.lines[end].kind == documentLineContinuation {
+		end++
+	}
+	return end
+}
+
+// sectionInsertPos returns the index a new key of the section is inserted
+// at, after the last key of the last definition of the section. Comments and
+// blank lines trailing the section are kept after the new key.
+func (d *Document) sectionInsertPos(section string) int {
+	pos := -1
+	for i, line := range d.lines {
+		if line.section != section {
+			continue
+		}
+		switch line.kind {
+		case documentLineSection, documentLineKey, documentLineContinuation:
+			pos = i + 1
+		}
+	}
+	return pos
+}
+
+func (d *Document) insertLine(i int, line documentLine) {
+	d.lines = append(d.lines, documentLine{})
+	copy(d.lines[i+1:], d.lines[i:])
+	d.lines[i] = line
+}
+
+func (d *Document) replaceLines(start, end int, lines ...documentLine) {
+	rest := append([]documentLine{}, d.lines[end:]...)
+	d.lines = append(append(d.lines[:start], lines...), rest...)
+}
+
+func validateSectionName(section string) error {
+	if len(strings.TrimSpace(section)) == 0 || section != strings.TrimSpace(section) ||
+		strings.ContainsAny(section, "[]#;\r\n") {
+		return awserr.New(ErrCodeInvalidDocumentValue,
+			fmt.Sprintf("invalid section name %q", section), nil)
+	}
+	return nil
+}
+
+func validateKey(key string) error {
+	if len(key) == 0 || strings.ContainsAny(key, "=:[]#;\"") ||
+		strings.IndexFunc(key, unicode.IsSpace) >= 0 {
+		return awserr.New(ErrCodeInvalidDocumentValue,
+			fmt.Sprintf("invalid key %q", key), nil)
+	}
+	return nil
+}
+
+// ValidateValue returns an error if the value of the key cannot be written to
+// a Document, such as values with line breaks, or that would not be read back
+// as set by the ini parser.
+func ValidateValue(key, value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return awserr.New(ErrCodeInvalidDocumentValue,
+			fmt.Sprintf("invalid value of key %s, must not contain line breaks", key), nil)
+	}
+
+	sections, err := ParseBytes([]byte("[section]\n" + key + " = " + value + "\n"))
+	if err == nil {
+		if section, ok := sections.GetSection("section"); !ok || section.String(key) != value {
+			err = fmt.Errorf("value is read back as %q", section.String(key))
+		}
+	}
+	if err != nil {
+		return awserr.New(ErrCodeInvalidDocumentValue,
+			fmt.Sprintf("invalid value of key %s, cannot be written to file", key), err)
+	}
+	return nil
+}
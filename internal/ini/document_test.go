@@ -0,0 +1,262 @@
+// +build go1.7
+
+package ini
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const testDocument = `# top level comment
+[default]
+region = us-west-2 ; trailing comment
+# comment about the key
+aws_access_key_id = AKID
+
+; comment before profile
+[profile foo]
+role_arn = "arn:aws:iam::123456789012:role/foo"
+s3 =
+  use_arn_region = true
+  addressing_style = path
+
+[profile bar]
+region=us-east-1
+`
+
+func TestDocument_RoundTrip(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := testDocument, string(doc.Bytes()); e != a {
+		t.Errorf("expect document unchanged\n%v\ngot\n%v", e, a)
+	}
+
+	if e, a := []string{"default", "profile foo", "profile bar"}, doc.Sections(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v sections, got %v", e, a)
+	}
+	if e, a := []string{"role_arn", "s3"}, doc.Keys("profile foo"); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v keys, got %v", e, a)
+	}
+}
+
+func TestDocument_Value(t *testing.T) {
+	doc, err := ParseDocument([]byte(testDocument))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	cases := []struct {
+		Section, Key string
+		Expect       string
+		ExpectOK     bool
+	}{
+		{Section: "default", Key: "region", Expect: "us-west-2", ExpectOK: true},
+		{Section: "profile foo", Key: "role_arn", Expect: "arn:aws:iam::123456789012:role/foo", ExpectOK: true},
+		{Section: "profile bar", Key: "region", Expect: "us-east-1", ExpectOK: true},
+		{Section: "profile bar", Key: "role_arn"},
+		{Section: "not_exists", Key: "region"},
+	}
+
+	for _, c := range cases {
+		v, ok := doc.Value(c.Section, c.Key)
+		if e, a := c.ExpectOK, ok; e != a {
+			t.Errorf("%s %s: expect %v ok, got %v", c.Section, c.Key, e, a)
+		}
+		if e, a := c.Expect, v; e != a {
+			t.Errorf("%s %s: expect %v value, got %v", c.Section, c.Key, e, a)
+		}
+	}
+}
+
+func TestDocument_Edit(t *testing.T) {
+	cases := map[string]struct {
+		Edit   func(*Document) error
+		Expect string
+	}{
+		"replace value": {
+			Edit: func(d *Document) error {
+				return d.SetValue("default", "region", "eu-west-1")
+			},
+			Expect: "# top level comment\n[default]\nregion = eu-west-1\n# comment about the key\naws_access_key_id = AKID\n\n; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\ns3 =\n  use_arn_region = true\n  addressing_style = path\n\n[profile bar]\nregion=us-east-1\n",
+		},
+		"replace nested value": {
+			Edit: func(d *Document) error {
+				return d.SetValue("profile foo", "s3", "")
+			},
+			Expect: "# top level comment\n[default]\nregion = us-west-2 ; trailing comment\n# comment about the key\naws_access_key_id = AKID\n\n; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\ns3 = \n\n[profile bar]\nregion=us-east-1\n",
+		},
+		"add value": {
+			Edit: func(d *Document) error {
+				return d.SetValue("default", "output", "json")
+			},
+			Expect: "# top level comment\n[default]\nregion = us-west-2 ; trailing comment\n# comment about the key\naws_access_key_id = AKID\noutput = json\n\n; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\ns3 =\n  use_arn_region = true\n  addressing_style = path\n\n[profile bar]\nregion=us-east-1\n",
+		},
+		"add section": {
+			Edit: func(d *Document) error {
+				return d.SetValue("profile baz", "region", "ap-south-1")
+			},
+			Expect: testDocument + "\n[profile baz]\nregion = ap-south-1\n",
+		},
+		"delete value": {
+			Edit: func(d *Document) error {
+				if !d.DeleteValue("profile foo", "s3") {
+					t.Errorf("expect value deleted")
+				}
+				if d.DeleteValue("profile foo", "not_exists") {
+					t.Errorf("expect value not deleted")
+				}
+				return nil
+			},
+			Expect: "# top level comment\n[default]\nregion = us-west-2 ; trailing comment\n# comment about the key\naws_access_key_id = AKID\n\n; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\n\n[profile bar]\nregion=us-east-1\n",
+		},
+		"delete section": {
+			Edit: func(d *Document) error {
+				if !d.DeleteSection("profile bar") {
+					t.Errorf("expect section deleted")
+				}
+				if d.DeleteSection("not_exists") {
+					t.Errorf("expect section not deleted")
+				}
+				return nil
+			},
+			Expect: "# top level comment\n[default]\nregion = us-west-2 ; trailing comment\n# comment about the key\naws_access_key_id = AKID\n\n; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\ns3 =\n  use_arn_region = true\n  addressing_style = path\n",
+		},
+		"delete section keeps next section comments": {
+			Edit: func(d *Document) error {
+				if !d.DeleteSection("default") {
+					t.Errorf("expect section deleted")
+				}
+				return nil
+			},
+			Expect: "; comment before profile\n[profile foo]\nrole_arn = \"arn:aws:iam::123456789012:role/foo\"\ns3 =\n  use_arn_region = true\n  addressing_style = path\n\n[profile bar]\nregion=us-east-1\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := ParseDocument([]byte(testDocument))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if err := c.Edit(doc); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if e, a := c.Expect, string(doc.Bytes()); e != a {
+				t.Errorf("expect\n%v\ngot\n%v", e, a)
+			}
+
+			if _, err := ParseBytes(doc.Bytes()); err != nil {
+				t.Errorf("expect edited document to parse, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDocument_SetValueInvalid(t *testing.T) {
+	cases := map[string]struct {
+		Section, Key, Value string
+	}{
+		"empty key":             {Section: "default", Key: "", Value: "v"},
+		"key with space":        {Section: "default", Key: "a b", Value: "v"},
+		"key with separator":    {Section: "default", Key: "a=b", Value: "v"},
+		"key with section":      {Section: "default", Key: "[x]", Value: "v"},
+		"key with comment":      {Section: "default", Key: "#a", Value: "v"},
+		"key with newline":      {Section: "default", Key: "a\nb", Value: "v"},
+		"value with newline":    {Section: "default", Key: "k", Value: "v\nk2 = v2"},
+		"value with section":    {Section: "default", Key: "k", Value: "v\r\n[evil]"},
+		"value with comment":    {Section: "default", Key: "k", Value: "v # c"},
+		"value with bracket":    {Section: "default", Key: "k", Value: "[v"},
+		"value with quotes":     {Section: "default", Key: "k", Value: `"v"`},
+		"value with whitespace": {Section: "default", Key: "k", Value: " v"},
+		"section with bracket":  {Section: "a]b", Key: "k", Value: "v"},
+		"section with newline":  {Section: "a\n[b", Key: "k", Value: "v"},
+		"empty section":         {Section: "", Key: "k", Value: "v"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := ParseDocument([]byte(testDocument))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			err = doc.SetValue(c.Section, c.Key, c.Value)
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := ErrCodeInvalidDocumentValue, err.(awserr.Error).Code(); e != a {
+				t.Errorf("expect %v error code, got %v", e, a)
+			}
+			if e, a := testDocument, string(doc.Bytes()); e != a {
+				t.Errorf("expect document unchanged, got\n%v", a)
+			}
+		})
+	}
+}
+
+func TestDocument_CRLF(t *testing.T) {
+	doc, err := ParseDocument([]byte("[default]\r\nregion = us-west-2\r\n"))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if err := doc.SetValue("default", "output", "json"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "[default]\r\nregion = us-west-2\r\noutput = json\r\n", string(doc.Bytes()); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+}
+
+func TestDocument_WriteFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ini_document")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "sub", "config")
+
+	doc, err := OpenDocument(filename)
+	if err != nil {
+		t.Fatalf("expect no error for file not exists, got %v", err)
+	}
+	if err := doc.SetValue("default", "region", "us-west-2"); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if err := doc.WriteFile(filename, 0600); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "[default]\nregion = us-west-2\n", string(b); e != a {
+		t.Errorf("expect %q, got %q", e, a)
+	}
+
+	files, err := ioutil.ReadDir(filepath.Dir(filename))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 1, len(files); e != a {
+		t.Errorf("expect %v files, got %v, temporary file not removed", e, a)
+	}
+}
+
+func TestParseDocument_Invalid(t *testing.T) {
+	if _, err := ParseDocument([]byte("[default\nregion = us-west-2")); err == nil {
+		t.Errorf("expect error for invalid document")
+	}
+}
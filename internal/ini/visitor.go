@@ -128,6 +128,18 @@ type Section struct {
 	values values
 }
 
+// ValueNames returns the sorted list of the names of the entries in the
+// section.
+func (t Section) ValueNames() []string {
+	names := make([]string, 0, len(t.values))
+	for k := range t.values {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
 // Has will return whether or not an entry exists in a given section
 func (t Section) Has(k string) bool {
 	_, ok := t.values[k]
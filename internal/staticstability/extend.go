@@ -0,0 +1,33 @@
+// Package staticstability provides the static stability shared by credential
+// providers, which continue to return the last retrieved credentials when
+// refreshing them fails.
+package staticstability
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/internal/sdkrand"
+)
+
+// Extension is the minimum duration the expiration of the last retrieved
+// credentials is extended by when they fail to be refreshed. A random jitter
+// of up to the same duration is added.
+var Extension = 5 * time.Minute
+
+// ExtendExpiration extends the expiration of the last retrieved credentials
+// after the refresh failed with err, and logs a warning with the message to
+// the logger.
+func ExtendExpiration(expiry *credentials.Expiry, logger aws.Logger, msg string, err error) {
+	now := time.Now
+	if expiry.CurrentTime != nil {
+		now = expiry.CurrentTime
+	}
+	extension := Extension + time.Duration(sdkrand.SeededRand.Int63n(int64(Extension)))
+	expiry.SetExpiration(now().Add(extension), 0)
+
+	aws.LogWithFields(logger, aws.LogSeverityWarn, msg,
+		aws.LogField{Key: "expiration", Value: expiry.ExpiresAt()},
+		aws.LogField{Key: aws.LogFieldError, Value: err})
+}
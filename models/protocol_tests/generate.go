@@ -4,6 +4,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -218,6 +219,9 @@ func (t tplInputTestCaseData) BodyAssertions() string {
 		} else {
 			code.WriteString(fmtAssertEqual(fmt.Sprintf("%q", expectedBody), "util.Trim(string(body))"))
 		}
+	case "smithy-rpc-v2-cbor":
+		// CBOR bodies are compared by their hex encoding
+		code.WriteString(fmtAssertEqual(fmt.Sprintf("%q", expectedBody), `fmt.Sprintf("%x", body)`))
 	case "json", "jsonrpc", "rest-json":
 		if strings.HasPrefix(expectedBody, "{") {
 			fmt.Fprintf(code, "awstesting.AssertJSON(t, `%s`, util.Trim(string(body)))",
@@ -328,9 +332,19 @@ func (i *testCase) TestCase(idx int) string {
 			panic(err)
 		}
 	} else if i.TestSuite.Type == TestSuiteTypeOutput {
+		body := i.OutputTest.Body
+		if i.TestSuite.API.Metadata.Protocol == "smithy-rpc-v2-cbor" {
+			// CBOR bodies are hex encoded in the test suite
+			b, err := hex.DecodeString(body)
+			if err != nil {
+				panic(err)
+			}
+			body = string(b)
+		}
+
 		output := tplOutputTestCaseData{
 			TestCase:   i,
-			Body:       fmt.Sprintf("%q", i.OutputTest.Body),
+			Body:       fmt.Sprintf("%q", body),
 			OpName:     strings.ToUpper(opName[0:1]) + opName[1:],
 			Assertions: GenerateAssertions(i.Data, i.Given.OutputRef.Shape, "out"),
 		}
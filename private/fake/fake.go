@@ -0,0 +1,202 @@
+// Package fake provides the request pipeline and call recorder shared by the
+// in-memory fake service clients of the generated <service>fake packages.
+//
+// A fake client is a service client whose requests are served by a function
+// instead of being sent to the service. The request's input is validated the
+// same as the service client, but no request is built, signed, or sent.
+package fake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/corehandlers"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// ErrCodeNotStubbed is the error code of the error returned by a fake client
+// for operations that have not been stubbed.
+const ErrCodeNotStubbed = "NotStubbed"
+
+// Region is the default region fake clients are configured with.
+const Region = "us-east-1"
+
+// ConfigProvider provides the client configuration fake clients are
+// created with. The configuration is not loaded from the environment or the
+// shared config files, and uses anonymous credentials.
+type ConfigProvider struct{}
+
+// ClientConfig returns the client configuration for the service, with the
+// configurations merged in.
+func (ConfigProvider) ClientConfig(serviceName string, cfgs ...*aws.Config) client.Config {
+	cfg := defaults.Config().
+		WithCredentials(credentials.AnonymousCredentials).
+		WithRegion(Region).
+		WithMaxRetries(0)
+	cfg.MergeIn(cfgs...)
+
+	return client.Config{
+		Config:        cfg,
+		Handlers:      defaults.Handlers(),
+		Endpoint:      fmt.Sprintf("https://%s.fake.amazonaws.com", strings.ToLower(serviceName)),
+		SigningRegion: aws.StringValue(cfg.Region),
+		SigningName:   serviceName,
+	}
+}
+
+// InvokeFunc serves the request made with a fake client, returning the
+// output of the request's operation, or an error.
+type InvokeFunc func(r *request.Request) (interface{}, error)
+
+// Stub replaces the request handlers of the client, so that the client's
+// requests are served by the invoke function. The request's input parameters
+// are validated before invoke is called.
+func Stub(c *client.Client, invoke InvokeFunc) {
+	c.Handlers.Validate.Clear()
+	c.Handlers.Validate.PushBackNamed(corehandlers.ValidateParametersHandler)
+	c.Handlers.Validate.PushBackNamed(request.NamedHandler{
+		Name: "fake.StubRequestHandler",
+		Fn: func(r *request.Request) {
+			stubRequest(r, invoke)
+		},
+	})
+	c.Handlers.Validate.AfterEachFn = request.HandlerListStopOnError
+
+	clearResponseHandlers(&c.Handlers)
+	c.Handlers.Build.Clear()
+	c.Handlers.BuildStream.Clear()
+	c.Handlers.Sign.Clear()
+	c.Handlers.Send.Clear()
+	c.Handlers.Retry.Clear()
+	c.Handlers.AfterRetry.Clear()
+}
+
+// stubRequest replaces the handlers of the request added after the client's
+// handlers, such as handlers specific to the request's operation, with the
+// handler sending the request to invoke.
+func stubRequest(r *request.Request, invoke InvokeFunc) {
+	r.Handlers.Build.Clear()
+	r.Handlers.BuildStream.Clear()
+	r.Handlers.Sign.Clear()
+	r.Handlers.Send.Clear()
+	r.Handlers.Send.PushBackNamed(request.NamedHandler{
+		Name: "fake.SendHandler",
+		Fn: func(r *request.Request) {
+			send(r, invoke)
+		},
+	})
+	r.Handlers.Retry.Clear()
+	r.Handlers.AfterRetry.Clear()
+	clearResponseHandlers(&r.Handlers)
+}
+
+func clearResponseHandlers(h *request.Handlers) {
+	h.UnmarshalStream.Clear()
+	h.UnmarshalMeta.Clear()
+	h.ValidateResponse.Clear()
+	h.Unmarshal.Clear()
+	h.UnmarshalError.Clear()
+}
+
+func send(r *request.Request, invoke InvokeFunc) {
+	r.HTTPResponse = &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+	}
+
+	output, err := invoke(r)
+	if err != nil {
+		if reqErr, ok := err.(awserr.RequestFailure); ok {
+			r.HTTPResponse.StatusCode = reqErr.StatusCode()
+		} else {
+			r.HTTPResponse.StatusCode = http.StatusBadRequest
+		}
+		r.Error = err
+		return
+	}
+
+	// Copy the stubbed output into the request's output, so that the output
+	// returned to the caller, paginators, and waiters have the stubbed value.
+	out := reflect.ValueOf(output)
+	data := reflect.ValueOf(r.Data)
+	if out.Kind() != reflect.Ptr || out.IsNil() || data.Kind() != reflect.Ptr || data.IsNil() {
+		return
+	}
+	if out.Type() != data.Type() {
+		r.Error = awserr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("stubbed output %T is not %T", output, r.Data), nil)
+		return
+	}
+	data.Elem().Set(out.Elem())
+}
+
+// NotStubbedError returns the error for the operation not being stubbed.
+func NotStubbedError(operation string) error {
+	return awserr.New(ErrCodeNotStubbed,
+		fmt.Sprintf("%s operation is not stubbed by the fake client", operation), nil)
+}
+
+// A Call is an operation call made with a fake client.
+type Call struct {
+	// The name of the API operation called.
+	Operation string
+
+	// The input parameters of the call.
+	Input interface{}
+}
+
+// Recorder records the calls made with a fake client. Recorder is safe to use
+// concurrently.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Record adds the call of the operation to the recorder.
+func (r *Recorder) Record(operation string, input interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Operation: operation, Input: input})
+}
+
+// Calls returns the calls recorded, in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call{}, r.calls...)
+}
+
+// Inputs returns the inputs of the calls of the operation recorded, in the
+// order they were made.
+func (r *Recorder) Inputs(operation string) []interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var inputs []interface{}
+	for _, c := range r.calls {
+		if c.Operation == operation {
+			inputs = append(inputs, c.Input)
+		}
+	}
+	return inputs
+}
+
+// Reset removes the calls recorded.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
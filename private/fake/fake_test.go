@@ -0,0 +1,232 @@
+package fake_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbfake"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3fake"
+)
+
+func TestFake_Operation(t *testing.T) {
+	svc := dynamodbfake.New()
+	svc.GetItemFunc = func(ctx aws.Context, input *dynamodb.GetItemInput) (*dynamodb.GetItemOutput, error) {
+		return &dynamodb.GetItemOutput{
+			Item: map[string]*dynamodb.AttributeValue{
+				"id": {S: input.Key["id"].S},
+			},
+		}, nil
+	}
+
+	input := &dynamodb.GetItemInput{
+		TableName: aws.String("table"),
+		Key: map[string]*dynamodb.AttributeValue{
+			"id": {S: aws.String("abc")},
+		},
+	}
+	out, err := svc.GetItem(input)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "abc", aws.StringValue(out.Item["id"].S); e != a {
+		t.Errorf("expect %v item id, got %v", e, a)
+	}
+
+	if e, a := []*dynamodb.GetItemInput{input}, svc.GetItemCalls(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+	if e, a := []dynamodbfake.Call{{Operation: "GetItem", Input: input}}, svc.Calls(); !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+
+	svc.ResetCalls()
+	if calls := svc.Calls(); len(calls) != 0 {
+		t.Errorf("expect no calls, got %v", calls)
+	}
+}
+
+func TestFake_NotStubbed(t *testing.T) {
+	svc := dynamodbfake.New()
+
+	_, err := svc.DescribeTable(&dynamodb.DescribeTableInput{TableName: aws.String("table")})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := dynamodbfake.ErrCodeNotStubbed, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := 1, len(svc.DescribeTableCalls()); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestFake_InvalidParameters(t *testing.T) {
+	svc := dynamodbfake.New()
+	svc.DescribeTableFunc = func(aws.Context, *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+		t.Errorf("expect operation not to be called")
+		return nil, nil
+	}
+
+	_, err := svc.DescribeTable(&dynamodb.DescribeTableInput{})
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := request.InvalidParameterErrCode, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if calls := svc.Calls(); len(calls) != 0 {
+		t.Errorf("expect no calls, got %v", calls)
+	}
+}
+
+func TestFake_Error(t *testing.T) {
+	svc := dynamodbfake.New()
+	svc.DescribeTableFunc = func(aws.Context, *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+		return nil, awserr.NewRequestFailure(
+			awserr.New(dynamodb.ErrCodeResourceNotFoundException, "table not found", nil),
+			400, "request-id")
+	}
+
+	req, _ := svc.DescribeTableRequest(&dynamodb.DescribeTableInput{TableName: aws.String("table")})
+	err := req.Send()
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := dynamodb.ErrCodeResourceNotFoundException, err.(awserr.Error).Code(); e != a {
+		t.Errorf("expect %v error code, got %v", e, a)
+	}
+	if e, a := 400, req.HTTPResponse.StatusCode; e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	if e, a := 0, req.RetryCount; e != a {
+		t.Errorf("expect %v retries, got %v", e, a)
+	}
+}
+
+func TestFake_Paginator(t *testing.T) {
+	pages := [][]*string{
+		{aws.String("table_a"), aws.String("table_b")},
+		{aws.String("table_c")},
+	}
+
+	svc := dynamodbfake.New()
+	svc.ListTablesFunc = func(ctx aws.Context, input *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+		if input.ExclusiveStartTableName == nil {
+			return &dynamodb.ListTablesOutput{
+				TableNames:             pages[0],
+				LastEvaluatedTableName: aws.String("table_b"),
+			}, nil
+		}
+		return &dynamodb.ListTablesOutput{TableNames: pages[1]}, nil
+	}
+
+	var names []string
+	err := svc.ListTablesPages(&dynamodb.ListTablesInput{},
+		func(page *dynamodb.ListTablesOutput, lastPage bool) bool {
+			names = append(names, aws.StringValueSlice(page.TableNames)...)
+			return true
+		})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := []string{"table_a", "table_b", "table_c"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tables, got %v", e, a)
+	}
+
+	calls := svc.ListTablesCalls()
+	if e, a := 2, len(calls); e != a {
+		t.Fatalf("expect %v calls, got %v", e, a)
+	}
+	if e, a := "table_b", aws.StringValue(calls[1].ExclusiveStartTableName); e != a {
+		t.Errorf("expect %v start table, got %v", e, a)
+	}
+}
+
+func TestFake_TypedPaginator(t *testing.T) {
+	svc := dynamodbfake.New()
+	svc.ListTablesFunc = func(ctx aws.Context, input *dynamodb.ListTablesInput) (*dynamodb.ListTablesOutput, error) {
+		if input.ExclusiveStartTableName == nil {
+			return &dynamodb.ListTablesOutput{
+				TableNames:             []*string{aws.String("table_a")},
+				LastEvaluatedTableName: aws.String("table_a"),
+			}, nil
+		}
+		return &dynamodb.ListTablesOutput{TableNames: []*string{aws.String("table_b")}}, nil
+	}
+
+	var client dynamodbiface.DynamoDBAPI = svc
+	p := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+
+	var names []string
+	for p.HasMorePages() {
+		page, err := p.NextPage(aws.BackgroundContext())
+		if err != nil {
+			t.Fatalf("expect no error, got %v", err)
+		}
+		names = append(names, aws.StringValueSlice(page.TableNames)...)
+	}
+
+	if e, a := []string{"table_a", "table_b"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v tables, got %v", e, a)
+	}
+}
+
+func TestFake_Waiter(t *testing.T) {
+	statuses := []string{dynamodb.TableStatusCreating, dynamodb.TableStatusActive}
+
+	svc := dynamodbfake.New()
+	svc.DescribeTableFunc = func(aws.Context, *dynamodb.DescribeTableInput) (*dynamodb.DescribeTableOutput, error) {
+		status := statuses[0]
+		statuses = statuses[1:]
+		return &dynamodb.DescribeTableOutput{
+			Table: &dynamodb.TableDescription{TableStatus: aws.String(status)},
+		}, nil
+	}
+
+	err := svc.WaitUntilTableExists(&dynamodb.DescribeTableInput{TableName: aws.String("table")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := 2, len(svc.DescribeTableCalls()); e != a {
+		t.Errorf("expect %v calls, got %v", e, a)
+	}
+}
+
+func TestFake_WaiterStatusMatcher(t *testing.T) {
+	svc := s3fake.New()
+	svc.HeadBucketFunc = func(aws.Context, *s3.HeadBucketInput) (*s3.HeadBucketOutput, error) {
+		return nil, awserr.NewRequestFailure(awserr.New("NotFound", "not found", nil), 404, "")
+	}
+
+	err := svc.WaitUntilBucketNotExists(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+}
+
+func TestFake_OperationHandlersRemoved(t *testing.T) {
+	svc := s3fake.New()
+	svc.PutObjectFunc = func(ctx aws.Context, input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+		return &s3.PutObjectOutput{ETag: aws.String("etag")}, nil
+	}
+
+	out, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("abc")),
+	})
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "etag", aws.StringValue(out.ETag); e != a {
+		t.Errorf("expect %v etag, got %v", e, a)
+	}
+}
@@ -50,8 +50,22 @@ type API struct {
 	// Set to true to not generate struct field accessors
 	NoGenStructFieldAccessors bool
 
+	// Set to true to generate reflection-free field marshalers for the
+	// input shapes, and field unmarshalers for the output shapes, in
+	// addition to the services that have them by default.
+	FieldMarshalers bool
+
 	BaseImportPath string
 
+	// The Go import path of the client package. Defaults to the package name
+	// joined to the BaseImportPath if not set.
+	ClientImportPath string
+
+	// Set to true for APIs generated outside of the SDK. The SDK's service
+	// specific customizations are not applied, and the package documentation
+	// does not link to the SDK's API reference of the package.
+	NoSDKCustomizations bool
+
 	initialized bool
 	imports     map[string]bool
 	name        string
@@ -100,6 +114,9 @@ func (a *API) PackageName() string {
 
 // ImportPath returns the client's full import path
 func (a *API) ImportPath() string {
+	if len(a.ClientImportPath) != 0 {
+		return a.ClientImportPath
+	}
 	return path.Join(a.BaseImportPath, a.PackageName())
 }
 
@@ -173,6 +190,8 @@ func (a *API) ProtocolPackage() string {
 		return "jsonrpc"
 	case "ec2":
 		return "ec2query"
+	case "smithy-rpc-v2-cbor":
+		return "rpcv2cbor"
 	default:
 		return strings.Replace(a.Metadata.Protocol, "-", "", -1)
 	}
@@ -421,9 +440,11 @@ var tplServiceDoc = template.Must(template.New("service docs").
 //
 // See {{ $crosslinkURL }} for more information on this service.
 {{ end -}}
+{{ if not .NoSDKCustomizations -}}
 //
 // See {{ .PackageName }} package documentation for more information.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/{{ .PackageName }}/
+{{ end -}}
 //
 // Using the Client
 //
@@ -436,10 +457,12 @@ var tplServiceDoc = template.Must(template.New("service docs").
 //
 // See aws.Config documentation for more information on configuring SDK clients.
 // https://docs.aws.amazon.com/sdk-for-go/api/aws/#Config
+{{ if not .NoSDKCustomizations -}}
 //
 // See the {{ .Metadata.ServiceFullName }} client {{ .StructName }} for more
 // information on creating client for this service.
 // https://docs.aws.amazon.com/sdk-for-go/api/service/{{ .PackageName }}/#New
+{{ end -}}
 `))
 
 var serviceIDRegex = regexp.MustCompile("[^a-zA-Z0-9 ]+")
@@ -577,7 +600,7 @@ func newClient(cfg aws.Config, handlers request.Handlers, partitionID, endpoint,
 			{{ if and (.Metadata.JSONVersion) (eq .Metadata.Protocol "json") -}}
 				JSONVersion:  "{{ .Metadata.JSONVersion }}",
 			{{- end }}
-			{{ if and (.Metadata.TargetPrefix) (eq .Metadata.Protocol "json") -}}
+			{{ if and (.Metadata.TargetPrefix) (or (eq .Metadata.Protocol "json") (eq .Metadata.Protocol "smithy-rpc-v2-cbor")) -}}
 				TargetPrefix: "{{ .Metadata.TargetPrefix }}",
 			{{- end }}
     		},
@@ -1,5 +1,5 @@
 // Package service contains automatically generated AWS clients.
 package service
 
-//go:generate go run -tags codegen ../../../cli/gen-api/main.go -path=../service -svc-import-path "github.com/aws/aws-sdk-go/private/model/api/codegentest/service" ../models/*/*/api-2.json
+//go:generate go run -tags codegen ../../../cli/gen-api/main.go -path=../service -svc-import-path "github.com/aws/aws-sdk-go/private/model/api/codegentest/service" ../models/*/*/api-2.json ../models/*/*/model.json
 //go:generate gofmt -s -w ../service
@@ -37,6 +37,9 @@ var serviceAliaseNames = map[string]string{
 }
 
 func (a *API) setServiceAliaseName() {
+	if a.NoSDKCustomizations {
+		return
+	}
 	if newName, ok := serviceAliaseNames[a.PackageName()]; ok {
 		a.name = newName
 	}
@@ -44,6 +47,10 @@ func (a *API) setServiceAliaseName() {
 
 // customizationPasses Executes customization logic for the API by package name.
 func (a *API) customizationPasses() error {
+	if a.NoSDKCustomizations {
+		return nil
+	}
+
 	var svcCustomizations = map[string]func(*API) error{
 		"s3":         s3Customizations,
 		"s3control":  s3ControlCustomizations,
@@ -50,7 +50,7 @@ func parseExampleTimeString(ref *ShapeRef, memName, v string) string {
 	}
 
 	switch ref.API.Metadata.Protocol {
-	case "json", "rest-json", "rest-xml", "ec2", "query":
+	case "json", "rest-json", "rest-xml", "ec2", "query", "smithy-rpc-v2-cbor":
 		return fmt.Sprintf("%s: parseTime(%q, %q),\n", memName, protocol.ISO8601TimeFormat, v)
 	default:
 		panic("Unsupported time type: " + ref.API.Metadata.Protocol)
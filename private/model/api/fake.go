@@ -0,0 +1,154 @@
+// +build codegen
+
+package api
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+)
+
+// FakePackageName returns the package name for the service's fake client.
+func (a *API) FakePackageName() string {
+	return a.PackageName() + "fake"
+}
+
+// A tplFake defines the template for the service's fake client type.
+var tplFake = template.Must(template.New("fake").Parse(`
+{{ $opts := .OperationList }}{{ $opt := index $opts 0 -}}
+// {{ .StructName }} provides an in-memory fake of the {{ .PackageName }}.{{ .StructName }} service client for
+// unit testing code using the client, without needing an HTTP server. The
+// fake client satisfies the {{ .InterfacePackageName }}.{{ .StructName }}API interface.
+//
+// Each API operation is stubbed by setting the operation's func field. The
+// output returned by the func is returned by the operation's methods, and
+// used by the paginators and waiters of the operation. Operations without a
+// func return a {{ .FakePackageName }}.ErrCodeNotStubbed error.
+//
+// The input of each call is validated the same as the service client, and
+// recorded. The calls of an operation are returned by the operation's Calls
+// method.
+//
+//    svc := {{ .FakePackageName }}.New()
+//    svc.{{ $opt.ExportedName }}Func = func(ctx aws.Context, input {{ $opt.InputRef.GoTypeWithPkgName }}) ({{ $opt.OutputRef.GoTypeWithPkgName }}, error) {
+//        // stub the response
+//        return &{{ $opt.OutputRef.Shape.GoTypeWithPkgNameElem }}{}, nil
+//    }
+//
+//    myFunc(svc)
+//
+//    if calls := svc.{{ $opt.ExportedName }}Calls(); len(calls) != 1 {
+//        t.Errorf("expect 1 {{ $opt.ExportedName }} call, got %d", len(calls))
+//    }
+type {{ .StructName }} struct {
+	*{{ .PackageName }}.{{ .StructName }}
+
+	calls fake.Recorder
+	{{ range $_, $o := .OperationList }}
+	// {{ $o.ExportedName }}Func stubs the {{ $o.ExportedName }} API operation.
+	{{ $o.ExportedName }}Func func(aws.Context, {{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error)
+	{{ end }}
+}
+
+var _ {{ .InterfacePackageName }}.{{ .StructName }}API = (*{{ .StructName }})(nil)
+
+// ErrCodeNotStubbed is the error code of the error returned by operations
+// that have not been stubbed.
+const ErrCodeNotStubbed = fake.ErrCodeNotStubbed
+
+// New returns a fake {{ .PackageName }}.{{ .StructName }} client with no operations stubbed. The
+// configurations are applied to the fake client, the same as the service
+// client.
+func New(cfgs ...*aws.Config) *{{ .StructName }} {
+	f := &{{ .StructName }}{
+		{{ .StructName }}: {{ .PackageName }}.New(fake.ConfigProvider{}, cfgs...),
+	}
+	fake.Stub(f.{{ .StructName }}.Client, f.invoke)
+
+	return f
+}
+
+// A Call is an API operation call made with the fake client.
+type Call struct {
+	// The name of the API operation called.
+	Operation string
+
+	// The input parameters of the call.
+	Input interface{}
+}
+
+// Calls returns the calls made with the fake client, in the order they were
+// made.
+func (f *{{ .StructName }}) Calls() []Call {
+	var calls []Call
+	for _, c := range f.calls.Calls() {
+		calls = append(calls, Call{Operation: c.Operation, Input: c.Input})
+	}
+	return calls
+}
+
+// ResetCalls removes the calls recorded by the fake client.
+func (f *{{ .StructName }}) ResetCalls() {
+	f.calls.Reset()
+}
+{{ range $_, $o := .OperationList }}
+// {{ $o.ExportedName }}Calls returns the inputs of the calls made to the
+// {{ $o.ExportedName }} API operation, in the order they were made.
+func (f *{{ $.StructName }}) {{ $o.ExportedName }}Calls() []{{ $o.InputRef.GoTypeWithPkgName }} {
+	var inputs []{{ $o.InputRef.GoTypeWithPkgName }}
+	for _, input := range f.calls.Inputs("{{ $o.Name }}") {
+		inputs = append(inputs, input.({{ $o.InputRef.GoTypeWithPkgName }}))
+	}
+	return inputs
+}
+{{ end }}
+{{ range $_, $w := .Waiters }}
+// WaitUntil{{ $w.Name }} calls {{ $.PackageName }}.{{ $.StructName }}.WaitUntil{{ $w.Name }} with no delay
+// between attempts.
+func (f *{{ $.StructName }}) WaitUntil{{ $w.Name }}(input {{ $w.Operation.InputRef.GoTypeWithPkgName }}) error {
+	return f.WaitUntil{{ $w.Name }}WithContext(aws.BackgroundContext(), input)
+}
+
+// WaitUntil{{ $w.Name }}WithContext calls {{ $.PackageName }}.{{ $.StructName }}.WaitUntil{{ $w.Name }}WithContext
+// with no delay between attempts, unless a delay is set by the options.
+func (f *{{ $.StructName }}) WaitUntil{{ $w.Name }}WithContext(ctx aws.Context, input {{ $w.Operation.InputRef.GoTypeWithPkgName }}, opts ...request.WaiterOption) error {
+	opts = append([]request.WaiterOption{request.WithWaiterDelay(request.ConstantWaiterDelay(0))}, opts...)
+	return f.{{ $.StructName }}.WaitUntil{{ $w.Name }}WithContext(ctx, input, opts...)
+}
+{{ end }}
+func (f *{{ .StructName }}) invoke(r *request.Request) (interface{}, error) {
+	ctx := r.Context()
+
+	switch r.Operation.Name {
+	{{- range $_, $o := .OperationList }}
+	case "{{ $o.Name }}":
+		input := r.Params.({{ $o.InputRef.GoTypeWithPkgName }})
+		f.calls.Record(r.Operation.Name, input)
+		if fn := f.{{ $o.ExportedName }}Func; fn != nil {
+			return fn(ctx, input)
+		}
+	{{- end }}
+	}
+
+	return nil, fake.NotStubbedError(r.Operation.Name)
+}
+`))
+
+// FakeGoCode returns the Go code for the service's fake client. Assumes that
+// the fake client is being created in a different package than the service
+// API's package.
+func (a *API) FakeGoCode() string {
+	a.resetImports()
+	a.AddSDKImport("aws")
+	a.AddSDKImport("aws/request")
+	a.AddSDKImport("private/fake")
+	a.AddImport(a.ImportPath())
+	a.AddImport(a.ImportPath() + "/" + a.InterfacePackageName())
+
+	var buf bytes.Buffer
+	if err := tplFake.Execute(&buf, a); err != nil {
+		panic(err)
+	}
+
+	return a.importsGoCode() + strings.TrimSpace(buf.String())
+}
@@ -0,0 +1,43 @@
+// +build go1.8,codegen
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFakeGoCode(t *testing.T) {
+	a := newFieldMarshalerTestAPI("json")
+	a.Waiters = []Waiter{
+		{
+			Name:          "FooReady",
+			OperationName: "FooOp",
+			Operation:     a.Operations["FooOp"],
+			Delay:         15,
+			MaxAttempts:   10,
+		},
+	}
+
+	code := a.FakeGoCode()
+
+	expect := []string{
+		`"github.com/aws/aws-sdk-go/private/fake"`,
+		`"fooservice/fooserviceiface"`,
+		"*fooservice.FooService",
+		"FooOpFunc func(aws.Context, *fooservice.FooOpInput) (*fooservice.FooOpOutput, error)",
+		"var _ fooserviceiface.FooServiceAPI = (*FooService)(nil)",
+		"fooservice.New(fake.ConfigProvider{}, cfgs...)",
+		"fake.Stub(f.FooService.Client, f.invoke)",
+		"func (f *FooService) FooOpCalls() []*fooservice.FooOpInput {",
+		"func (f *FooService) Calls() []Call {",
+		`case "FooOp":`,
+		"func (f *FooService) WaitUntilFooReadyWithContext(ctx aws.Context, input *fooservice.FooOpInput, opts ...request.WaiterOption) error {",
+		"request.WithWaiterDelay(request.ConstantWaiterDelay(0))",
+	}
+	for _, e := range expect {
+		if !strings.Contains(code, e) {
+			t.Errorf("expect %q in generated code\n%s", e, code)
+		}
+	}
+}
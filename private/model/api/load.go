@@ -23,6 +23,10 @@ type Loader struct {
 	// Allows ignoring API models that are unsupported by the SDK without
 	// failing the load of other supported APIs.
 	IgnoreUnsupportedAPIs bool
+
+	// Set to true if the APIs are generated outside of the SDK, so the SDK's
+	// service specific customizations are not applied.
+	NoSDKCustomizations bool
 }
 
 // Load loads the API model files from disk returning the map of API package.
@@ -32,6 +36,7 @@ func (l Loader) Load(modelPaths []string) (APIs, error) {
 	for _, modelPath := range modelPaths {
 		a, err := loadAPI(modelPath, l.BaseImport, func(a *API) {
 			a.IgnoreUnsupportedAPIs = l.IgnoreUnsupportedAPIs
+			a.NoSDKCustomizations = l.NoSDKCustomizations
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to load API, %v, %v", modelPath, err)
@@ -67,14 +72,28 @@ func loadAPI(modelPath, baseImport string, opts ...func(*API)) (*API, error) {
 
 	modelFile := filepath.Base(modelPath)
 	modelDir := filepath.Dir(modelPath)
-	err := attachModelFiles(modelDir,
-		modelLoader{modelFile, a.Attach, true},
-		modelLoader{"docs-2.json", a.AttachDocs, false},
-		modelLoader{"paginators-1.json", a.AttachPaginators, false},
-		modelLoader{"waiters-2.json", a.AttachWaiters, false},
-		modelLoader{"examples-1.json", a.AttachExamples, false},
-		modelLoader{"smoke.json", a.AttachSmokeTests, false},
-	)
+
+	isSmithy, err := isSmithyModel(modelPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if isSmithy {
+		// Smithy models include their documentation, paginators, and
+		// waiters.
+		err = attachModelFiles(modelDir,
+			modelLoader{modelFile, a.AttachSmithy, true},
+		)
+	} else {
+		err = attachModelFiles(modelDir,
+			modelLoader{modelFile, a.Attach, true},
+			modelLoader{"docs-2.json", a.AttachDocs, false},
+			modelLoader{"paginators-1.json", a.AttachPaginators, false},
+			modelLoader{"waiters-2.json", a.AttachWaiters, false},
+			modelLoader{"examples-1.json", a.AttachExamples, false},
+			modelLoader{"smoke.json", a.AttachSmokeTests, false},
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
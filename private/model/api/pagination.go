@@ -3,9 +3,11 @@
 package api
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
+	"text/template"
 )
 
 // Paginator keeps track of pagination configuration for an API operation.
@@ -100,3 +102,137 @@ func enableStopOnSameToken(service string) bool {
 		return false
 	}
 }
+
+// HasPaginators returns if the API has paginated operations.
+func (a *API) HasPaginators() bool {
+	for _, op := range a.Operations {
+		if op.Paginator != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// PaginatorsGoCode generates and returns Go code for the typed paginators of
+// the API's paginated operations.
+func (a *API) PaginatorsGoCode() string {
+	a.resetImports()
+	a.AddSDKImport("aws")
+	a.AddSDKImport("aws/request")
+
+	var buf bytes.Buffer
+	for _, op := range a.OperationList() {
+		if op.Paginator == nil {
+			continue
+		}
+		if err := paginatorTmpl.Execute(&buf, op); err != nil {
+			panic(err)
+		}
+	}
+
+	return a.importsGoCode() + buf.String()
+}
+
+var paginatorTmpl = template.Must(template.New("paginator").Funcs(template.FuncMap{
+	"EnableStopOnSameToken": enableStopOnSameToken,
+	"GetDeprecatedMsg":      getDeprecatedMessage,
+}).Parse(`
+// {{ .ExportedName }}APIClient is the interface of the client used by
+// {{ .ExportedName }}Paginator. The {{ .API.StructName }} client and the
+// {{ .API.InterfacePackageName }}.{{ .API.StructName }}API interface satisfy it.
+{{ if .Deprecated }}//
+// Deprecated: {{ GetDeprecatedMsg .DeprecatedMsg (printf "%s%s" .ExportedName "APIClient") }}
+{{ end -}}
+type {{ .ExportedName }}APIClient interface {
+	{{ .ExportedName }}Request({{ .InputRef.GoType }}) (*request.Request, {{ .OutputRef.GoType }})
+}
+
+// {{ .ExportedName }}Paginator retrieves the pages of the {{ .ExportedName }}
+// API operation one page at a time. Use New{{ .ExportedName }}Paginator to
+// create the paginator.
+//
+// Note: This operation can generate multiple requests to a service.
+//
+//    // Example iterating over the pages of a {{ .ExportedName }} operation.
+//    p := {{ .API.PackageName }}.New{{ .ExportedName }}Paginator(client, params)
+//    for p.HasMorePages() {
+//        page, err := p.NextPage(ctx)
+//        if err != nil {
+//            return err
+//        }
+//        fmt.Println(page)
+//    }
+{{ if .Deprecated }}//
+// Deprecated: {{ GetDeprecatedMsg .DeprecatedMsg (printf "%s%s" .ExportedName "Paginator") }}
+{{ end -}}
+type {{ .ExportedName }}Paginator struct {
+	// The maximum number of pages the paginator will retrieve. Zero for no
+	// limit.
+	PageLimit int
+
+	// Options applied to the request of each page.
+	Options []request.Option
+
+	client {{ .ExportedName }}APIClient
+	input  {{ .InputRef.GoType }}
+	pages  request.PageIterator
+}
+
+// New{{ .ExportedName }}Paginator returns a paginator for the pages of the
+// {{ .ExportedName }} API operation. The request options are applied to the
+// request of each page.
+{{ if .Deprecated }}//
+// Deprecated: {{ GetDeprecatedMsg .DeprecatedMsg (printf "%s%s%s" "New" .ExportedName "Paginator") }}
+{{ end -}}
+func New{{ .ExportedName }}Paginator(` +
+	`client {{ .ExportedName }}APIClient, input {{ .InputRef.GoType }}, opts ...request.Option) *{{ .ExportedName }}Paginator {
+	return &{{ .ExportedName }}Paginator{
+		Options: opts,
+		client:  client,
+		input:   input,
+		{{ if EnableStopOnSameToken .API.PackageName -}}
+		pages:   request.PageIterator{EndPageOnSameToken: true},
+		{{ end -}}
+	}
+}
+
+// HasMorePages returns if the {{ .ExportedName }} API operation has more
+// pages, and the paginator's page limit has not been reached.
+func (p *{{ .ExportedName }}Paginator) HasMorePages() bool {
+	return p.pages.HasMorePages(p.PageLimit)
+}
+
+// NextPage retrieves the next page of the {{ .ExportedName }} API operation.
+// The request options are applied to the page's request, after the options
+// of the paginator.
+//
+// If the page's request fails the error is returned, and NextPage can be
+// called again to retry the page. Returns an error if there are no more
+// pages. If the operation returned the same pagination tokens the page was
+// requested with, the page is returned along with the error.
+//
+// The context must be non-nil and will be used for request cancellation. If
+// the context is nil a panic will occur. In the future the SDK may create
+// sub-contexts for http.Requests. See https://golang.org/pkg/context/
+// for more information on using Contexts.
+func (p *{{ .ExportedName }}Paginator) NextPage(` +
+	`ctx aws.Context, opts ...request.Option) ({{ .OutputRef.GoType }}, error) {
+	page, err := p.pages.Next(p.PageLimit, func() (*request.Request, error) {
+		var inCpy {{ .InputRef.GoType }}
+		if p.input != nil {
+			tmp := *p.input
+			inCpy = &tmp
+		}
+		req, _ := p.client.{{ .ExportedName }}Request(inCpy)
+		req.SetContext(ctx)
+		req.ApplyOptions(p.Options...)
+		req.ApplyOptions(opts...)
+		return req, nil
+	})
+	if page == nil {
+		return nil, err
+	}
+
+	return page.({{ .OutputRef.GoType }}), err
+}
+`))
@@ -13,6 +13,7 @@ func (a *API) enableGeneratedTypedErrors() {
 	switch a.Metadata.Protocol {
 	case "json":
 	case "rest-json":
+	case "smithy-rpc-v2-cbor":
 	default:
 		return
 	}
@@ -0,0 +1,772 @@
+// +build codegen
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// fieldMarshalerServices is the set of services field marshalers are
+// generated for by default. These services' request serialization is CPU
+// bound on the protocol's reflection based marshaler.
+var fieldMarshalerServices = map[string]struct{}{
+	"dynamodb": {},
+	"sqs":      {},
+}
+
+// HasFieldMarshalers returns if reflection-free field marshalers will be
+// generated for the API's shapes. Field marshalers are generated for APIs
+// with FieldMarshalers set, or in the fieldMarshalerServices set, that use
+// the JSON, REST-JSON, Query, EC2 Query, or REST-XML protocols.
+//
+// The input shapes are given marshalers serializing the request body, and
+// the output shapes are given unmarshalers deserializing the response body.
+// Shapes the generated code does not support, such as shapes with a payload
+// member, are serialized by the protocol's reflection based marshaler and
+// unmarshaler.
+func (a *API) HasFieldMarshalers() bool {
+	_, ok := fieldMarshalerServices[a.PackageName()]
+	if (!ok || a.NoSDKCustomizations) && !a.FieldMarshalers {
+		return false
+	}
+
+	switch a.Metadata.Protocol {
+	case "json", "rest-json", "query", "ec2", "rest-xml":
+		return true
+	default:
+		return false
+	}
+}
+
+// APIMarshalersGoCode renders the field marshalers of the API's input shapes,
+// and the field unmarshalers of its output shapes in Go code. Returns an
+// empty string if the API does not have field marshalers.
+func (a *API) APIMarshalersGoCode() string {
+	if !a.HasFieldMarshalers() {
+		return ""
+	}
+
+	a.resetImports()
+
+	var buf bytes.Buffer
+	w := &fieldMarshalerWriter{API: a, buf: &buf, isEC2: a.Metadata.Protocol == "ec2"}
+	for _, s := range a.fieldMarshalerShapes() {
+		switch a.Metadata.Protocol {
+		case "json", "rest-json":
+			a.AddSDKImport("private/protocol/json/jsonutil")
+			w.writeJSONShape(s)
+		case "query", "ec2":
+			a.AddImport("net/url")
+			a.AddSDKImport("private/protocol/query/queryutil")
+			w.writeQueryShape(s)
+		case "rest-xml":
+			a.AddImport("encoding/xml")
+			a.AddSDKImport("private/protocol/xml/xmlutil")
+			w.writeXMLShape(s)
+		}
+	}
+	for _, s := range a.fieldUnmarshalerShapes() {
+		switch a.Metadata.Protocol {
+		case "json", "rest-json":
+			a.AddSDKImport("private/protocol/json/jsonutil")
+			w.writeJSONUnmarshalShape(s)
+		case "query", "ec2", "rest-xml":
+			a.AddSDKImport("private/protocol/xml/xmlutil")
+			w.writeXMLUnmarshalShape(s)
+		}
+	}
+
+	return a.importsGoCode() + strings.TrimSpace(buf.String())
+}
+
+// fieldMarshalerShapes returns the structure shapes serialized in the body
+// of requests that field marshalers are generated for, sorted by name.
+func (a *API) fieldMarshalerShapes() []*Shape {
+	return a.fieldShapes(
+		func(op *Operation) *ShapeRef { return &op.InputRef },
+		func(s *Shape) bool {
+			switch a.Metadata.Protocol {
+			case "query", "ec2":
+				return !hasMemberType(s, "jsonvalue")
+			case "rest-xml":
+				// The XML element of shapes with only non-body members is
+				// omitted.
+				return !hasMemberType(s, "jsonvalue") && !hasOnlyLocationMembers(s)
+			default:
+				return true
+			}
+		},
+	)
+}
+
+// fieldShapes returns the structure shapes reachable from the operations'
+// references that field methods are generated for, sorted by name. Supported
+// reports if the generated code can serialize the shape's members.
+//
+// Shapes with a payload member, exception shapes, event streams, and shapes
+// defined in other packages are serialized with reflection, as are the
+// shapes nesting them.
+func (a *API) fieldShapes(refOf func(*Operation) *ShapeRef, supported func(*Shape) bool) []*Shape {
+	visited := map[*Shape]bool{}
+
+	var visit func(*Shape)
+	visit = func(s *Shape) {
+		if s == nil || visited[s] || s.IsEventStream {
+			return
+		}
+		visited[s] = true
+
+		switch s.Type {
+		case "structure":
+			for _, ref := range s.MemberRefs {
+				visit(ref.Shape)
+			}
+		case "list":
+			visit(s.MemberRef.Shape)
+		case "map":
+			visit(s.ValueRef.Shape)
+		}
+	}
+
+	for _, op := range a.OperationList() {
+		visit(refOf(op).Shape)
+	}
+
+	generated := map[*Shape]bool{}
+	for s := range visited {
+		if s.Type != "structure" {
+			continue
+		}
+		generated[s] = len(s.resolvePkg) == 0 && !s.Exception &&
+			s.OutputEventStreamAPI == nil && len(s.PayloadRefName()) == 0 &&
+			!hasEventStreamMember(s) && supported(s)
+	}
+
+	// Shapes nesting structures without field methods are not generated.
+	for changed := true; changed; {
+		changed = false
+		for s, ok := range generated {
+			if !ok {
+				continue
+			}
+			for _, ref := range s.MemberRefs {
+				if nested := nestedStructure(ref); nested != nil && !generated[nested] {
+					generated[s] = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	var shapes []*Shape
+	for _, s := range a.ShapeList() {
+		if generated[s] {
+			shapes = append(shapes, s)
+		}
+	}
+
+	return shapes
+}
+
+// fieldShapeType returns the type of the reference's shape. References to
+// JSON values are of the jsonvalue type.
+func fieldShapeType(ref *ShapeRef) string {
+	if ref.JSONValue {
+		return "jsonvalue"
+	}
+	return ref.Shape.Type
+}
+
+// nestedStructure returns the structure shape of the reference, or of the
+// elements of the list or map shape it references. Nil is returned if the
+// values are not structures.
+func nestedStructure(ref *ShapeRef) *Shape {
+	for {
+		switch fieldShapeType(ref) {
+		case "structure":
+			return ref.Shape
+		case "list":
+			ref = &ref.Shape.MemberRef
+		case "map":
+			ref = &ref.Shape.ValueRef
+		default:
+			return nil
+		}
+	}
+}
+
+// hasMemberType returns if any of the shape's members, or the elements of
+// its list and map members, are of the shape type.
+func hasMemberType(s *Shape, typ string) bool {
+	for _, ref := range s.MemberRefs {
+		for r := ref; ; {
+			t := fieldShapeType(r)
+			if t == typ {
+				return true
+			}
+			if t == "list" {
+				r = &r.Shape.MemberRef
+			} else if t == "map" {
+				r = &r.Shape.ValueRef
+			} else {
+				break
+			}
+		}
+	}
+	return false
+}
+
+func hasEventStreamMember(s *Shape) bool {
+	for _, ref := range s.MemberRefs {
+		if ref.Shape.IsEventStream {
+			return true
+		}
+	}
+	return false
+}
+
+// hasOnlyLocationMembers returns if the shape has members, all of which are
+// serialized outside of the body.
+func hasOnlyLocationMembers(s *Shape) bool {
+	var body, location int
+	for _, ref := range s.MemberRefs {
+		if ref.Ignore {
+			continue
+		}
+		if isLocationMember(ref) {
+			location++
+		} else {
+			body++
+		}
+	}
+	return body == 0 && location > 0
+}
+
+// isLocationMember returns if the member is serialized outside of the body,
+// such as to a header.
+func isLocationMember(ref *ShapeRef) bool {
+	return ref.Location != "" || ref.Shape.Location != "" || ref.IsEventHeader
+}
+
+// fieldMarshalerWriter writes the Go code of the field marshaler methods.
+type fieldMarshalerWriter struct {
+	*API
+	buf   *bytes.Buffer
+	isEC2 bool
+}
+
+func (w *fieldMarshalerWriter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(w.buf, format, args...)
+	w.buf.WriteByte('\n')
+}
+
+// isIgnoredJSONMember returns if the member is not serialized in the JSON
+// body of the request.
+func isIgnoredJSONMember(ref *ShapeRef) bool {
+	if ref.Ignore || isLocationMember(ref) {
+		return true
+	}
+	for _, tag := range ref.CustomTags {
+		if tag.Key == "json" && tag.Val == "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// memberLocationName returns the name of the member in the JSON or XML body.
+func memberLocationName(name string, ref *ShapeRef) string {
+	if ref.LocationName != "" {
+		return ref.LocationName
+	}
+	if ref.Shape.LocationName != "" {
+		return ref.Shape.LocationName
+	}
+	return name
+}
+
+func (w *fieldMarshalerWriter) writeJSONShape(s *Shape) {
+	w.printf("// MarshalJSONFields serializes the shape as JSON without reflection.")
+	w.printf("func (s *%s) MarshalJSONFields(e *jsonutil.Encoder) error {", s.ShapeName)
+	w.printf("e.ObjectStart()")
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if isIgnoredJSONMember(ref) {
+			continue
+		}
+
+		field := "s." + name
+		key := memberLocationName(name, ref)
+
+		if ref.IdempotencyToken || ref.Shape.IdempotencyToken {
+			w.addProtocolImport()
+			w.printf("if %s != nil {", field)
+			w.printf("e.ObjectKey(%q)", key)
+			w.printf("e.String(*%s)", field)
+			w.printf("} else {")
+			w.printf("e.ObjectKey(%q)", key)
+			w.printf("e.String(protocol.GetIdempotencyToken())")
+			w.printf("}")
+			continue
+		}
+
+		w.printf("if %s != nil {", field)
+		w.printf("e.ObjectKey(%q)", key)
+		w.writeJSONValue(ref, field, ref.GetTimestampFormat(), 1)
+		w.printf("}")
+	}
+
+	w.printf("e.ObjectEnd()")
+	w.printf("return nil")
+	w.printf("}\n")
+}
+
+// writeJSONValue writes the code to encode the non-nil value of the
+// expression. Timestamp format is the format of timestamp values, and is
+// only set for members. Elements of lists and maps use the protocol's default
+// timestamp format.
+func (w *fieldMarshalerWriter) writeJSONValue(ref *ShapeRef, expr, timestampFormat string, depth int) {
+	switch fieldShapeType(ref) {
+	case "structure":
+		w.printf("if err := %s.MarshalJSONFields(e); err != nil {", expr)
+		w.printf("return err")
+		w.printf("}")
+	case "list":
+		elem := fmt.Sprintf("v%d", depth)
+		w.printf("e.ListStart()")
+		w.printf("for _, %s := range %s {", elem, expr)
+		w.printf("e.ListElem()")
+		w.writeJSONElem(&ref.Shape.MemberRef, elem, depth)
+		w.printf("}")
+		w.printf("e.ListEnd()")
+	case "map":
+		w.AddImport("sort")
+		keys, key := fmt.Sprintf("keys%d", depth), fmt.Sprintf("k%d", depth)
+		elem := fmt.Sprintf("v%d", depth)
+		w.printf("%s := make([]string, 0, len(%s))", keys, expr)
+		w.printf("for %s := range %s {", key, expr)
+		w.printf("%s = append(%s, %s)", keys, keys, key)
+		w.printf("}")
+		w.printf("sort.Strings(%s)", keys)
+		w.printf("e.ObjectStart()")
+		w.printf("for _, %s := range %s {", key, keys)
+		w.printf("e.ObjectKey(%s)", key)
+		w.printf("%s := %s[%s]", elem, expr, key)
+		w.writeJSONElem(&ref.Shape.ValueRef, elem, depth)
+		w.printf("}")
+		w.printf("e.ObjectEnd()")
+	case "string", "character":
+		w.printf("e.String(*%s)", expr)
+	case "boolean":
+		w.printf("e.Bool(*%s)", expr)
+	case "byte", "short", "integer", "long":
+		w.printf("e.Int64(*%s)", expr)
+	case "float", "double":
+		w.printf("if err := e.Float64(*%s); err != nil {", expr)
+		w.printf("return err")
+		w.printf("}")
+	case "timestamp":
+		w.printf("e.Time(*%s, %q)", expr, timestampFormat)
+	case "blob":
+		w.printf("e.Blob(%s)", expr)
+	case "jsonvalue":
+		w.printf("if err := e.JSONValue(%s); err != nil {", expr)
+		w.printf("return err")
+		w.printf("}")
+	default:
+		panic(fmt.Sprintf("unsupported shape type %s for field marshaler", fieldShapeType(ref)))
+	}
+}
+
+// writeJSONElem writes the code to encode an element of a list or map. Nil
+// elements are encoded as null.
+func (w *fieldMarshalerWriter) writeJSONElem(ref *ShapeRef, elem string, depth int) {
+	switch fieldShapeType(ref) {
+	case "list", "map":
+		w.writeJSONValue(ref, elem, "", depth+1)
+	default:
+		w.printf("if %s == nil {", elem)
+		w.printf("e.Null()")
+		w.printf("} else {")
+		w.writeJSONValue(ref, elem, "", depth+1)
+		w.printf("}")
+	}
+}
+
+// queryMemberName returns the name of the member's query parameter, relative
+// to its parent.
+func (w *fieldMarshalerWriter) queryMemberName(name string, ref *ShapeRef) string {
+	if w.isEC2 && ref.QueryName != "" {
+		return ref.QueryName
+	}
+
+	var queryName string
+	locName := ref.LocationName
+	if locName == "" {
+		locName = ref.Shape.LocationName
+	}
+	if (ref.Flattened || ref.Shape.Flattened) && ref.Shape.MemberRef.LocationName != "" {
+		queryName = ref.Shape.MemberRef.LocationName
+	} else if locName != "" {
+		queryName = locName
+	}
+	if queryName != "" && w.isEC2 {
+		queryName = strings.ToUpper(queryName[0:1]) + queryName[1:]
+	}
+	if queryName == "" {
+		queryName = name
+	}
+
+	return queryName
+}
+
+func (w *fieldMarshalerWriter) writeQueryShape(s *Shape) {
+	w.printf("// MarshalQueryFields serializes the shape as query parameters without")
+	w.printf("// reflection.")
+	w.printf("func (s *%s) MarshalQueryFields(v url.Values, prefix string) error {", s.ShapeName)
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if ref.Ignore {
+			continue
+		}
+
+		field := "s." + name
+		paramName := fmt.Sprintf("queryutil.JoinName(prefix, %q)", w.queryMemberName(name, ref))
+
+		if ref.IdempotencyToken || ref.Shape.IdempotencyToken {
+			w.addProtocolImport()
+			w.printf("if %s != nil {", field)
+			w.printf("v.Set(%s, *%s)", paramName, field)
+			w.printf("} else {")
+			w.printf("v.Set(%s, protocol.GetIdempotencyToken())", paramName)
+			w.printf("}")
+			continue
+		}
+
+		w.printf("if %s != nil {", field)
+		w.writeQueryValue(ref, field, paramName, ref.GetTimestampFormat(), true, 1)
+		w.printf("}")
+	}
+
+	w.printf("return nil")
+	w.printf("}\n")
+}
+
+// writeQueryValue writes the code to serialize the non-nil value of the
+// expression to the query parameter name. Member is set if the value is a
+// shape's member, whose reference's traits apply to the value. The traits
+// of list and map elements are not used.
+func (w *fieldMarshalerWriter) writeQueryValue(ref *ShapeRef, expr, paramName, timestampFormat string, member bool, depth int) {
+	switch fieldShapeType(ref) {
+	case "structure":
+		w.printf("if err := %s.MarshalQueryFields(v, %s); err != nil {", expr, paramName)
+		w.printf("return err")
+		w.printf("}")
+	case "list":
+		w.writeQueryList(ref, expr, paramName, member, depth)
+	case "map":
+		w.writeQueryMap(ref, expr, paramName, member, depth)
+	case "string", "character":
+		w.printf("v.Set(%s, *%s)", paramName, expr)
+	case "boolean":
+		w.AddImport("strconv")
+		w.printf("v.Set(%s, strconv.FormatBool(*%s))", paramName, expr)
+	case "byte", "short", "integer", "long":
+		w.AddImport("strconv")
+		w.printf("v.Set(%s, strconv.FormatInt(*%s, 10))", paramName, expr)
+	case "float", "double":
+		w.AddImport("strconv")
+		w.printf("v.Set(%s, strconv.FormatFloat(*%s, 'f', -1, 64))", paramName, expr)
+	case "timestamp":
+		w.addProtocolImport()
+		format := timestampFormat
+		if len(format) == 0 {
+			format = "iso8601"
+		}
+		w.printf("v.Set(%s, protocol.FormatTime(%q, *%s))", paramName, format, expr)
+	case "blob":
+		w.AddImport("encoding/base64")
+		w.printf("v.Set(%s, base64.StdEncoding.EncodeToString(%s))", paramName, expr)
+	default:
+		panic(fmt.Sprintf("unsupported shape type %s for field marshaler", fieldShapeType(ref)))
+	}
+}
+
+func (w *fieldMarshalerWriter) writeQueryList(ref *ShapeRef, expr, paramName string, member bool, depth int) {
+	w.AddImport("strconv")
+
+	name := fmt.Sprintf("name%d", depth)
+	w.printf("%s := %s", name, paramName)
+	w.printf("if len(%s) == 0 {", expr)
+	w.printf("v.Set(%s, \"\")", name)
+	w.printf("}")
+
+	if !w.isEC2 {
+		flattened := member && (ref.Flattened || ref.Shape.Flattened)
+		if !flattened {
+			listName := "member"
+			if member && ref.Shape.MemberRef.LocationName != "" {
+				listName = ref.Shape.MemberRef.LocationName
+			}
+			w.printf("%s += %q", name, "."+listName)
+		}
+	}
+
+	index, elem := fmt.Sprintf("i%d", depth), fmt.Sprintf("v%d", depth)
+	w.printf("for %s, %s := range %s {", index, elem, expr)
+	w.writeQueryElem(&ref.Shape.MemberRef, elem,
+		fmt.Sprintf("%s+\".\"+strconv.Itoa(%s+1)", name, index), depth)
+	w.printf("}")
+}
+
+func (w *fieldMarshalerWriter) writeQueryMap(ref *ShapeRef, expr, paramName string, member bool, depth int) {
+	w.AddImport("sort")
+	w.AddImport("strconv")
+
+	name := fmt.Sprintf("name%d", depth)
+	w.printf("%s := %s", name, paramName)
+	w.printf("if len(%s) == 0 {", expr)
+	w.printf("v.Set(%s, \"\")", name)
+	w.printf("}")
+
+	if !w.isEC2 && !(member && (ref.Flattened || ref.Shape.Flattened)) {
+		w.printf("%s += \".entry\"", name)
+	}
+
+	keyName, valueName := "key", "value"
+	if member && ref.Shape.KeyRef.LocationName != "" {
+		keyName = ref.Shape.KeyRef.LocationName
+	}
+	if member && ref.Shape.ValueRef.LocationName != "" {
+		valueName = ref.Shape.ValueRef.LocationName
+	}
+
+	keys, index, key := fmt.Sprintf("keys%d", depth), fmt.Sprintf("i%d", depth), fmt.Sprintf("k%d", depth)
+	entry := fmt.Sprintf("entry%d", depth)
+	w.printf("%s := make([]string, 0, len(%s))", keys, expr)
+	w.printf("for %s := range %s {", key, expr)
+	w.printf("%s = append(%s, %s)", keys, keys, key)
+	w.printf("}")
+	w.printf("sort.Strings(%s)", keys)
+	w.printf("for %s, %s := range %s {", index, key, keys)
+	w.printf("%s := %s+\".\"+strconv.Itoa(%s+1)", entry, name, index)
+	w.printf("v.Set(%s+%q, %s)", entry, "."+keyName, key)
+	w.writeQueryElem(&ref.Shape.ValueRef, fmt.Sprintf("%s[%s]", expr, key),
+		fmt.Sprintf("%s+%q", entry, "."+valueName), depth)
+	w.printf("}")
+}
+
+// writeQueryElem writes the code to serialize an element of a list or map.
+// Nil elements are not serialized.
+func (w *fieldMarshalerWriter) writeQueryElem(ref *ShapeRef, elem, paramName string, depth int) {
+	w.printf("if %s != nil {", elem)
+	w.writeQueryValue(ref, elem, paramName, "", false, depth+1)
+	w.printf("}")
+}
+
+func (w *fieldMarshalerWriter) writeXMLShape(s *Shape) {
+	w.printf("// MarshalXMLFields serializes the shape's members to the shape's XML")
+	w.printf("// element without reflection.")
+	w.printf("func (s *%s) MarshalXMLFields(node *xmlutil.XMLNode) error {", s.ShapeName)
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		if ref.Ignore || isLocationMember(ref) {
+			continue
+		}
+
+		field := "s." + name
+		xmlName := memberLocationName(name, ref)
+
+		if ref.IdempotencyToken || ref.Shape.IdempotencyToken {
+			w.addProtocolImport()
+			w.printf("if %s != nil {", field)
+			w.writeXMLText(ref, "node", xmlName, "*"+field, true)
+			w.printf("} else {")
+			w.writeXMLText(ref, "node", xmlName, "protocol.GetIdempotencyToken()", true)
+			w.printf("}")
+			continue
+		}
+
+		w.writeXMLValue(ref, field, "node", xmlName, true, 1)
+	}
+
+	w.printf("return nil")
+	w.printf("}\n")
+}
+
+// writeXMLValue writes the code to add the value of the expression to the
+// parent XML node, as the named element. Member is set if the value is a
+// shape's member, whose reference's traits apply to the value. The elements
+// of lists and maps are unnamed, and do not use the traits of their
+// references. Nil values are omitted, except for blobs.
+func (w *fieldMarshalerWriter) writeXMLValue(ref *ShapeRef, expr, parent, name string, member bool, depth int) {
+	typ := fieldShapeType(ref)
+	if typ == "blob" {
+		w.writeXMLText(ref, parent, name, w.xmlText(ref, expr, member), member)
+		return
+	}
+
+	w.printf("if %s != nil {", expr)
+	switch typ {
+	case "structure":
+		w.writeXMLStruct(ref, expr, parent, name, member, depth)
+	case "list":
+		w.writeXMLList(ref, expr, parent, name, member, depth)
+	case "map":
+		w.writeXMLMap(ref, expr, parent, name, member, depth)
+	default:
+		w.writeXMLText(ref, parent, name, w.xmlText(ref, expr, member), member)
+	}
+	w.printf("}")
+}
+
+func (w *fieldMarshalerWriter) writeXMLStruct(ref *ShapeRef, expr, parent, name string, member bool, depth int) {
+	ns := ref.Shape.XMLNamespace
+	if member {
+		if ref.XMLNamespace.Prefix != "" {
+			ns.Prefix = ref.XMLNamespace.Prefix
+		}
+		if ref.XMLNamespace.URI != "" {
+			ns.URI = ref.XMLNamespace.URI
+		}
+	} else {
+		name = ref.Shape.LocationName
+	}
+
+	node := fmt.Sprintf("node%d", depth)
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", node, name)
+	if ns.URI != "" {
+		attrName := "xmlns"
+		if ns.Prefix != "" {
+			attrName += ":" + ns.Prefix
+		}
+		w.printf("%s.Attr = append(%s.Attr, xml.Attr{Name: xml.Name{Local: %q}, Value: %q})",
+			node, node, attrName, ns.URI)
+	}
+	w.printf("if err := %s.MarshalXMLFields(%s); err != nil {", expr, node)
+	w.printf("return err")
+	w.printf("}")
+	w.printf("%s.AddChild(%s)", parent, node)
+}
+
+func (w *fieldMarshalerWriter) writeXMLList(ref *ShapeRef, expr, parent, name string, member bool, depth int) {
+	elem, child := fmt.Sprintf("v%d", depth), fmt.Sprintf("child%d", depth)
+
+	if member && (ref.Flattened || ref.Shape.Flattened) {
+		w.printf("for _, %s := range %s {", elem, expr)
+		w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", child, name)
+		w.printf("%s.AddChild(%s)", parent, child)
+		w.writeXMLValue(&ref.Shape.MemberRef, elem, child, "", false, depth+1)
+		w.printf("}")
+		return
+	}
+
+	listName := "member"
+	if member && ref.Shape.MemberRef.LocationName != "" {
+		listName = ref.Shape.MemberRef.LocationName
+	}
+
+	list := fmt.Sprintf("list%d", depth)
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", list, name)
+	w.printf("%s.AddChild(%s)", parent, list)
+	w.printf("for _, %s := range %s {", elem, expr)
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", child, listName)
+	w.printf("%s.AddChild(%s)", list, child)
+	w.writeXMLValue(&ref.Shape.MemberRef, elem, child, "", false, depth+1)
+	w.printf("}")
+}
+
+func (w *fieldMarshalerWriter) writeXMLMap(ref *ShapeRef, expr, parent, name string, member bool, depth int) {
+	w.AddImport("sort")
+
+	keyName, valueName := "key", "value"
+	if member && ref.Shape.KeyRef.LocationName != "" {
+		keyName = ref.Shape.KeyRef.LocationName
+	}
+	if member && ref.Shape.ValueRef.LocationName != "" {
+		valueName = ref.Shape.ValueRef.LocationName
+	}
+
+	mapNode, keys, key := fmt.Sprintf("map%d", depth), fmt.Sprintf("keys%d", depth), fmt.Sprintf("k%d", depth)
+	entry, keyNode, valueNode := fmt.Sprintf("entry%d", depth), fmt.Sprintf("key%d", depth), fmt.Sprintf("value%d", depth)
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", mapNode, name)
+	w.printf("%s.AddChild(%s)", parent, mapNode)
+	w.printf("%s := make([]string, 0, len(%s))", keys, expr)
+	w.printf("for %s := range %s {", key, expr)
+	w.printf("%s = append(%s, %s)", keys, keys, key)
+	w.printf("}")
+	w.printf("sort.Strings(%s)", keys)
+	w.printf("for _, %s := range %s {", key, keys)
+	if member && (ref.Flattened || ref.Shape.Flattened) {
+		w.printf("%s := %s", entry, mapNode)
+	} else {
+		w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: \"entry\"})", entry)
+		w.printf("%s.AddChild(%s)", mapNode, entry)
+	}
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", keyNode, keyName)
+	w.printf("%s.Text = %s", keyNode, key)
+	w.printf("%s := xmlutil.NewXMLElement(xml.Name{Local: %q})", valueNode, valueName)
+	w.printf("%s.AddChild(%s)", entry, keyNode)
+	w.printf("%s.AddChild(%s)", entry, valueNode)
+	w.writeXMLValue(&ref.Shape.ValueRef, fmt.Sprintf("%s[%s]", expr, key), valueNode, "", false, depth+1)
+	w.printf("}")
+}
+
+// writeXMLText writes the code to add the text to the parent XML node, as an
+// attribute if the member is an XML attribute, or as a text element.
+func (w *fieldMarshalerWriter) writeXMLText(ref *ShapeRef, parent, name, text string, member bool) {
+	if member && ref.XMLAttribute {
+		w.printf("%s.Attr = append(%s.Attr, xml.Attr{Name: xml.Name{Local: %q}, Value: %s})",
+			parent, parent, name, text)
+		return
+	}
+	w.printf("%s.AddChild(&xmlutil.XMLNode{Name: xml.Name{Local: %q}, Text: %s})", parent, name, text)
+}
+
+// xmlText returns the code of the XML text of the scalar value of the
+// expression. Timestamps of members are formatted in the member's format,
+// other timestamps in ISO 8601.
+func (w *fieldMarshalerWriter) xmlText(ref *ShapeRef, expr string, member bool) string {
+	switch fieldShapeType(ref) {
+	case "string", "character":
+		return "*" + expr
+	case "boolean":
+		w.AddImport("strconv")
+		return fmt.Sprintf("strconv.FormatBool(*%s)", expr)
+	case "byte", "short", "integer", "long":
+		w.AddImport("strconv")
+		return fmt.Sprintf("strconv.FormatInt(*%s, 10)", expr)
+	case "float", "double":
+		w.AddImport("strconv")
+		return fmt.Sprintf("strconv.FormatFloat(*%s, 'f', -1, 64)", expr)
+	case "timestamp":
+		w.addProtocolImport()
+		return fmt.Sprintf("protocol.FormatTime(%q, *%s)", xmlTimestampFormat(ref, member), expr)
+	case "blob":
+		w.AddImport("encoding/base64")
+		return fmt.Sprintf("base64.StdEncoding.EncodeToString(%s)", expr)
+	default:
+		panic(fmt.Sprintf("unsupported shape type %s for field marshaler", fieldShapeType(ref)))
+	}
+}
+
+// xmlTimestampFormat returns the format of the XML timestamp value. Only
+// members use their reference's format, defaulting to ISO 8601.
+func xmlTimestampFormat(ref *ShapeRef, member bool) string {
+	if member {
+		if format := ref.GetTimestampFormat(); len(format) != 0 {
+			return format
+		}
+	}
+	return "iso8601"
+}
+
+func (w *fieldMarshalerWriter) addProtocolImport() {
+	w.AddSDKImport("private/protocol")
+}
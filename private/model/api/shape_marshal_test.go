@@ -0,0 +1,178 @@
+// +build go1.8,codegen
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func newFieldMarshalerTestAPI(protocol string) *API {
+	a := &API{
+		Metadata: Metadata{
+			APIVersion:       "0000-00-00",
+			EndpointPrefix:   "fooservice",
+			Protocol:         protocol,
+			ServiceFullName:  "Foo Service",
+			ServiceID:        "FooService",
+			SignatureVersion: "v4",
+		},
+		FieldMarshalers: true,
+		Operations: map[string]*Operation{
+			"FooOp": {
+				Name:      "FooOp",
+				InputRef:  ShapeRef{ShapeName: "FooOpRequest"},
+				OutputRef: ShapeRef{ShapeName: "FooOpResponse"},
+			},
+		},
+		Shapes: map[string]*Shape{
+			"FooOpRequest": {
+				ShapeName: "FooOpRequest", Type: "structure",
+				MemberRefs: map[string]*ShapeRef{
+					"Name":   {ShapeName: "String", LocationName: "name"},
+					"Token":  {ShapeName: "String", IdempotencyToken: true},
+					"Header": {ShapeName: "String", Location: "header", LocationName: "x-foo"},
+					"Nested": {ShapeName: "Nested"},
+					"List":   {ShapeName: "NestedList"},
+					"Map":    {ShapeName: "NestedMap"},
+				},
+			},
+			"FooOpResponse": {
+				ShapeName: "FooOpResponse", Type: "structure",
+				MemberRefs: map[string]*ShapeRef{
+					"Count": {ShapeName: "Long", LocationName: "count"},
+					"Items": {ShapeName: "NestedList"},
+				},
+			},
+			"Nested": {
+				ShapeName: "Nested", Type: "structure",
+				MemberRefs: map[string]*ShapeRef{
+					"Time": {ShapeName: "Timestamp", TimestampFormat: "iso8601"},
+				},
+			},
+			"NestedList": {
+				ShapeName: "NestedList", Type: "list",
+				MemberRef: ShapeRef{ShapeName: "Nested", LocationName: "item"},
+			},
+			"NestedMap": {
+				ShapeName: "NestedMap", Type: "map",
+				KeyRef:   ShapeRef{ShapeName: "String"},
+				ValueRef: ShapeRef{ShapeName: "Long"},
+			},
+			"String":    {ShapeName: "String", Type: "string"},
+			"Long":      {ShapeName: "Long", Type: "long"},
+			"Timestamp": {ShapeName: "Timestamp", Type: "timestamp"},
+		},
+	}
+	a.Setup()
+
+	return a
+}
+
+func TestAPIMarshalersGoCode(t *testing.T) {
+	cases := map[string]struct {
+		Protocol string
+		Expect   []string
+		Exclude  []string
+	}{
+		"json": {
+			Protocol: "json",
+			Expect: []string{
+				"func (s *FooOpInput) MarshalJSONFields(e *jsonutil.Encoder) error {",
+				"func (s *Nested) MarshalJSONFields(e *jsonutil.Encoder) error {",
+				`e.ObjectKey("name")`,
+				"e.String(protocol.GetIdempotencyToken())",
+				`e.Time(*s.Time, "iso8601")`,
+				"sort.Strings(keys1)",
+				"if err := v1.MarshalJSONFields(e); err != nil {",
+				"func (s *FooOpOutput) UnmarshalJSONFields(d *jsonutil.Decoder) error {",
+				`case "count":`,
+				"if err := d.Int64(&s.Count); err != nil {",
+			},
+			Exclude: []string{
+				"func (s *FooOpOutput) MarshalJSONFields(",
+				"func (s *FooOpInput) UnmarshalJSONFields(",
+				`e.ObjectKey("x-foo")`,
+			},
+		},
+		"query": {
+			Protocol: "query",
+			Expect: []string{
+				"func (s *FooOpInput) MarshalQueryFields(v url.Values, prefix string) error {",
+				`v.Set(queryutil.JoinName(prefix, "name"), *s.Name)`,
+				`name1 += ".item"`,
+				`name1 += ".entry"`,
+				`v.Set(entry1+".key", k1)`,
+				`v.Set(queryutil.JoinName(prefix, "Time"), protocol.FormatTime("iso8601", *s.Time))`,
+				"func (s *FooOpOutput) UnmarshalXMLFields(node *xmlutil.XMLNode) error {",
+				`for _, n1 := range node.Elems("count") {`,
+			},
+			Exclude: []string{
+				"func (s *FooOpOutput) MarshalQueryFields(",
+			},
+		},
+		"ec2": {
+			Protocol: "ec2",
+			Expect: []string{
+				`v.Set(queryutil.JoinName(prefix, "Name"), *s.Name)`,
+			},
+			Exclude: []string{
+				`name1 += ".item"`,
+				`name1 += ".entry"`,
+			},
+		},
+		"rest-xml": {
+			Protocol: "rest-xml",
+			Expect: []string{
+				"func (s *FooOpInput) MarshalXMLFields(node *xmlutil.XMLNode) error {",
+				"func (s *Nested) MarshalXMLFields(node *xmlutil.XMLNode) error {",
+				`list1 := xmlutil.NewXMLElement(xml.Name{Local: "List"})`,
+				`node.AddChild(&xmlutil.XMLNode{Name: xml.Name{Local: "Token"}, Text: protocol.GetIdempotencyToken()})`,
+				"func (s *FooOpOutput) UnmarshalXMLFields(node *xmlutil.XMLNode) error {",
+			},
+			Exclude: []string{
+				`xml.Name{Local: "x-foo"}`,
+				"func (s *FooOpOutput) MarshalXMLFields(",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			a := newFieldMarshalerTestAPI(c.Protocol)
+
+			code := a.APIMarshalersGoCode()
+			for _, e := range c.Expect {
+				if !strings.Contains(code, e) {
+					t.Errorf("expect %q in generated code\n%s", e, code)
+				}
+			}
+			for _, e := range c.Exclude {
+				if strings.Contains(code, e) {
+					t.Errorf("expect no %q in generated code\n%s", e, code)
+				}
+			}
+		})
+	}
+}
+
+func TestHasFieldMarshalers(t *testing.T) {
+	a := newFieldMarshalerTestAPI("rest-xml")
+	if !a.HasFieldMarshalers() {
+		t.Errorf("expect field marshalers for rest-xml protocol")
+	}
+
+	a = newFieldMarshalerTestAPI("smithy-rpc-v2-cbor")
+	if a.HasFieldMarshalers() {
+		t.Errorf("expect no field marshalers for unsupported protocol")
+	}
+	if code := a.APIMarshalersGoCode(); len(code) != 0 {
+		t.Errorf("expect no generated code, got %s", code)
+	}
+
+	a = newFieldMarshalerTestAPI("json")
+	a.FieldMarshalers = false
+	if a.HasFieldMarshalers() {
+		t.Errorf("expect no field marshalers for services not opted in")
+	}
+}
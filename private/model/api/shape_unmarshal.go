@@ -0,0 +1,339 @@
+// +build codegen
+
+package api
+
+import (
+	"fmt"
+)
+
+// fieldUnmarshalerShapes returns the structure shapes deserialized from the
+// body of responses that field unmarshalers are generated for, sorted by
+// name.
+func (a *API) fieldUnmarshalerShapes() []*Shape {
+	return a.fieldShapes(
+		func(op *Operation) *ShapeRef { return &op.OutputRef },
+		func(s *Shape) bool {
+			switch a.Metadata.Protocol {
+			case "json", "rest-json":
+				// Members sharing a name are decoded from the same value.
+				return !hasDuplicateJSONMembers(s)
+			default:
+				return !hasMemberType(s, "jsonvalue")
+			}
+		},
+	)
+}
+
+// hasDuplicateJSONMembers returns if multiple members of the shape have the
+// same name in the JSON body.
+func hasDuplicateJSONMembers(s *Shape) bool {
+	names := map[string]bool{}
+	for name, ref := range s.MemberRefs {
+		key := memberLocationName(name, ref)
+		if names[key] {
+			return true
+		}
+		names[key] = true
+	}
+	return false
+}
+
+// writeJSONUnmarshalShape writes the UnmarshalJSONFields method of the shape.
+// Like the reflection based unmarshaler, all members are decoded from the
+// object member of their name, including members bound to other locations of
+// the response.
+func (w *fieldMarshalerWriter) writeJSONUnmarshalShape(s *Shape) {
+	w.printf("// UnmarshalJSONFields deserializes the shape from JSON without reflection.")
+	w.printf("func (s *%s) UnmarshalJSONFields(d *jsonutil.Decoder) error {", s.ShapeName)
+	w.printf("for {")
+	w.printf("key, ok, err := d.ObjectKey()")
+	w.printf("if err != nil {")
+	w.printf("return err")
+	w.printf("} else if !ok {")
+	w.printf("return nil")
+	w.printf("}")
+	w.printf("")
+	w.printf("switch key {")
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		w.printf("case %q:", memberLocationName(name, ref))
+		w.writeJSONDecode(ref, "s."+name, ref.GetTimestampFormat(), 1)
+	}
+
+	w.printf("default:")
+	w.printf("if err := d.Skip(); err != nil {")
+	w.printf("return err")
+	w.printf("}")
+	w.printf("}")
+	w.printf("}")
+	w.printf("}\n")
+}
+
+// fieldGoType returns the Go type of the reference's values.
+func (w *fieldMarshalerWriter) fieldGoType(ref *ShapeRef) string {
+	if fieldShapeType(ref) == "jsonvalue" {
+		w.AddSDKImport("aws")
+		return "aws.JSONValue"
+	}
+	return ref.Shape.GoType()
+}
+
+// jsonDecodeCall returns the code of the decoder method call reading the
+// scalar value into the expression. False is returned if the value is not a
+// scalar.
+func jsonDecodeCall(ref *ShapeRef, expr, timestampFormat string) (string, bool) {
+	switch fieldShapeType(ref) {
+	case "string", "character":
+		return fmt.Sprintf("d.String(&%s)", expr), true
+	case "boolean":
+		return fmt.Sprintf("d.Bool(&%s)", expr), true
+	case "byte", "short", "integer", "long":
+		return fmt.Sprintf("d.Int64(&%s)", expr), true
+	case "float", "double":
+		return fmt.Sprintf("d.Float64(&%s)", expr), true
+	case "timestamp":
+		return fmt.Sprintf("d.Time(&%s, %q)", expr, timestampFormat), true
+	case "blob":
+		return fmt.Sprintf("d.Blob(&%s)", expr), true
+	case "jsonvalue":
+		return fmt.Sprintf("d.JSONValue(&%s)", expr), true
+	default:
+		return "", false
+	}
+}
+
+// writeJSONDecode writes the code to decode the next JSON value into the
+// expression, returning any error. Timestamp format is the format of
+// timestamp values, and is only set for members.
+func (w *fieldMarshalerWriter) writeJSONDecode(ref *ShapeRef, expr, timestampFormat string, depth int) {
+	if call, ok := jsonDecodeCall(ref, expr, timestampFormat); ok {
+		w.printf("if err := %s; err != nil {", call)
+		w.printf("return err")
+		w.printf("}")
+		return
+	}
+
+	switch fieldShapeType(ref) {
+	case "structure":
+		w.printf("if ok, err := d.ObjectStart(); err != nil {")
+		w.printf("return err")
+		w.printf("} else if ok {")
+		w.printf("if %s == nil {", expr)
+		w.printf("%s = &%s{}", expr, ref.Shape.ShapeName)
+		w.printf("}")
+		w.printf("if err := %s.UnmarshalJSONFields(d); err != nil {", expr)
+		w.printf("return err")
+		w.printf("}")
+		w.printf("}")
+	case "list":
+		index := fmt.Sprintf("i%d", depth)
+		w.printf("if ok, err := d.ListStart(); err != nil {")
+		w.printf("return err")
+		w.printf("} else if ok {")
+		w.printf("if %s == nil {", expr)
+		w.printf("%s = %s{}", expr, ref.Shape.GoType())
+		w.printf("}")
+		w.printf("for %s := 0; d.More(); %s++ {", index, index)
+		w.printf("if %s >= len(%s) {", index, expr)
+		w.printf("%s = append(%s, nil)", expr, expr)
+		w.printf("}")
+		w.writeJSONDecode(&ref.Shape.MemberRef, fmt.Sprintf("%s[%s]", expr, index), "", depth+1)
+		w.printf("}")
+		w.printf("if err := d.ListEnd(); err != nil {")
+		w.printf("return err")
+		w.printf("}")
+		w.printf("}")
+	case "map":
+		key, elem := fmt.Sprintf("k%d", depth), fmt.Sprintf("v%d", depth)
+		valueRef := &ref.Shape.ValueRef
+		w.printf("if ok, err := d.MapStart(); err != nil {")
+		w.printf("return err")
+		w.printf("} else if ok {")
+		w.printf("if %s == nil {", expr)
+		w.printf("%s = %s{}", expr, ref.Shape.GoType())
+		w.printf("}")
+		w.printf("for {")
+		w.printf("%s, ok, err := d.ObjectKey()", key)
+		w.printf("if err != nil {")
+		w.printf("return err")
+		w.printf("} else if !ok {")
+		w.printf("break")
+		w.printf("}")
+		w.printf("")
+		w.printf("var %s %s", elem, w.fieldGoType(valueRef))
+		if call, ok := jsonDecodeCall(valueRef, elem, ""); ok {
+			w.printf("if err := %s; err != nil && d.Err() != nil {", call)
+		} else {
+			w.printf("if err := func() error {")
+			w.writeJSONDecode(valueRef, elem, "", depth+1)
+			w.printf("return nil")
+			w.printf("}(); err != nil && d.Err() != nil {")
+		}
+		w.printf("// Only errors decoding the JSON stream are returned, invalid")
+		w.printf("// map values are ignored.")
+		w.printf("return err")
+		w.printf("}")
+		w.printf("%s[%s] = %s", expr, key, elem)
+		w.printf("}")
+		w.printf("}")
+	default:
+		panic(fmt.Sprintf("unsupported shape type %s for field unmarshaler", fieldShapeType(ref)))
+	}
+}
+
+// xmlUnmarshalName returns the name of the member's XML elements, or
+// attribute.
+func xmlUnmarshalName(name string, ref *ShapeRef) string {
+	if (ref.Flattened || ref.Shape.Flattened) && ref.Shape.MemberRef.LocationName != "" {
+		return ref.Shape.MemberRef.LocationName
+	}
+	return memberLocationName(name, ref)
+}
+
+// writeXMLUnmarshalShape writes the UnmarshalXMLFields method of the shape.
+// Like the reflection based unmarshaler, all members are deserialized from
+// the elements of their name, including members bound to other locations of
+// the response.
+func (w *fieldMarshalerWriter) writeXMLUnmarshalShape(s *Shape) {
+	w.printf("// UnmarshalXMLFields deserializes the shape's members from the shape's XML")
+	w.printf("// element without reflection.")
+	w.printf("func (s *%s) UnmarshalXMLFields(node *xmlutil.XMLNode) error {", s.ShapeName)
+
+	for _, name := range s.MemberNames() {
+		ref := s.MemberRefs[name]
+		w.printf("for _, n1 := range node.Elems(%q) {", xmlUnmarshalName(name, ref))
+		w.writeXMLParse(ref, "s."+name, "n1", true, 1)
+		w.printf("}")
+	}
+
+	w.printf("return nil")
+	w.printf("}\n")
+}
+
+// writeXMLParse writes the code to deserialize the XML node into the
+// expression, returning any error. Member is set if the value is a shape's
+// member, whose reference's traits apply to the value. The traits of list
+// and map elements are not used.
+func (w *fieldMarshalerWriter) writeXMLParse(ref *ShapeRef, expr, node string, member bool, depth int) {
+	switch fieldShapeType(ref) {
+	case "structure":
+		w.printf("if %s == nil {", expr)
+		w.printf("%s = &%s{}", expr, ref.Shape.ShapeName)
+		w.printf("}")
+		w.printf("if err := %s.UnmarshalXMLFields(%s); err != nil {", expr, node)
+		w.printf("return err")
+		w.printf("}")
+	case "list":
+		w.writeXMLParseList(ref, expr, node, member, depth)
+	case "map":
+		w.writeXMLParseMap(ref, expr, node, member, depth)
+	case "string", "character":
+		w.printf("%s = &%s.Text", expr, node)
+	case "boolean":
+		w.AddImport("strconv")
+		w.writeXMLParseScalar(expr, fmt.Sprintf("strconv.ParseBool(%s.Text)", node), true, depth)
+	case "byte", "short", "integer", "long":
+		w.AddImport("strconv")
+		w.writeXMLParseScalar(expr, fmt.Sprintf("strconv.ParseInt(%s.Text, 10, 64)", node), true, depth)
+	case "float", "double":
+		w.AddImport("strconv")
+		w.writeXMLParseScalar(expr, fmt.Sprintf("strconv.ParseFloat(%s.Text, 64)", node), true, depth)
+	case "timestamp":
+		w.addProtocolImport()
+		w.writeXMLParseScalar(expr, fmt.Sprintf("protocol.ParseTime(%q, %s.Text)",
+			xmlTimestampFormat(ref, member), node), true, depth)
+	case "blob":
+		w.AddImport("encoding/base64")
+		w.writeXMLParseScalar(expr, fmt.Sprintf("base64.StdEncoding.DecodeString(%s.Text)", node), false, depth)
+	default:
+		panic(fmt.Sprintf("unsupported shape type %s for field unmarshaler", fieldShapeType(ref)))
+	}
+}
+
+// writeXMLParseScalar writes the code to set the expression to the value
+// returned by the parse call, or to its address if pointer is set.
+func (w *fieldMarshalerWriter) writeXMLParseScalar(expr, call string, pointer bool, depth int) {
+	v := fmt.Sprintf("v%d", depth)
+	w.printf("%s, err := %s", v, call)
+	w.printf("if err != nil {")
+	w.printf("return err")
+	w.printf("}")
+	if pointer {
+		w.printf("%s = &%s", expr, v)
+	} else {
+		w.printf("%s = %s", expr, v)
+	}
+}
+
+func (w *fieldMarshalerWriter) writeXMLParseList(ref *ShapeRef, expr, node string, member bool, depth int) {
+	elemRef := &ref.Shape.MemberRef
+
+	if member && (ref.Flattened || ref.Shape.Flattened) {
+		// Each element of flattened lists is a member element.
+		w.printf("%s = append(%s, nil)", expr, expr)
+		w.writeXMLParse(elemRef, fmt.Sprintf("%s[len(%s)-1]", expr, expr), node, false, depth+1)
+		return
+	}
+
+	listName := "member"
+	if member && elemRef.LocationName != "" {
+		listName = elemRef.LocationName
+	}
+
+	children, index := fmt.Sprintf("children%d", depth), fmt.Sprintf("i%d", depth)
+	child := fmt.Sprintf("n%d", depth+1)
+	w.printf("if %s, ok := %s.Children[%q]; ok {", children, node, listName)
+	w.printf("if %s == nil {", expr)
+	w.printf("%s = make(%s, len(%s))", expr, ref.Shape.GoType(), children)
+	w.printf("}")
+	w.printf("for %s, %s := range %s {", index, child, children)
+	w.writeXMLParse(elemRef, fmt.Sprintf("%s[%s]", expr, index), child, false, depth+1)
+	w.printf("}")
+	w.printf("}")
+}
+
+func (w *fieldMarshalerWriter) writeXMLParseMap(ref *ShapeRef, expr, node string, member bool, depth int) {
+	keyName, valueName := "key", "value"
+	if member && ref.Shape.KeyRef.LocationName != "" {
+		keyName = ref.Shape.KeyRef.LocationName
+	}
+	if member && ref.Shape.ValueRef.LocationName != "" {
+		valueName = ref.Shape.ValueRef.LocationName
+	}
+
+	w.printf("if %s == nil {", expr)
+	w.printf("%s = %s{}", expr, ref.Shape.GoType())
+	w.printf("}")
+
+	entry := node
+	flattened := member && (ref.Flattened || ref.Shape.Flattened)
+	if !flattened {
+		// The entries of maps which are not flattened are nested in the
+		// member element.
+		entry = fmt.Sprintf("entry%d", depth)
+		w.printf("for _, %s := range %s.Children[\"entry\"] {", entry, node)
+	}
+
+	keys, values := fmt.Sprintf("keys%d", depth), fmt.Sprintf("values%d", depth)
+	index, key, elem := fmt.Sprintf("i%d", depth), fmt.Sprintf("k%d", depth), fmt.Sprintf("e%d", depth)
+	w.printf("%s, %s := %s.Children[%q], %s.Children[%q]", keys, values, entry, keyName, entry, valueName)
+	w.printf("for %s, %s := range %s {", index, key, keys)
+	valueRef, value := &ref.Shape.ValueRef, fmt.Sprintf("%s[%s]", values, index)
+	if t := fieldShapeType(valueRef); t == "string" || t == "character" {
+		w.printf("%s[%s.Text] = &%s.Text", expr, key, value)
+	} else {
+		w.printf("var %s %s", elem, w.fieldGoType(valueRef))
+		w.printf("// Invalid map values are ignored.")
+		w.printf("func() error {")
+		w.writeXMLParse(valueRef, elem, value, false, depth+1)
+		w.printf("return nil")
+		w.printf("}()")
+		w.printf("%s[%s.Text] = %s", expr, key, elem)
+	}
+	w.printf("}")
+
+	if !flattened {
+		w.printf("}")
+	}
+}
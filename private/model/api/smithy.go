@@ -0,0 +1,1003 @@
+// +build codegen
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Smithy trait shape IDs translated into the API model.
+const (
+	smithyTraitDocumentation    = "smithy.api#documentation"
+	smithyTraitTitle            = "smithy.api#title"
+	smithyTraitRequired         = "smithy.api#required"
+	smithyTraitDeprecated       = "smithy.api#deprecated"
+	smithyTraitSensitive        = "smithy.api#sensitive"
+	smithyTraitEnum             = "smithy.api#enum"
+	smithyTraitEnumValue        = "smithy.api#enumValue"
+	smithyTraitLength           = "smithy.api#length"
+	smithyTraitRange            = "smithy.api#range"
+	smithyTraitError            = "smithy.api#error"
+	smithyTraitHTTPError        = "smithy.api#httpError"
+	smithyTraitHTTP             = "smithy.api#http"
+	smithyTraitHTTPLabel        = "smithy.api#httpLabel"
+	smithyTraitHTTPQuery        = "smithy.api#httpQuery"
+	smithyTraitHTTPQueryParams  = "smithy.api#httpQueryParams"
+	smithyTraitHTTPHeader       = "smithy.api#httpHeader"
+	smithyTraitHTTPPrefixHeader = "smithy.api#httpPrefixHeaders"
+	smithyTraitHTTPPayload      = "smithy.api#httpPayload"
+	smithyTraitHTTPResponseCode = "smithy.api#httpResponseCode"
+	smithyTraitJSONName         = "smithy.api#jsonName"
+	smithyTraitXMLName          = "smithy.api#xmlName"
+	smithyTraitXMLFlattened     = "smithy.api#xmlFlattened"
+	smithyTraitXMLAttribute     = "smithy.api#xmlAttribute"
+	smithyTraitXMLNamespace     = "smithy.api#xmlNamespace"
+	smithyTraitTimestampFormat  = "smithy.api#timestampFormat"
+	smithyTraitIdempotencyToken = "smithy.api#idempotencyToken"
+	smithyTraitHostLabel        = "smithy.api#hostLabel"
+	smithyTraitEndpoint         = "smithy.api#endpoint"
+	smithyTraitAuth             = "smithy.api#auth"
+	smithyTraitPaginated        = "smithy.api#paginated"
+	smithyTraitStreaming        = "smithy.api#streaming"
+
+	smithyTraitService                 = "aws.api#service"
+	smithyTraitClientEndpointDiscovery = "aws.api#clientEndpointDiscovery"
+	smithyTraitClientDiscoveredEndpt   = "aws.api#clientDiscoveredEndpoint"
+	smithyTraitEndpointDiscoveryID     = "aws.api#clientEndpointDiscoveryId"
+	smithyTraitSigV4                   = "aws.auth#sigv4"
+	smithyTraitUnsignedPayload         = "aws.auth#unsignedPayload"
+	smithyTraitAWSQueryError           = "aws.protocols#awsQueryError"
+	smithyTraitEC2QueryName            = "aws.protocols#ec2QueryName"
+
+	smithyTraitWaitable = "smithy.waiters#waitable"
+)
+
+// smithyProtocols maps the Smithy protocol traits to the API model's protocol
+// and JSON version.
+var smithyProtocols = []struct {
+	Trait       string
+	Protocol    string
+	JSONVersion string
+}{
+	{"aws.protocols#restJson1", "rest-json", ""},
+	{"aws.protocols#awsJson1_0", "json", "1.0"},
+	{"aws.protocols#awsJson1_1", "json", "1.1"},
+	{"aws.protocols#restXml", "rest-xml", ""},
+	{"aws.protocols#awsQuery", "query", ""},
+	{"aws.protocols#ec2Query", "ec2", ""},
+	{"smithy.protocols#rpcv2Cbor", "smithy-rpc-v2-cbor", ""},
+}
+
+// smithyShapeTypes maps Smithy simple and aggregate shape types to the API
+// model's shape types.
+var smithyShapeTypes = map[string]string{
+	"blob":       "blob",
+	"boolean":    "boolean",
+	"string":     "string",
+	"enum":       "string",
+	"byte":       "byte",
+	"short":      "short",
+	"integer":    "integer",
+	"intEnum":    "integer",
+	"long":       "long",
+	"float":      "float",
+	"double":     "double",
+	"bigInteger": "long",
+	"bigDecimal": "double",
+	"timestamp":  "timestamp",
+	"document":   "string",
+	"list":       "list",
+	"set":        "list",
+	"map":        "map",
+	"structure":  "structure",
+	"union":      "structure",
+}
+
+// smithyPrelude is the types of the Smithy prelude shapes that can be the
+// target of members.
+var smithyPrelude = map[string]string{
+	"String":           "string",
+	"Blob":             "blob",
+	"Boolean":          "boolean",
+	"PrimitiveBoolean": "boolean",
+	"Byte":             "byte",
+	"PrimitiveByte":    "byte",
+	"Short":            "short",
+	"PrimitiveShort":   "short",
+	"Integer":          "integer",
+	"PrimitiveInteger": "integer",
+	"Long":             "long",
+	"PrimitiveLong":    "long",
+	"Float":            "float",
+	"PrimitiveFloat":   "float",
+	"Double":           "double",
+	"PrimitiveDouble":  "double",
+	"BigInteger":       "bigInteger",
+	"BigDecimal":       "bigDecimal",
+	"Timestamp":        "timestamp",
+	"Document":         "document",
+}
+
+// smithyTimestampFormats maps the Smithy timestampFormat trait values to the
+// API model's timestamp formats.
+var smithyTimestampFormats = map[string]string{
+	"date-time":     "iso8601",
+	"http-date":     "rfc822",
+	"epoch-seconds": "unixTimestamp",
+}
+
+const (
+	smithyPreludeNamespace = "smithy.api#"
+	smithyUnitShape        = "smithy.api#Unit"
+
+	// Smithy waiters are bounded by the caller's maximum wait time instead of
+	// a number of attempts. Generated waiters use the waiter's minimum delay,
+	// and a fixed number of attempts.
+	smithyWaiterDefaultMinDelay = 2
+	smithyWaiterMaxAttempts     = 25
+)
+
+// smithyModel is the Smithy JSON AST of a model.
+type smithyModel struct {
+	Smithy string                  `json:"smithy"`
+	Shapes map[string]*smithyShape `json:"shapes"`
+}
+
+type smithyShape struct {
+	Type    string                   `json:"type"`
+	Version string                   `json:"version"`
+	Traits  smithyTraits             `json:"traits"`
+	Members map[string]*smithyMember `json:"members"`
+	Member  *smithyMember            `json:"member"`
+	Key     *smithyMember            `json:"key"`
+	Value   *smithyMember            `json:"value"`
+
+	// Service, resource, and operation shape properties.
+	Operations           []smithyRef `json:"operations"`
+	CollectionOperations []smithyRef `json:"collectionOperations"`
+	Resources            []smithyRef `json:"resources"`
+	Create               *smithyRef  `json:"create"`
+	Put                  *smithyRef  `json:"put"`
+	Read                 *smithyRef  `json:"read"`
+	Update               *smithyRef  `json:"update"`
+	Delete               *smithyRef  `json:"delete"`
+	List                 *smithyRef  `json:"list"`
+	Input                *smithyRef  `json:"input"`
+	Output               *smithyRef  `json:"output"`
+	Errors               []smithyRef `json:"errors"`
+}
+
+type smithyMember struct {
+	Target string       `json:"target"`
+	Traits smithyTraits `json:"traits"`
+}
+
+type smithyRef struct {
+	Target string `json:"target"`
+}
+
+// smithyTraits is the traits applied to a shape or member, by trait shape ID.
+type smithyTraits map[string]json.RawMessage
+
+func (ts smithyTraits) has(id string) bool {
+	_, ok := ts[id]
+	return ok
+}
+
+// decode decodes the value of the trait into v. Returns false if the trait is
+// not applied.
+func (ts smithyTraits) decode(id string, v interface{}) (bool, error) {
+	raw, ok := ts[id]
+	if !ok {
+		return false, nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return true, fmt.Errorf("invalid %s trait, %v", id, err)
+	}
+	return true, nil
+}
+
+// str returns the value of a string trait, or empty string if the trait is
+// not applied.
+func (ts smithyTraits) str(id string) string {
+	var s string
+	json.Unmarshal(ts[id], &s)
+	return s
+}
+
+type smithyHTTPTrait struct {
+	Method string `json:"method"`
+	URI    string `json:"uri"`
+	Code   uint   `json:"code"`
+}
+
+type smithyPaginatedTrait struct {
+	InputToken  string `json:"inputToken"`
+	OutputToken string `json:"outputToken"`
+	PageSize    string `json:"pageSize"`
+}
+
+type smithyWaiter struct {
+	Documentation string           `json:"documentation"`
+	MinDelay      int              `json:"minDelay"`
+	Acceptors     []smithyAcceptor `json:"acceptors"`
+}
+
+type smithyAcceptor struct {
+	State   string `json:"state"`
+	Matcher struct {
+		Output      *smithyPathMatcher `json:"output"`
+		InputOutput *smithyPathMatcher `json:"inputOutput"`
+		Success     *bool              `json:"success"`
+		ErrorType   string             `json:"errorType"`
+	} `json:"matcher"`
+}
+
+type smithyPathMatcher struct {
+	Path       string `json:"path"`
+	Expected   string `json:"expected"`
+	Comparator string `json:"comparator"`
+}
+
+// AttachSmithy loads the Smithy JSON AST model file into the API. The model
+// must contain a single service shape. The service's operations, and the
+// shapes they reference, are translated into the API's operations and
+// shapes. Paginators and waiters are translated from the operations'
+// paginated and waitable traits.
+func (a *API) AttachSmithy(filename string) error {
+	a.path = filepath.Dir(filename)
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var m smithyModel
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return fmt.Errorf("failed to decode %s, err: %v", filename, err)
+	}
+
+	c := smithyConverter{
+		API:    a,
+		model:  &m,
+		names:  map[string]string{},
+		shapes: map[string]string{},
+	}
+	if err := c.convert(); err != nil {
+		return fmt.Errorf("failed to translate Smithy model %s, %v", filename, err)
+	}
+
+	return nil
+}
+
+// isSmithyModel returns if the model file is a Smithy JSON AST model.
+func isSmithyModel(filename string) (bool, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	var v struct {
+		Smithy string `json:"smithy"`
+	}
+	if err := json.NewDecoder(f).Decode(&v); err != nil {
+		return false, fmt.Errorf("failed to decode %s, err: %v", filename, err)
+	}
+	return len(v.Smithy) != 0, nil
+}
+
+// smithyConverter translates a Smithy model into the API.
+type smithyConverter struct {
+	*API
+	model *smithyModel
+
+	// Shape IDs of the converted shapes by API shape name, used to detect
+	// shapes with colliding names.
+	names map[string]string
+	// API shape names by Smithy shape ID.
+	shapes map[string]string
+}
+
+func (c *smithyConverter) convert() error {
+	svcID, svc, err := c.service()
+	if err != nil {
+		return err
+	}
+	if err := c.convertMetadata(svcID, svc); err != nil {
+		return err
+	}
+
+	c.Operations = map[string]*Operation{}
+	c.Shapes = map[string]*Shape{}
+
+	opIDs := map[string]bool{}
+	c.collectOperations(svc, opIDs)
+	ids := make([]string, 0, len(opIDs))
+	for id := range opIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var discoveryOp string
+	var discovery struct {
+		Operation string `json:"operation"`
+	}
+	if _, err := svc.Traits.decode(smithyTraitClientEndpointDiscovery, &discovery); err != nil {
+		return err
+	}
+	discoveryOp = discovery.Operation
+
+	var svcPaginated smithyPaginatedTrait
+	if _, err := svc.Traits.decode(smithyTraitPaginated, &svcPaginated); err != nil {
+		return err
+	}
+
+	waiters := waiterDefinitions{API: c.API, Waiters: map[string]Waiter{}}
+	for _, id := range ids {
+		op, err := c.convertOperation(id, svc)
+		if err != nil {
+			return fmt.Errorf("operation %s, %v", id, err)
+		}
+		op.IsEndpointDiscoveryOp = id == discoveryOp
+		c.Operations[op.Name] = op
+
+		shape := c.model.Shapes[id]
+		if op.Paginator, err = c.paginator(shape, svcPaginated); err != nil {
+			return fmt.Errorf("operation %s, %v", id, err)
+		}
+		if err := c.waiters(op.Name, shape, waiters.Waiters); err != nil {
+			return fmt.Errorf("operation %s, %v", id, err)
+		}
+	}
+
+	if len(waiters.Waiters) != 0 {
+		return waiters.setup()
+	}
+	return nil
+}
+
+// service returns the model's service shape.
+func (c *smithyConverter) service() (string, *smithyShape, error) {
+	var id string
+	for k, s := range c.model.Shapes {
+		if s.Type != "service" {
+			continue
+		}
+		if len(id) != 0 {
+			return "", nil, fmt.Errorf("model must have a single service, found %s and %s", id, k)
+		}
+		id = k
+	}
+	if len(id) == 0 {
+		return "", nil, fmt.Errorf("model does not have a service shape")
+	}
+
+	return id, c.model.Shapes[id], nil
+}
+
+func (c *smithyConverter) convertMetadata(id string, svc *smithyShape) error {
+	name := smithyLocalName(id)
+
+	var svcTrait struct {
+		SDKID          string `json:"sdkId"`
+		ARNNamespace   string `json:"arnNamespace"`
+		EndpointPrefix string `json:"endpointPrefix"`
+	}
+	if _, err := svc.Traits.decode(smithyTraitService, &svcTrait); err != nil {
+		return err
+	}
+	var sigv4 struct {
+		Name string `json:"name"`
+	}
+	hasSigV4, err := svc.Traits.decode(smithyTraitSigV4, &sigv4)
+	if err != nil {
+		return err
+	}
+
+	md := Metadata{
+		APIVersion:          svc.Version,
+		ServiceID:           svcTrait.SDKID,
+		ServiceAbbreviation: svcTrait.SDKID,
+		ServiceFullName:     svc.Traits.str(smithyTraitTitle),
+		EndpointPrefix:      svcTrait.EndpointPrefix,
+		SigningName:         sigv4.Name,
+		SignatureVersion:    "v4",
+	}
+	if len(md.ServiceID) == 0 {
+		md.ServiceID = name
+	}
+	if len(md.ServiceFullName) == 0 {
+		md.ServiceFullName = md.ServiceID
+	}
+	if len(md.EndpointPrefix) == 0 {
+		md.EndpointPrefix = svcTrait.ARNNamespace
+	}
+	if len(md.EndpointPrefix) == 0 {
+		md.EndpointPrefix = strings.ToLower(strings.Replace(md.ServiceID, " ", "", -1))
+	}
+	if !hasSigV4 {
+		md.SigningName = md.EndpointPrefix
+	}
+
+	for _, p := range smithyProtocols {
+		if svc.Traits.has(p.Trait) {
+			md.Protocol, md.JSONVersion = p.Protocol, p.JSONVersion
+			break
+		}
+	}
+	switch md.Protocol {
+	case "":
+		return fmt.Errorf("service %s does not have a supported protocol trait", id)
+	case "json", "smithy-rpc-v2-cbor":
+		md.TargetPrefix = name
+	}
+
+	c.Metadata = md
+	c.Documentation = docstring(svc.Traits.str(smithyTraitDocumentation))
+
+	return nil
+}
+
+// collectOperations adds the IDs of the operations bound to the service or
+// resource shape, and its resources.
+func (c *smithyConverter) collectOperations(s *smithyShape, ids map[string]bool) {
+	refs := append([]smithyRef{}, s.Operations...)
+	refs = append(refs, s.CollectionOperations...)
+	for _, ref := range []*smithyRef{s.Create, s.Put, s.Read, s.Update, s.Delete, s.List} {
+		if ref != nil {
+			refs = append(refs, *ref)
+		}
+	}
+	for _, ref := range refs {
+		ids[ref.Target] = true
+	}
+
+	for _, ref := range s.Resources {
+		if r, ok := c.model.Shapes[ref.Target]; ok {
+			c.collectOperations(r, ids)
+		}
+	}
+}
+
+func (c *smithyConverter) convertOperation(id string, svc *smithyShape) (*Operation, error) {
+	s, ok := c.model.Shapes[id]
+	if !ok || s.Type != "operation" {
+		return nil, fmt.Errorf("operation shape not found")
+	}
+
+	op := &Operation{
+		Name:          smithyLocalName(id),
+		Documentation: docstring(s.Traits.str(smithyTraitDocumentation)),
+		HTTP:          HTTPInfo{Method: "POST", RequestURI: "/"},
+	}
+
+	var http smithyHTTPTrait
+	if ok, err := s.Traits.decode(smithyTraitHTTP, &http); err != nil {
+		return nil, err
+	} else if ok {
+		op.HTTP = HTTPInfo{Method: http.Method, RequestURI: http.URI, ResponseCode: http.Code}
+	} else if c.Metadata.Protocol == "rest-json" || c.Metadata.Protocol == "rest-xml" {
+		return nil, fmt.Errorf("%s protocol operation does not have http trait", c.Metadata.Protocol)
+	}
+
+	if ok, msg, err := smithyDeprecated(s.Traits); err != nil {
+		return nil, err
+	} else if ok {
+		op.Deprecated, op.DeprecatedMsg = true, msg
+	}
+
+	var auth []string
+	if ok, err := s.Traits.decode(smithyTraitAuth, &auth); err != nil {
+		return nil, err
+	} else if ok && len(auth) == 0 {
+		op.AuthType = NoneAuthType
+	} else if s.Traits.has(smithyTraitUnsignedPayload) {
+		op.AuthType = V4UnsignedBodyAuthType
+	}
+
+	var endpoint EndpointTrait
+	if ok, err := s.Traits.decode(smithyTraitEndpoint, &endpoint); err != nil {
+		return nil, err
+	} else if ok {
+		op.Endpoint = &endpoint
+	}
+
+	var discovered EndpointDiscovery
+	if ok, err := s.Traits.decode(smithyTraitClientDiscoveredEndpt, &discovered); err != nil {
+		return nil, err
+	} else if ok {
+		op.EndpointDiscovery = &discovered
+	}
+
+	if s.Input != nil && s.Input.Target != smithyUnitShape {
+		name, err := c.shape(s.Input.Target)
+		if err != nil {
+			return nil, err
+		}
+		op.InputRef = ShapeRef{ShapeName: name}
+		if ns := c.xmlNamespace(svc.Traits); c.Metadata.Protocol == "rest-xml" && ns != nil {
+			op.InputRef.XMLNamespace = *ns
+		}
+	}
+	if s.Output != nil && s.Output.Target != smithyUnitShape {
+		name, err := c.shape(s.Output.Target)
+		if err != nil {
+			return nil, err
+		}
+		op.OutputRef = ShapeRef{ShapeName: name}
+	}
+
+	// Errors bound to the service can be returned by all of its operations.
+	for _, ref := range append(append([]smithyRef{}, svc.Errors...), s.Errors...) {
+		name, err := c.shape(ref.Target)
+		if err != nil {
+			return nil, err
+		}
+		op.ErrorRefs = append(op.ErrorRefs, ShapeRef{ShapeName: name})
+	}
+
+	return op, nil
+}
+
+// shape converts the shape with the ID if it has not already been converted,
+// returning the API shape name of the shape.
+func (c *smithyConverter) shape(id string) (string, error) {
+	if name, ok := c.shapes[id]; ok {
+		return name, nil
+	}
+
+	s, ok := c.model.Shapes[id]
+	if !ok && strings.HasPrefix(id, smithyPreludeNamespace) {
+		if t, ok := smithyPrelude[smithyLocalName(id)]; ok {
+			s = &smithyShape{Type: t}
+		}
+	}
+	if s == nil {
+		return "", fmt.Errorf("shape %s not found", id)
+	}
+
+	name := smithyLocalName(id)
+	if other, ok := c.names[name]; ok {
+		return "", fmt.Errorf("shape %s name conflicts with %s", id, other)
+	}
+	typ, ok := smithyShapeTypes[s.Type]
+	if !ok {
+		return "", fmt.Errorf("shape %s type %s not supported", id, s.Type)
+	}
+
+	// The shape is added before its members are converted, for recursive
+	// shapes.
+	shape := &Shape{
+		API:           c.API,
+		ShapeName:     name,
+		Type:          typ,
+		Documentation: docstring(s.Traits.str(smithyTraitDocumentation)),
+		Sensitive:     s.Traits.has(smithyTraitSensitive),
+	}
+	c.names[name], c.shapes[id] = id, name
+	c.Shapes[name] = shape
+
+	if err := c.convertShape(id, s, shape); err != nil {
+		return "", fmt.Errorf("shape %s, %v", id, err)
+	}
+
+	return name, nil
+}
+
+func (c *smithyConverter) convertShape(id string, s *smithyShape, shape *Shape) error {
+	if ok, msg, err := smithyDeprecated(s.Traits); err != nil {
+		return err
+	} else if ok {
+		shape.Deprecated, shape.DeprecatedMsg = true, msg
+	}
+
+	if format := s.Traits.str(smithyTraitTimestampFormat); len(format) != 0 {
+		shape.TimestampFormat = smithyTimestampFormats[format]
+	}
+	if c.isXMLProtocol() {
+		shape.LocationName = s.Traits.str(smithyTraitXMLName)
+		if ns := c.xmlNamespace(s.Traits); ns != nil {
+			shape.XMLNamespace = *ns
+		}
+	}
+
+	min, err := smithyMin(s.Traits)
+	if err != nil {
+		return err
+	}
+	shape.Min = min
+
+	var enum []struct {
+		Value string `json:"value"`
+	}
+	if _, err := s.Traits.decode(smithyTraitEnum, &enum); err != nil {
+		return err
+	}
+	for _, e := range enum {
+		shape.Enum = append(shape.Enum, e.Value)
+	}
+
+	if err := c.convertError(s, shape); err != nil {
+		return err
+	}
+
+	switch s.Type {
+	case "list", "set":
+		if s.Member == nil {
+			return fmt.Errorf("list does not have member")
+		}
+		ref, err := c.memberRef(s.Member)
+		if err != nil {
+			return err
+		}
+		shape.MemberRef = *ref
+		shape.Flattened = c.isXMLProtocol() && s.Traits.has(smithyTraitXMLFlattened)
+
+	case "map":
+		if s.Key == nil || s.Value == nil {
+			return fmt.Errorf("map does not have key and value")
+		}
+		key, err := c.memberRef(s.Key)
+		if err != nil {
+			return err
+		}
+		value, err := c.memberRef(s.Value)
+		if err != nil {
+			return err
+		}
+		shape.KeyRef, shape.ValueRef = *key, *value
+		shape.Flattened = c.isXMLProtocol() && s.Traits.has(smithyTraitXMLFlattened)
+
+	case "enum":
+		for _, name := range smithySortedMembers(s.Members) {
+			value := s.Members[name].Traits.str(smithyTraitEnumValue)
+			if len(value) == 0 {
+				value = name
+			}
+			shape.Enum = append(shape.Enum, value)
+		}
+
+	case "intEnum":
+		// Integer enum values are not generated as constants.
+
+	case "structure", "union":
+		shape.MemberRefs = map[string]*ShapeRef{}
+		for _, name := range smithySortedMembers(s.Members) {
+			m := s.Members[name]
+			ref, err := c.memberRef(m)
+			if err != nil {
+				return fmt.Errorf("member %s, %v", name, err)
+			}
+			if err := c.bindMember(name, m.Traits, shape, ref); err != nil {
+				return fmt.Errorf("member %s, %v", name, err)
+			}
+			shape.MemberRefs[name] = ref
+		}
+
+		if s.Type == "union" && s.Traits.has(smithyTraitStreaming) {
+			shape.IsEventStream = true
+			for _, ref := range shape.MemberRefs {
+				c.Shapes[ref.ShapeName].IsEvent = true
+			}
+		}
+
+	case "blob", "string":
+		shape.Streaming = s.Traits.has(smithyTraitStreaming)
+	}
+
+	return nil
+}
+
+// convertError sets the error information of the shape from its error traits.
+func (c *smithyConverter) convertError(s *smithyShape, shape *Shape) error {
+	var kind string
+	if ok, err := s.Traits.decode(smithyTraitError, &kind); err != nil || !ok {
+		return err
+	}
+
+	shape.Exception = true
+	shape.ErrorInfo.HTTPStatusCode = 400
+	if kind == "server" {
+		shape.ErrorInfo.HTTPStatusCode = 500
+	}
+	if _, err := s.Traits.decode(smithyTraitHTTPError, &shape.ErrorInfo.HTTPStatusCode); err != nil {
+		return err
+	}
+
+	var queryErr struct {
+		Code             string `json:"code"`
+		HTTPResponseCode int    `json:"httpResponseCode"`
+	}
+	if ok, err := s.Traits.decode(smithyTraitAWSQueryError, &queryErr); err != nil {
+		return err
+	} else if ok {
+		shape.ErrorInfo.Code = queryErr.Code
+		if queryErr.HTTPResponseCode != 0 {
+			shape.ErrorInfo.HTTPStatusCode = queryErr.HTTPResponseCode
+		}
+	}
+
+	return nil
+}
+
+// memberRef returns the reference of the member to its target shape, with the
+// member's serialization traits applied.
+func (c *smithyConverter) memberRef(m *smithyMember) (*ShapeRef, error) {
+	name, err := c.shape(m.Target)
+	if err != nil {
+		return nil, err
+	}
+	ref := &ShapeRef{
+		ShapeName:     name,
+		Documentation: docstring(m.Traits.str(smithyTraitDocumentation)),
+	}
+
+	if target, ok := c.model.Shapes[m.Target]; ok && target.Type == "document" {
+		ref.JSONValue = true
+	} else if m.Target == smithyPreludeNamespace+"Document" {
+		ref.JSONValue = true
+	}
+
+	if m.Traits.has(smithyTraitSensitive) {
+		if ref.ShapeName, err = c.sensitiveShape(name); err != nil {
+			return nil, err
+		}
+	}
+
+	if ok, msg, err := smithyDeprecated(m.Traits); err != nil {
+		return nil, err
+	} else if ok {
+		ref.Deprecated, ref.DeprecatedMsg = true, msg
+	}
+
+	if format := m.Traits.str(smithyTraitTimestampFormat); len(format) != 0 {
+		ref.TimestampFormat = smithyTimestampFormats[format]
+	}
+
+	switch {
+	case c.Metadata.Protocol == "rest-json":
+		ref.LocationName = m.Traits.str(smithyTraitJSONName)
+	case c.isXMLProtocol():
+		ref.LocationName = m.Traits.str(smithyTraitXMLName)
+		ref.Flattened = m.Traits.has(smithyTraitXMLFlattened)
+		ref.XMLAttribute = m.Traits.has(smithyTraitXMLAttribute)
+		if ns := c.xmlNamespace(m.Traits); ns != nil {
+			ref.XMLNamespace = *ns
+		}
+	}
+	if c.Metadata.Protocol == "ec2" {
+		ref.QueryName = m.Traits.str(smithyTraitEC2QueryName)
+	}
+
+	ref.IdempotencyToken = m.Traits.has(smithyTraitIdempotencyToken)
+	ref.HostLabel = m.Traits.has(smithyTraitHostLabel)
+	ref.EndpointDiscoveryID = m.Traits.has(smithyTraitEndpointDiscoveryID)
+
+	return ref, nil
+}
+
+// sensitiveShape returns the name of the sensitive shape for the member
+// targeting the shape. Sensitive members targeting shapes that are not
+// sensitive refer to a sensitive copy of the shape.
+func (c *smithyConverter) sensitiveShape(name string) (string, error) {
+	shape := c.Shapes[name]
+	if shape.Sensitive {
+		return name, nil
+	}
+
+	sensitiveName := "Sensitive" + name
+	if s, ok := c.Shapes[sensitiveName]; ok {
+		if !s.Sensitive {
+			return "", fmt.Errorf("shape %s name conflicts with sensitive %s shape", sensitiveName, name)
+		}
+		return sensitiveName, nil
+	}
+
+	cp := *shape
+	cp.ShapeName = sensitiveName
+	cp.Sensitive = true
+	c.Shapes[sensitiveName] = &cp
+	c.names[sensitiveName] = c.names[name]
+
+	return sensitiveName, nil
+}
+
+// bindMember applies the member's HTTP binding traits, and required trait, to
+// the member's structure.
+func (c *smithyConverter) bindMember(name string, traits smithyTraits, shape *Shape, ref *ShapeRef) error {
+	if traits.has(smithyTraitRequired) {
+		shape.Required = append(shape.Required, name)
+	}
+
+	switch {
+	case traits.has(smithyTraitHTTPLabel):
+		ref.Location, ref.LocationName = "uri", name
+	case traits.has(smithyTraitHTTPQuery):
+		ref.Location, ref.LocationName = "querystring", traits.str(smithyTraitHTTPQuery)
+	case traits.has(smithyTraitHTTPQueryParams):
+		ref.Location = "querystring"
+	case traits.has(smithyTraitHTTPHeader):
+		ref.Location, ref.LocationName = "header", traits.str(smithyTraitHTTPHeader)
+	case traits.has(smithyTraitHTTPPrefixHeader):
+		ref.Location, ref.LocationName = "headers", traits.str(smithyTraitHTTPPrefixHeader)
+	case traits.has(smithyTraitHTTPResponseCode):
+		ref.Location = "statusCode"
+	case traits.has(smithyTraitHTTPPayload):
+		shape.Payload = name
+		if c.Metadata.Protocol == "rest-xml" && len(ref.LocationName) == 0 {
+			if target := c.Shapes[ref.ShapeName]; target.Type == "structure" && len(target.LocationName) == 0 {
+				ref.LocationName = name
+			}
+		}
+	}
+
+	return nil
+}
+
+// paginator returns the paginator of the operation from its paginated trait,
+// merged with the service's paginated trait.
+func (c *smithyConverter) paginator(s *smithyShape, svc smithyPaginatedTrait) (*Paginator, error) {
+	var p smithyPaginatedTrait
+	if ok, err := s.Traits.decode(smithyTraitPaginated, &p); err != nil || !ok {
+		return nil, err
+	}
+
+	if len(p.InputToken) == 0 {
+		p.InputToken = svc.InputToken
+	}
+	if len(p.OutputToken) == 0 {
+		p.OutputToken = svc.OutputToken
+	}
+	if len(p.PageSize) == 0 {
+		p.PageSize = svc.PageSize
+	}
+	if len(p.InputToken) == 0 || len(p.OutputToken) == 0 {
+		return nil, fmt.Errorf("paginated trait must have inputToken and outputToken")
+	}
+
+	return &Paginator{
+		InputTokens:  []string{p.InputToken},
+		OutputTokens: []string{p.OutputToken},
+		LimitKey:     p.PageSize,
+	}, nil
+}
+
+// waiters adds the waiters of the operation's waitable trait.
+func (c *smithyConverter) waiters(opName string, s *smithyShape, waiters map[string]Waiter) error {
+	var defs map[string]smithyWaiter
+	if ok, err := s.Traits.decode(smithyTraitWaitable, &defs); err != nil || !ok {
+		return err
+	}
+
+	for name, def := range defs {
+		if _, ok := waiters[name]; ok {
+			return fmt.Errorf("waiter %s is defined more than once", name)
+		}
+
+		w := Waiter{
+			Name:          name,
+			Delay:         def.MinDelay,
+			MaxAttempts:   smithyWaiterMaxAttempts,
+			OperationName: opName,
+		}
+		if w.Delay == 0 {
+			w.Delay = smithyWaiterDefaultMinDelay
+		}
+
+		for _, sa := range def.Acceptors {
+			a, err := smithyWaiterAcceptor(sa)
+			if err != nil {
+				return fmt.Errorf("waiter %s, %v", name, err)
+			}
+			w.Acceptors = append(w.Acceptors, a)
+		}
+		waiters[name] = w
+	}
+
+	return nil
+}
+
+func smithyWaiterAcceptor(sa smithyAcceptor) (WaiterAcceptor, error) {
+	a := WaiterAcceptor{State: sa.State}
+
+	switch m := sa.Matcher; {
+	case m.Output != nil:
+		a.Argument, a.Expected = m.Output.Path, m.Output.Expected
+		switch m.Output.Comparator {
+		case "stringEquals":
+			a.Matcher = "path"
+		case "booleanEquals":
+			b, err := strconv.ParseBool(m.Output.Expected)
+			if err != nil {
+				return a, fmt.Errorf("invalid booleanEquals expected value, %v", err)
+			}
+			a.Matcher, a.Expected = "path", b
+		case "allStringEquals":
+			a.Matcher = "pathAll"
+		case "anyStringEquals":
+			a.Matcher = "pathAny"
+		default:
+			return a, fmt.Errorf("comparator %s not supported", m.Output.Comparator)
+		}
+	case m.Success != nil:
+		if !*m.Success {
+			return a, fmt.Errorf("success matcher must be true")
+		}
+		a.Matcher, a.Expected = "status", 200
+	case len(m.ErrorType) != 0:
+		a.Matcher, a.Expected = "error", smithyLocalName(m.ErrorType)
+	default:
+		return a, fmt.Errorf("acceptor matcher not supported")
+	}
+
+	return a, nil
+}
+
+func (c *smithyConverter) isXMLProtocol() bool {
+	switch c.Metadata.Protocol {
+	case "rest-xml", "query", "ec2":
+		return true
+	}
+	return false
+}
+
+func (c *smithyConverter) xmlNamespace(traits smithyTraits) *XMLInfo {
+	var ns struct {
+		URI    string `json:"uri"`
+		Prefix string `json:"prefix"`
+	}
+	if ok, _ := traits.decode(smithyTraitXMLNamespace, &ns); !ok {
+		return nil
+	}
+	return &XMLInfo{URI: ns.URI, Prefix: ns.Prefix}
+}
+
+// smithyDeprecated returns if the deprecated trait is applied, and its
+// message.
+func smithyDeprecated(traits smithyTraits) (bool, string, error) {
+	var d struct {
+		Message string `json:"message"`
+	}
+	ok, err := traits.decode(smithyTraitDeprecated, &d)
+	return ok, d.Message, err
+}
+
+// smithyMin returns the minimum of the length or range trait.
+func smithyMin(traits smithyTraits) (float64, error) {
+	var c struct {
+		Min float64 `json:"min"`
+	}
+	if ok, err := traits.decode(smithyTraitLength, &c); ok {
+		return c.Min, err
+	}
+	_, err := traits.decode(smithyTraitRange, &c)
+	return c.Min, err
+}
+
+// smithyLocalName returns the name of the shape ID without its namespace, or
+// member.
+func smithyLocalName(id string) string {
+	if i := strings.Index(id, "#"); i >= 0 {
+		id = id[i+1:]
+	}
+	if i := strings.Index(id, "$"); i >= 0 {
+		id = id[:i]
+	}
+	return id
+}
+
+func smithySortedMembers(members map[string]*smithyMember) []string {
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
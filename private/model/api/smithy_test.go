@@ -0,0 +1,265 @@
+// +build go1.8,codegen
+
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestLoadAPI_Smithy(t *testing.T) {
+	a, err := loadAPI(filepath.Join("codegentest", "models", "smithyrestjson", "0000-00-00", "model.json"), "")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "rest-json", a.Metadata.Protocol; e != a {
+		t.Errorf("expect %v protocol, got %v", e, a)
+	}
+	if e, a := "smithyrestjsonservice", a.Metadata.EndpointPrefix; e != a {
+		t.Errorf("expect %v endpoint prefix, got %v", e, a)
+	}
+	if e, a := "Smithy Rest Json Service", a.Metadata.ServiceID; e != a {
+		t.Errorf("expect %v service ID, got %v", e, a)
+	}
+	if e, a := "SmithyRestJsonService", a.StructName(); e != a {
+		t.Errorf("expect %v struct name, got %v", e, a)
+	}
+
+	var ops []string
+	for name := range a.Operations {
+		ops = append(ops, name)
+	}
+	sort.Strings(ops)
+	if e, a := []string{"CreateWidget", "GetWidget", "ListWidgets", "PutWidgetData"}, ops; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v operations, got %v", e, a)
+	}
+
+	create := a.Operations["CreateWidget"]
+	if e, a := (HTTPInfo{Method: "POST", RequestURI: "/widgets", ResponseCode: 201}), create.HTTP; e != a {
+		t.Errorf("expect %v http, got %v", e, a)
+	}
+	input := create.InputRef.Shape
+	if !input.MemberRefs["ClientToken"].IdempotencyToken {
+		t.Errorf("expect ClientToken to be idempotency token")
+	}
+	if e, a := "header", input.MemberRefs["ClientToken"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+	if e, a := "name", input.MemberRefs["Name"].LocationName; e != a {
+		t.Errorf("expect %v location name, got %v", e, a)
+	}
+	if e, a := []string{"Name"}, input.Required; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v required, got %v", e, a)
+	}
+	if !input.MemberRefs["Secret"].Shape.Sensitive {
+		t.Errorf("expect Secret to be sensitive")
+	}
+	if input.MemberRefs["Name"].Shape.Sensitive {
+		t.Errorf("expect Name not to be sensitive")
+	}
+	if e, a := "Widget", create.OutputRef.Shape.Payload; e != a {
+		t.Errorf("expect %v payload, got %v", e, a)
+	}
+
+	get := a.Operations["GetWidget"]
+	output := get.OutputRef.Shape
+	if e, a := "unixTimestamp", output.MemberRefs["CreatedAt"].TimestampFormat; e != a {
+		t.Errorf("expect %v timestamp format, got %v", e, a)
+	}
+	if !output.MemberRefs["Metadata"].JSONValue {
+		t.Errorf("expect Metadata to be JSON value")
+	}
+	if e, a := []string{"PENDING", "ACTIVE", "FAILED"}, output.MemberRefs["State"].Shape.Enum; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v enum, got %v", e, a)
+	}
+	if e, a := 2, len(get.ErrorRefs); e != a {
+		t.Fatalf("expect %v errors, got %v", e, a)
+	}
+	for _, ref := range get.ErrorRefs {
+		status := map[string]int{
+			"ServiceFault":            500,
+			"WidgetNotFoundException": 404,
+		}[ref.ShapeName]
+		if e, a := status, ref.Shape.ErrorInfo.HTTPStatusCode; e != a {
+			t.Errorf("expect %v %v status code, got %v", ref.ShapeName, e, a)
+		}
+		if !ref.Shape.Exception {
+			t.Errorf("expect %v to be exception", ref.ShapeName)
+		}
+	}
+
+	list := a.Operations["ListWidgets"]
+	if list.Paginator == nil {
+		t.Fatalf("expect ListWidgets paginator")
+	}
+	if e, a := []string{"NextToken"}, list.Paginator.InputTokens; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v input tokens, got %v", e, a)
+	}
+	if e, a := "MaxResults", list.Paginator.LimitKey; e != a {
+		t.Errorf("expect %v limit key, got %v", e, a)
+	}
+	if e, a := "querystring", list.InputRef.Shape.MemberRefs["MaxResults"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+
+	put := a.Operations["PutWidgetData"]
+	if e, a := V4UnsignedBodyAuthType, put.AuthType; e != a {
+		t.Errorf("expect %v auth type, got %v", e, a)
+	}
+	if !put.InputRef.Shape.MemberRefs["Body"].Shape.Streaming {
+		t.Errorf("expect Body to be streaming")
+	}
+	if e, a := "headers", put.InputRef.Shape.MemberRefs["Metadata"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+	if e, a := "statusCode", put.OutputRef.Shape.MemberRefs["Status"].Location; e != a {
+		t.Errorf("expect %v location, got %v", e, a)
+	}
+
+	if e, a := 1, len(a.Waiters); e != a {
+		t.Fatalf("expect %v waiters, got %v", e, a)
+	}
+	w := a.Waiters[0]
+	if e, a := "WidgetActive", w.Name; e != a {
+		t.Errorf("expect %v waiter, got %v", e, a)
+	}
+	if e, a := get, w.Operation; e != a {
+		t.Errorf("expect %v waiter operation, got %v", e.Name, a.Name)
+	}
+	if e, a := 5, w.Delay; e != a {
+		t.Errorf("expect %v delay, got %v", e, a)
+	}
+	expectAcceptors := []WaiterAcceptor{
+		{State: "success", Matcher: "path", Argument: "State", Expected: "ACTIVE"},
+		{State: "failure", Matcher: "path", Argument: "State", Expected: "FAILED"},
+		{State: "retry", Matcher: "error", Expected: "WidgetNotFoundException"},
+	}
+	if e, a := expectAcceptors, w.Acceptors; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v acceptors, got %v", e, a)
+	}
+}
+
+func TestAttachSmithy_Invalid(t *testing.T) {
+	const service = `"ns#Service": {
+		"type": "service",
+		"version": "2020-01-01",
+		"operations": [{"target": "ns#Operation"}],
+		"traits": {"aws.protocols#awsJson1_1": {}}
+	}`
+
+	cases := map[string]struct {
+		Model     string
+		ExpectErr string
+	}{
+		"no service": {
+			Model:     `{"smithy": "1.0", "shapes": {}}`,
+			ExpectErr: "does not have a service shape",
+		},
+		"multiple services": {
+			Model: `{"smithy": "1.0", "shapes": {
+				"ns#Other": {"type": "service", "version": "2020-01-01"},
+				` + service + `}}`,
+			ExpectErr: "single service",
+		},
+		"no protocol": {
+			Model: `{"smithy": "1.0", "shapes": {
+				"ns#Service": {"type": "service", "version": "2020-01-01"}}}`,
+			ExpectErr: "supported protocol",
+		},
+		"missing shape": {
+			Model: `{"smithy": "1.0", "shapes": {` + service + `,
+				"ns#Operation": {"type": "operation", "input": {"target": "ns#Input"}}}}`,
+			ExpectErr: "shape ns#Input not found",
+		},
+		"conflicting names": {
+			Model: `{"smithy": "1.0", "shapes": {` + service + `,
+				"ns#Operation": {"type": "operation", "input": {"target": "ns#Input"}},
+				"ns#Input": {"type": "structure", "members": {
+					"A": {"target": "ns#Name"},
+					"B": {"target": "other#Name"}
+				}},
+				"ns#Name": {"type": "string"},
+				"other#Name": {"type": "string"}}}`,
+			ExpectErr: "name conflicts",
+		},
+		"unsupported waiter matcher": {
+			Model: `{"smithy": "1.0", "shapes": {` + service + `,
+				"ns#Operation": {"type": "operation", "traits": {
+					"smithy.waiters#waitable": {"Done": {"acceptors": [
+						{"state": "success", "matcher": {"inputOutput": {
+							"path": "input.Name == output.Name",
+							"expected": "true",
+							"comparator": "booleanEquals"
+						}}}
+					]}}
+				}}}}`,
+			ExpectErr: "matcher not supported",
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "smithy")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			filename := filepath.Join(dir, strings.Replace(name, " ", "_", -1)+".json")
+			if err := ioutil.WriteFile(filename, []byte(c.Model), 0644); err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			a := API{}
+			err := a.AttachSmithy(filename)
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect %q error, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestAttachSmithy_RPCv2CBOR(t *testing.T) {
+	const model = `{"smithy": "1.0", "shapes": {
+		"ns#Service": {
+			"type": "service",
+			"version": "2020-01-01",
+			"operations": [{"target": "ns#Operation"}],
+			"traits": {"smithy.protocols#rpcv2Cbor": {}}
+		},
+		"ns#Operation": {"type": "operation"}}}`
+
+	dir, err := ioutil.TempDir("", "smithy")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	filename := filepath.Join(dir, "model.json")
+	if err := ioutil.WriteFile(filename, []byte(model), 0644); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	a := API{}
+	if err := a.AttachSmithy(filename); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if e, a := "smithy-rpc-v2-cbor", a.Metadata.Protocol; e != a {
+		t.Errorf("expect %v protocol, got %v", e, a)
+	}
+	if e, a := "Service", a.Metadata.TargetPrefix; e != a {
+		t.Errorf("expect %v target prefix, got %v", e, a)
+	}
+	if e, a := "rpcv2cbor", a.ProtocolPackage(); e != a {
+		t.Errorf("expect %v protocol package, got %v", e, a)
+	}
+}
@@ -9,15 +9,14 @@ package main
 import (
 	"flag"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"sync"
 
+	"github.com/aws/aws-sdk-go/codegen"
 	"github.com/aws/aws-sdk-go/private/model/api"
-	"github.com/aws/aws-sdk-go/private/util"
 )
 
 func usage() {
@@ -119,7 +118,6 @@ func main() {
 
 		// Create the output path for the model.
 		pkgDir := filepath.Join(svcPath, a.PackageName())
-		os.MkdirAll(filepath.Join(pkgDir, a.InterfacePackageName()), 0775)
 
 		if _, ok := servicePaths[pkgDir]; ok {
 			fmt.Fprintf(os.Stderr,
@@ -153,6 +151,8 @@ var excludeServices = map[string]struct{}{
 	"importexport": {},
 }
 
+var writer = codegen.Writer{Generator: "private/model/cli/gen-api/main.go"}
+
 func writeServiceFiles(g *generateInfo, pkgDir string) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -166,25 +166,11 @@ func writeServiceFiles(g *generateInfo, pkgDir string) {
 		g.API.PackageName(), g.API.Metadata.APIVersion)
 
 	// write files for service client and API
-	Must(writeServiceDocFile(g))
-	Must(writeAPIFile(g))
-	Must(writeServiceFile(g))
-	Must(writeInterfaceFile(g))
-	Must(writeWaitersFile(g))
-	Must(writeAPIErrorsFile(g))
-	Must(writeExamplesFile(g))
-
-	if g.API.HasEventStream {
-		Must(writeAPIEventStreamTestFile(g))
-	}
+	Must(writer.WriteClient(g.API, pkgDir))
 
 	if g.API.PackageName() == "s3" {
 		Must(writeS3ManagerUploadInputFile(g))
 	}
-
-	if len(g.API.SmokeTests.TestCases) > 0 {
-		Must(writeAPISmokeTestsFile(g))
-	}
 }
 
 // Must will panic if the error passed in is not nil.
@@ -194,125 +180,10 @@ func Must(err error) {
 	}
 }
 
-const codeLayout = `// Code generated by private/model/cli/gen-api/main.go. DO NOT EDIT.
-
-%s
-package %s
-
-%s
-`
-
-func writeGoFile(file string, layout string, args ...interface{}) error {
-	return ioutil.WriteFile(file, []byte(util.GoFmt(fmt.Sprintf(layout, args...))), 0664)
-}
-
-// writeServiceDocFile generates the documentation for service package.
-func writeServiceDocFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "doc.go"),
-		codeLayout,
-		strings.TrimSpace(g.API.ServicePackageDoc()),
-		g.API.PackageName(),
-		"",
-	)
-}
-
-// writeExamplesFile writes out the service example file.
-func writeExamplesFile(g *generateInfo) error {
-	code := g.API.ExamplesGoCode()
-	if len(code) > 0 {
-		return writeGoFile(filepath.Join(g.PackageDir, "examples_test.go"),
-			codeLayout,
-			"",
-			g.API.PackageName()+"_test",
-			code,
-		)
-	}
-	return nil
-}
-
-// writeServiceFile writes out the service initialization file.
-func writeServiceFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "service.go"),
-		codeLayout,
-		"",
-		g.API.PackageName(),
-		g.API.ServiceGoCode(),
-	)
-}
-
-// writeInterfaceFile writes out the service interface file.
-func writeInterfaceFile(g *generateInfo) error {
-	const pkgDoc = `
-// Package %s provides an interface to enable mocking the %s service client
-// for testing your code.
-//
-// It is important to note that this interface will have breaking changes
-// when the service model is updated and adds new API operations, paginators,
-// and waiters.`
-	return writeGoFile(filepath.Join(g.PackageDir, g.API.InterfacePackageName(), "interface.go"),
-		codeLayout,
-		fmt.Sprintf(pkgDoc, g.API.InterfacePackageName(), g.API.Metadata.ServiceFullName),
-		g.API.InterfacePackageName(),
-		g.API.InterfaceGoCode(),
-	)
-}
-
-func writeWaitersFile(g *generateInfo) error {
-	if len(g.API.Waiters) == 0 {
-		return nil
-	}
-
-	return writeGoFile(filepath.Join(g.PackageDir, "waiters.go"),
-		codeLayout,
-		"",
-		g.API.PackageName(),
-		g.API.WaitersGoCode(),
-	)
-}
-
-// writeAPIFile writes out the service API file.
-func writeAPIFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "api.go"),
-		codeLayout,
-		"",
-		g.API.PackageName(),
-		g.API.APIGoCode(),
-	)
-}
-
-// writeAPIErrorsFile writes out the service API errors file.
-func writeAPIErrorsFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "errors.go"),
-		codeLayout,
-		"",
-		g.API.PackageName(),
-		g.API.APIErrorsGoCode(),
-	)
-}
-
-func writeAPIEventStreamTestFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "eventstream_test.go"),
-		codeLayout,
-		"// +build go1.10\n",
-		g.API.PackageName(),
-		g.API.APIEventStreamTestGoCode(),
-	)
-}
-
 func writeS3ManagerUploadInputFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "s3manager", "upload_input.go"),
-		codeLayout,
+	return writer.WriteGoFile(filepath.Join(g.PackageDir, "s3manager", "upload_input.go"),
 		"",
 		"s3manager",
 		api.S3ManagerUploadInputGoCode(g.API),
 	)
 }
-
-func writeAPISmokeTestsFile(g *generateInfo) error {
-	return writeGoFile(filepath.Join(g.PackageDir, "integ_test.go"),
-		codeLayout,
-		"// +build go1.10,integration\n",
-		g.API.PackageName()+"_test",
-		g.API.APISmokeTestsGoCode(),
-	)
-}
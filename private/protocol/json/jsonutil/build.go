@@ -3,7 +3,6 @@ package jsonutil
 
 import (
 	"bytes"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -23,11 +22,28 @@ var byteSliceType = reflect.ValueOf([]byte{}).Type()
 func BuildJSON(v interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 
-	err := buildAny(reflect.ValueOf(v), &buf, "")
+	err := jsonBuilder{}.buildAny(reflect.ValueOf(v), &buf, "")
 	return buf.Bytes(), err
 }
 
-func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+// BuildJSONWithReflection builds a JSON string for a given object v, with
+// reflection only. Shapes implementing Marshaler are serialized with
+// reflection instead of their generated serializers, such as to verify the
+// generated serializers.
+func BuildJSONWithReflection(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := jsonBuilder{reflectOnly: true}.buildAny(reflect.ValueOf(v), &buf, "")
+	return buf.Bytes(), err
+}
+
+// jsonBuilder serializes values to JSON.
+type jsonBuilder struct {
+	// reflectOnly disables the generated serializers of shapes.
+	reflectOnly bool
+}
+
+func (b jsonBuilder) buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	origVal := value
 	value = reflect.Indirect(value)
 	if !value.IsValid() {
@@ -59,20 +75,23 @@ func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 
 	switch t {
 	case "structure":
+		if m, ok := marshalerOf(origVal); ok && !b.reflectOnly {
+			return m.MarshalJSONFields(NewEncoder(buf))
+		}
 		if field, ok := vtype.FieldByName("_"); ok {
 			tag = field.Tag
 		}
-		return buildStruct(value, buf, tag)
+		return b.buildStruct(value, buf, tag)
 	case "list":
-		return buildList(value, buf, tag)
+		return b.buildList(value, buf, tag)
 	case "map":
-		return buildMap(value, buf, tag)
+		return b.buildMap(value, buf, tag)
 	default:
 		return buildScalar(origVal, buf, tag)
 	}
 }
 
-func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func (b jsonBuilder) buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	if !value.IsValid() {
 		return nil
 	}
@@ -137,7 +156,7 @@ func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag)
 		writeString(name, buf)
 		buf.WriteString(`:`)
 
-		err := buildAny(member, buf, field.Tag)
+		err := b.buildAny(member, buf, field.Tag)
 		if err != nil {
 			return err
 		}
@@ -149,11 +168,11 @@ func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag)
 	return nil
 }
 
-func buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func (b jsonBuilder) buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	buf.WriteString("[")
 
 	for i := 0; i < value.Len(); i++ {
-		buildAny(value.Index(i), buf, "")
+		b.buildAny(value.Index(i), buf, "")
 
 		if i < value.Len()-1 {
 			buf.WriteString(",")
@@ -171,7 +190,7 @@ func (sv sortedValues) Len() int           { return len(sv) }
 func (sv sortedValues) Swap(i, j int)      { sv[i], sv[j] = sv[j], sv[i] }
 func (sv sortedValues) Less(i, j int) bool { return sv[i].String() < sv[j].String() }
 
-func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
+func (b jsonBuilder) buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	buf.WriteString("{")
 
 	sv := sortedValues(value.MapKeys())
@@ -185,7 +204,7 @@ func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 		writeString(k.String(), buf)
 		buf.WriteString(`:`)
 
-		buildAny(value.MapIndex(k), buf, "")
+		b.buildAny(value.MapIndex(k), buf, "")
 	}
 
 	buf.WriteString("}")
@@ -216,40 +235,13 @@ func buildScalar(v reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) erro
 	default:
 		switch converted := value.Interface().(type) {
 		case time.Time:
-			format := tag.Get("timestampFormat")
-			if len(format) == 0 {
-				format = protocol.UnixTimeFormatName
-			}
-
-			ts := protocol.FormatTime(format, converted)
-			if format != protocol.UnixTimeFormatName {
-				ts = `"` + ts + `"`
-			}
-
-			buf.WriteString(ts)
+			NewEncoder(buf).Time(converted, tag.Get("timestampFormat"))
 		case []byte:
 			if !value.IsNil() {
-				buf.WriteByte('"')
-				if len(converted) < 1024 {
-					// for small buffers, using Encode directly is much faster.
-					dst := make([]byte, base64.StdEncoding.EncodedLen(len(converted)))
-					base64.StdEncoding.Encode(dst, converted)
-					buf.Write(dst)
-				} else {
-					// for large buffers, avoid unnecessary extra temporary
-					// buffer space.
-					enc := base64.NewEncoder(base64.StdEncoding, buf)
-					enc.Write(converted)
-					enc.Close()
-				}
-				buf.WriteByte('"')
+				NewEncoder(buf).Blob(converted)
 			}
 		case aws.JSONValue:
-			str, err := protocol.EncodeJSONValue(converted, protocol.QuotedEscape)
-			if err != nil {
-				return fmt.Errorf("unable to encode JSONValue, %v", err)
-			}
-			buf.WriteString(str)
+			return NewEncoder(buf).JSONValue(converted)
 		default:
 			return fmt.Errorf("unsupported JSON value %v (%s)", value.Interface(), value.Type())
 		}
@@ -0,0 +1,282 @@
+package jsonutil
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// Unmarshaler is implemented by shapes with generated JSON deserializers.
+// UnmarshalJSON will use the shape's UnmarshalJSONFields method instead of
+// reflection to deserialize the shape.
+//
+// UnmarshalJSONFields is called after the opening brace of the shape's JSON
+// object has been read, and must read the object's members, and its closing
+// brace.
+type Unmarshaler interface {
+	UnmarshalJSONFields(d *Decoder) error
+}
+
+// Decoder provides the primitives for generated JSON deserializers to decode
+// shapes without reflection. Values are decoded with the same rules as
+// UnmarshalJSON.
+//
+// Each value method reads the next value of the JSON stream. A null value
+// leaves the destination unchanged.
+type Decoder struct {
+	d *streamDecoder
+}
+
+// ObjectKey reads the key of the next member of the current object. False is
+// returned once the object has no more members, after its closing brace has
+// been read.
+func (d *Decoder) ObjectKey() (string, bool, error) {
+	if !d.d.dec.More() {
+		return "", false, d.d.end()
+	}
+
+	tok, err := d.d.token()
+	if err != nil {
+		return "", false, err
+	}
+	key, _ := tok.(string)
+	return key, true, nil
+}
+
+// ObjectStart reads the opening brace of a structure's JSON object. False is
+// returned if the value is null.
+func (d *Decoder) ObjectStart() (bool, error) {
+	return d.start('{', "JSON value is not a structure")
+}
+
+// MapStart reads the opening brace of a map's JSON object. False is returned
+// if the value is null. The map's entries are read with ObjectKey.
+func (d *Decoder) MapStart() (bool, error) {
+	return d.start('{', "JSON value is not a map")
+}
+
+// ListStart reads the opening bracket of a JSON array. False is returned if
+// the value is null. The array's elements are read while More returns true,
+// followed by ListEnd.
+func (d *Decoder) ListStart() (bool, error) {
+	return d.start('[', "JSON value is not a list")
+}
+
+// More returns if the current array or object has more elements.
+func (d *Decoder) More() bool {
+	return d.d.dec.More()
+}
+
+// ListEnd reads the closing bracket of the current array.
+func (d *Decoder) ListEnd() error {
+	return d.d.end()
+}
+
+func (d *Decoder) start(delim json.Delim, msg string) (bool, error) {
+	tok, err := d.d.token()
+	if err != nil {
+		return false, err
+	}
+	if tok == nil {
+		return false, nil
+	}
+	if tok != delim {
+		return false, d.d.mismatch(tok, msg)
+	}
+	return true, nil
+}
+
+// Err returns the error decoding the JSON stream, if any. Once set, the
+// stream cannot be decoded any further. Invalid map values are ignored
+// unless the stream failed.
+func (d *Decoder) Err() error {
+	return d.d.err
+}
+
+// Skip reads and ignores the next value.
+func (d *Decoder) Skip() error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+	return d.d.skipValue(tok)
+}
+
+// Value reads the next value into v with reflection. v must be a pointer to
+// the value.
+func (d *Decoder) Value(v interface{}) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+	return d.d.unmarshalAny(reflect.ValueOf(v).Elem(), tok, "")
+}
+
+// String reads a string value.
+func (d *Decoder) String(v **string) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case string:
+		*v = &data
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// Bool reads a boolean value.
+func (d *Decoder) Bool(v **bool) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case bool:
+		*v = &data
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// Int64 reads an integer value. Numbers with a fraction or exponent are
+// truncated to an integer.
+func (d *Decoder) Int64(v **int64) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case json.Number:
+		i, err := numberToInt64(data)
+		if err != nil {
+			return err
+		}
+		*v = &i
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// Float64 reads a floating point value.
+func (d *Decoder) Float64(v **float64) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case json.Number:
+		f, err := data.Float64()
+		if err != nil {
+			return err
+		}
+		*v = &f
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// Time reads a timestamp value. Timestamp strings are parsed in the format,
+// defaulting to ISO 8601, and numbers are read as epoch seconds.
+func (d *Decoder) Time(v **time.Time, format string) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case string:
+		if len(format) == 0 {
+			format = protocol.ISO8601TimeFormatName
+		}
+		t, err := protocol.ParseTime(format, data)
+		if err != nil {
+			return err
+		}
+		*v = &t
+	case json.Number:
+		f, err := data.Float64()
+		if err != nil {
+			return err
+		}
+		// Time unmarshaled from a number can only be epoch seconds
+		t := time.Unix(int64(f), 0).UTC()
+		*v = &t
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// Blob reads a base64 encoded string.
+func (d *Decoder) Blob(v *[]byte) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case string:
+		b, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return err
+		}
+		*v = b
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// JSONValue reads a JSON encoded aws.JSONValue string.
+func (d *Decoder) JSONValue(v *aws.JSONValue) error {
+	tok, err := d.d.token()
+	if err != nil {
+		return err
+	}
+
+	switch data := tok.(type) {
+	case nil:
+	case string:
+		// No need to use escaping as the value is a non-quoted string.
+		jv, err := protocol.DecodeJSONValue(data, protocol.NoEscape)
+		if err != nil {
+			return err
+		}
+		*v = jv
+	default:
+		return d.unsupported(tok, *v)
+	}
+	return nil
+}
+
+// unsupported returns the error for the value read not being supported by
+// the destination's type, matching the errors of UnmarshalJSON.
+func (d *Decoder) unsupported(tok json.Token, v interface{}) error {
+	switch tok.(type) {
+	case string, json.Number, bool:
+		return fmt.Errorf("unsupported value: %v (%T)", v, v)
+	default:
+		return d.d.mismatch(tok, "unsupported JSON value")
+	}
+}
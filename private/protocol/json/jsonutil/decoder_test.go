@@ -0,0 +1,270 @@
+package jsonutil_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+type mockUnmarshalerShape struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+
+	Values []*int64 `type:"list"`
+}
+
+func (s *mockUnmarshalerShape) UnmarshalJSONFields(d *jsonutil.Decoder) error {
+	for {
+		key, ok, err := d.ObjectKey()
+		if err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+
+		switch key {
+		case "name":
+			err = d.String(&s.Name)
+		case "values":
+			var ok bool
+			if ok, err = d.ListStart(); err == nil && ok {
+				s.Values = []*int64{}
+				for d.More() {
+					var v *int64
+					if err := d.Int64(&v); err != nil {
+						return err
+					}
+					s.Values = append(s.Values, v)
+				}
+				err = d.ListEnd()
+			}
+		default:
+			err = d.Skip()
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type mockReflectUnmarshalShape struct {
+	_ struct{} `type:"structure"`
+
+	Nested *mockUnmarshalerShape `type:"structure"`
+
+	List []*mockUnmarshalerShape `type:"list"`
+}
+
+func TestUnmarshalJSON_Unmarshaler(t *testing.T) {
+	cases := []struct {
+		Input   string
+		Out     interface{}
+		Expect  interface{}
+		Reflect interface{}
+	}{
+		{
+			Input: `{"name":"abc","Name":"def","values":[1,null,2],"other":{"b":[1]}}`,
+			Out:   &mockUnmarshalerShape{},
+			Expect: &mockUnmarshalerShape{
+				Name: aws.String("abc"), Values: []*int64{aws.Int64(1), nil, aws.Int64(2)},
+			},
+			Reflect: &mockUnmarshalerShape{Name: aws.String("def")},
+		},
+		{
+			Input: `{"Nested":{"name":"abc"},"List":[{"values":[]},null,{"Name":"def"}]}`,
+			Out:   &mockReflectUnmarshalShape{},
+			Expect: &mockReflectUnmarshalShape{
+				Nested: &mockUnmarshalerShape{Name: aws.String("abc")},
+				List: []*mockUnmarshalerShape{
+					{Values: []*int64{}}, nil, {},
+				},
+			},
+			Reflect: &mockReflectUnmarshalShape{
+				Nested: &mockUnmarshalerShape{},
+				List: []*mockUnmarshalerShape{
+					{}, nil, {Name: aws.String("def")},
+				},
+			},
+		},
+	}
+
+	for i, c := range cases {
+		out := reflect.New(reflect.TypeOf(c.Out).Elem()).Interface()
+		err := jsonutil.UnmarshalJSON(out, strings.NewReader(c.Input))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, out; !reflect.DeepEqual(e, a) {
+			t.Errorf("%d, expect %#v, got %#v", i, e, a)
+		}
+
+		out = reflect.New(reflect.TypeOf(c.Out).Elem()).Interface()
+		err = jsonutil.UnmarshalJSONWithReflection(out, strings.NewReader(c.Input))
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Reflect, out; !reflect.DeepEqual(e, a) {
+			t.Errorf("%d, expect %#v, got %#v", i, e, a)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	input := `{"string":"abc","bool":true,"int":1e3,"float":1.5,` +
+		`"unix":1234567890,"iso8601":"2009-02-13T23:31:30Z","blob":"YWJj",` +
+		`"jsonvalue":"{\"a\":\"b\"}","null":null,"value":{"k":[1]}}`
+
+	var actual struct {
+		String    *string
+		Bool      *bool
+		Int       *int64
+		Float     *float64
+		Unix      *time.Time
+		ISO8601   *time.Time
+		Blob      []byte
+		JSONValue aws.JSONValue
+		Null      *string
+		Value     map[string][]*int64
+	}
+
+	shape := decoderFunc(func(d *jsonutil.Decoder) error {
+		for {
+			key, ok, err := d.ObjectKey()
+			if err != nil {
+				return err
+			} else if !ok {
+				return nil
+			}
+
+			switch key {
+			case "string":
+				err = d.String(&actual.String)
+			case "bool":
+				err = d.Bool(&actual.Bool)
+			case "int":
+				err = d.Int64(&actual.Int)
+			case "float":
+				err = d.Float64(&actual.Float)
+			case "unix":
+				err = d.Time(&actual.Unix, "unixTimestamp")
+			case "iso8601":
+				err = d.Time(&actual.ISO8601, "")
+			case "blob":
+				err = d.Blob(&actual.Blob)
+			case "jsonvalue":
+				err = d.JSONValue(&actual.JSONValue)
+			case "null":
+				err = d.String(&actual.Null)
+			case "value":
+				err = d.Value(&actual.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+
+	if err := jsonutil.UnmarshalJSON(&decoderShape{Fields: shape}, strings.NewReader(input)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := "abc", aws.StringValue(actual.String); e != a {
+		t.Errorf("expect %v string, got %v", e, a)
+	}
+	if e, a := true, aws.BoolValue(actual.Bool); e != a {
+		t.Errorf("expect %v bool, got %v", e, a)
+	}
+	if e, a := int64(1000), aws.Int64Value(actual.Int); e != a {
+		t.Errorf("expect %v int, got %v", e, a)
+	}
+	if e, a := 1.5, aws.Float64Value(actual.Float); e != a {
+		t.Errorf("expect %v float, got %v", e, a)
+	}
+	expectTime := time.Unix(1234567890, 0).UTC()
+	if e, a := expectTime, aws.TimeValue(actual.Unix); !e.Equal(a) {
+		t.Errorf("expect %v unix time, got %v", e, a)
+	}
+	if e, a := expectTime, aws.TimeValue(actual.ISO8601); !e.Equal(a) {
+		t.Errorf("expect %v iso8601 time, got %v", e, a)
+	}
+	if e, a := "abc", string(actual.Blob); e != a {
+		t.Errorf("expect %v blob, got %v", e, a)
+	}
+	if e, a := (aws.JSONValue{"a": "b"}), actual.JSONValue; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v jsonvalue, got %v", e, a)
+	}
+	if actual.Null != nil {
+		t.Errorf("expect null value to be unset, got %v", *actual.Null)
+	}
+	if e, a := (map[string][]*int64{"k": {aws.Int64(1)}}), actual.Value; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v value, got %v", e, a)
+	}
+}
+
+func TestDecoder_Errors(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Decode func(*jsonutil.Decoder) error
+		Expect string
+	}{
+		{
+			Input: `{"v":1}`,
+			Decode: func(d *jsonutil.Decoder) error {
+				var v *string
+				return d.String(&v)
+			},
+			Expect: "unsupported value: <nil> (*string)",
+		},
+		{
+			Input: `{"v":[1]}`,
+			Decode: func(d *jsonutil.Decoder) error {
+				_, err := d.ObjectStart()
+				return err
+			},
+			Expect: "JSON value is not a structure",
+		},
+		{
+			Input: `{"v":{"a":1}}`,
+			Decode: func(d *jsonutil.Decoder) error {
+				var v *int64
+				return d.Int64(&v)
+			},
+			Expect: "unsupported JSON value",
+		},
+	}
+
+	for i, c := range cases {
+		shape := decoderFunc(func(d *jsonutil.Decoder) error {
+			if _, _, err := d.ObjectKey(); err != nil {
+				return err
+			}
+			return c.Decode(d)
+		})
+
+		err := jsonutil.UnmarshalJSON(&decoderShape{Fields: shape}, strings.NewReader(c.Input))
+		if err == nil {
+			t.Fatalf("%d, expect error", i)
+		}
+		if e, a := c.Expect, err.Error(); !strings.Contains(a, e) {
+			t.Errorf("%d, expect %q error, got %v", i, e, a)
+		}
+	}
+}
+
+type decoderFunc func(*jsonutil.Decoder) error
+
+// decoderShape is a shape whose fields are deserialized by a function.
+type decoderShape struct {
+	_ struct{} `type:"structure"`
+
+	Fields decoderFunc
+}
+
+func (s *decoderShape) UnmarshalJSONFields(d *jsonutil.Decoder) error {
+	return s.Fields(d)
+}
@@ -0,0 +1,169 @@
+package jsonutil
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// Marshaler is implemented by shapes with generated JSON serializers.
+// BuildJSON will use the shape's MarshalJSONFields method instead of
+// reflection to serialize the shape, and nested shapes implementing the
+// interface.
+type Marshaler interface {
+	MarshalJSONFields(*Encoder) error
+}
+
+// Encoder provides the primitives for generated JSON serializers to encode
+// shapes without reflection. The values are encoded with the same rules as
+// BuildJSON.
+type Encoder struct {
+	buf     *bytes.Buffer
+	first   []bool
+	scratch [64]byte
+}
+
+// NewEncoder returns an Encoder writing to the buffer.
+func NewEncoder(buf *bytes.Buffer) *Encoder {
+	return &Encoder{buf: buf}
+}
+
+// ObjectStart starts a JSON object.
+func (e *Encoder) ObjectStart() {
+	e.buf.WriteByte('{')
+	e.first = append(e.first, true)
+}
+
+// ObjectKey writes the key of the next member of the current object.
+func (e *Encoder) ObjectKey(name string) {
+	e.writeSeparator()
+	writeString(name, e.buf)
+	e.buf.WriteByte(':')
+}
+
+// ObjectEnd ends the current object.
+func (e *Encoder) ObjectEnd() {
+	e.first = e.first[:len(e.first)-1]
+	e.buf.WriteByte('}')
+}
+
+// ListStart starts a JSON array.
+func (e *Encoder) ListStart() {
+	e.buf.WriteByte('[')
+	e.first = append(e.first, true)
+}
+
+// ListElem separates the next element of the current array from the
+// previous element.
+func (e *Encoder) ListElem() {
+	e.writeSeparator()
+}
+
+// ListEnd ends the current array.
+func (e *Encoder) ListEnd() {
+	e.first = e.first[:len(e.first)-1]
+	e.buf.WriteByte(']')
+}
+
+func (e *Encoder) writeSeparator() {
+	i := len(e.first) - 1
+	if e.first[i] {
+		e.first[i] = false
+		return
+	}
+	e.buf.WriteByte(',')
+}
+
+// Null writes a JSON null value.
+func (e *Encoder) Null() {
+	e.buf.WriteString("null")
+}
+
+// String writes a string value.
+func (e *Encoder) String(v string) {
+	writeString(v, e.buf)
+}
+
+// Bool writes a boolean value.
+func (e *Encoder) Bool(v bool) {
+	if v {
+		e.buf.WriteString("true")
+	} else {
+		e.buf.WriteString("false")
+	}
+}
+
+// Int64 writes an integer value.
+func (e *Encoder) Int64(v int64) {
+	e.buf.Write(strconv.AppendInt(e.scratch[:0], v, 10))
+}
+
+// Float64 writes a floating point value. Returns an error if the value is
+// infinity or NaN, which cannot be represented in JSON.
+func (e *Encoder) Float64(v float64) error {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		return &json.UnsupportedValueError{
+			Value: reflect.ValueOf(v), Str: strconv.FormatFloat(v, 'f', -1, 64),
+		}
+	}
+	e.buf.Write(strconv.AppendFloat(e.scratch[:0], v, 'f', -1, 64))
+	return nil
+}
+
+// Time writes a timestamp value in the format. The timestamp is written as a
+// number for the unix timestamp format, and as a string otherwise.
+func (e *Encoder) Time(v time.Time, format string) {
+	if len(format) == 0 {
+		format = protocol.UnixTimeFormatName
+	}
+
+	ts := protocol.FormatTime(format, v)
+	if format != protocol.UnixTimeFormatName {
+		writeString(ts, e.buf)
+		return
+	}
+	e.buf.WriteString(ts)
+}
+
+// Blob writes a base64 encoded string of the bytes.
+func (e *Encoder) Blob(v []byte) {
+	e.buf.WriteByte('"')
+	if len(v) < 1024 {
+		dst := make([]byte, base64.StdEncoding.EncodedLen(len(v)))
+		base64.StdEncoding.Encode(dst, v)
+		e.buf.Write(dst)
+	} else {
+		enc := base64.NewEncoder(base64.StdEncoding, e.buf)
+		enc.Write(v)
+		enc.Close()
+	}
+	e.buf.WriteByte('"')
+}
+
+// JSONValue writes the JSON encoded aws.JSONValue as a string.
+func (e *Encoder) JSONValue(v aws.JSONValue) error {
+	str, err := protocol.EncodeJSONValue(v, protocol.QuotedEscape)
+	if err != nil {
+		return fmt.Errorf("unable to encode JSONValue, %v", err)
+	}
+	e.buf.WriteString(str)
+	return nil
+}
+
+// marshalerOf returns the Marshaler of the value if the value is a non-nil
+// pointer implementing Marshaler.
+func marshalerOf(v reflect.Value) (Marshaler, bool) {
+	if v.Kind() != reflect.Ptr || v.IsNil() || !v.CanInterface() {
+		return nil, false
+	}
+	m, ok := v.Interface().(Marshaler)
+	return m, ok
+}
@@ -0,0 +1,139 @@
+package jsonutil_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+type mockMarshalerShape struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+
+	Values []*int64 `type:"list"`
+}
+
+func (s *mockMarshalerShape) MarshalJSONFields(e *jsonutil.Encoder) error {
+	e.ObjectStart()
+	if s.Name != nil {
+		e.ObjectKey("name")
+		e.String(*s.Name)
+	}
+	if s.Values != nil {
+		e.ObjectKey("values")
+		e.ListStart()
+		for _, v := range s.Values {
+			e.ListElem()
+			e.Int64(*v)
+		}
+		e.ListEnd()
+	}
+	e.ObjectEnd()
+	return nil
+}
+
+type mockReflectShape struct {
+	_ struct{} `type:"structure"`
+
+	Nested *mockMarshalerShape `type:"structure"`
+
+	List []*mockMarshalerShape `type:"list"`
+}
+
+func TestBuildJSON_Marshaler(t *testing.T) {
+	cases := []struct {
+		In      interface{}
+		Expect  string
+		Reflect string
+	}{
+		{
+			In:      &mockMarshalerShape{Name: S("abc"), Values: []*int64{D(1), D(2)}},
+			Expect:  `{"name":"abc","values":[1,2]}`,
+			Reflect: `{"Name":"abc","Values":[1,2]}`,
+		},
+		{
+			In: &mockReflectShape{
+				Nested: &mockMarshalerShape{Name: S("abc")},
+				List:   []*mockMarshalerShape{{Values: []*int64{}}, {Name: S("def")}},
+			},
+			Expect:  `{"Nested":{"name":"abc"},"List":[{"values":[]},{"name":"def"}]}`,
+			Reflect: `{"Nested":{"Name":"abc"},"List":[{"Values":[]},{"Name":"def"}]}`,
+		},
+	}
+
+	for i, c := range cases {
+		b, err := jsonutil.BuildJSON(c.In)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		b, err = jsonutil.BuildJSONWithReflection(c.In)
+		if err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Reflect, string(b); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	e := jsonutil.NewEncoder(&buf)
+
+	e.ObjectStart()
+	e.ObjectKey("string")
+	e.String("a\"b\n")
+	e.ObjectKey("bool")
+	e.Bool(true)
+	e.ObjectKey("float")
+	if err := e.Float64(1.5); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	e.ObjectKey("unix")
+	e.Time(time.Unix(1234567890, 0), "")
+	e.ObjectKey("iso8601")
+	e.Time(time.Unix(1234567890, 0), "iso8601")
+	e.ObjectKey("blob")
+	e.Blob([]byte("abc"))
+	e.ObjectKey("jsonvalue")
+	if err := e.JSONValue(aws.JSONValue{"a": "b"}); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	e.ObjectKey("list")
+	e.ListStart()
+	e.ListElem()
+	e.Null()
+	e.ListElem()
+	e.ObjectStart()
+	e.ObjectEnd()
+	e.ListEnd()
+	e.ObjectEnd()
+
+	expect := `{"string":"a\"b\n","bool":true,"float":1.5,"unix":1234567890,` +
+		`"iso8601":"2009-02-13T23:31:30Z","blob":"YWJj","jsonvalue":"{\"a\":\"b\"}",` +
+		`"list":[null,{}]}`
+	if e, a := expect, buf.String(); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func TestEncoder_InvalidFloat(t *testing.T) {
+	var buf bytes.Buffer
+	e := jsonutil.NewEncoder(&buf)
+
+	if err := e.Float64(math.Inf(1)); err == nil {
+		t.Errorf("expect error for infinity")
+	}
+	if err := e.Float64(math.NaN()); err == nil {
+		t.Errorf("expect error for NaN")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -35,20 +36,97 @@ func UnmarshalJSONError(v interface{}, stream io.Reader) error {
 }
 
 // UnmarshalJSON reads a stream and unmarshals the results in object v.
+//
+// The JSON document is decoded as a stream of tokens, filling the members of
+// v as they are read. The document is not buffered, or decoded into an
+// intermediate representation first.
+//
+// Shapes implementing Unmarshaler are deserialized with their generated
+// deserializers instead of reflection.
 func UnmarshalJSON(v interface{}, stream io.Reader) error {
-	var out interface{}
+	return unmarshalJSON(newStreamDecoder(stream), v)
+}
+
+// UnmarshalJSONWithReflection reads a stream and unmarshals the results in
+// object v, with reflection only. Shapes implementing Unmarshaler are
+// deserialized with reflection instead of their generated deserializers,
+// such as to verify the generated deserializers.
+func UnmarshalJSONWithReflection(v interface{}, stream io.Reader) error {
+	d := newStreamDecoder(stream)
+	d.reflectOnly = true
+
+	return unmarshalJSON(d, v)
+}
 
-	err := json.NewDecoder(stream).Decode(&out)
+func unmarshalJSON(d *streamDecoder, v interface{}) error {
+	tok, err := d.token()
 	if err == io.EOF {
 		return nil
 	} else if err != nil {
 		return err
 	}
 
-	return unmarshalAny(reflect.ValueOf(v), out, "")
+	return d.unmarshalAny(reflect.ValueOf(v), tok, "")
+}
+
+// streamDecoder unmarshals a stream of JSON tokens into the SDK's shapes.
+type streamDecoder struct {
+	dec *json.Decoder
+
+	// err is the error returned by the JSON decoder. Once set, the stream
+	// cannot be decoded any further.
+	err error
+
+	// reflectOnly disables the generated deserializers of shapes.
+	reflectOnly bool
+}
+
+func newStreamDecoder(stream io.Reader) *streamDecoder {
+	dec := json.NewDecoder(stream)
+	dec.UseNumber()
+
+	return &streamDecoder{dec: dec}
+}
+
+func (d *streamDecoder) token() (json.Token, error) {
+	tok, err := d.dec.Token()
+	if err != nil {
+		d.err = err
+	}
+	return tok, err
+}
+
+// skipValue consumes the remaining tokens of the value started by tok.
+func (d *streamDecoder) skipValue(tok json.Token) error {
+	if delim, ok := tok.(json.Delim); !ok || (delim != '{' && delim != '[') {
+		return nil
+	}
+
+	for depth := 1; depth > 0; {
+		tok, err := d.token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+	return nil
+}
+
+// mismatch consumes the value started by tok, and returns the error for the
+// value not being the type expected.
+func (d *streamDecoder) mismatch(tok json.Token, msg string) error {
+	if err := d.skipValue(tok); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s (%#v)", msg, tok)
 }
 
-func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag) error {
+func (d *streamDecoder) unmarshalAny(value reflect.Value, tok json.Token, tag reflect.StructTag) error {
 	vtype := value.Type()
 	if vtype.Kind() == reflect.Ptr {
 		vtype = vtype.Elem() // check kind of actual element type
@@ -80,23 +158,22 @@ func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag)
 		if field, ok := vtype.FieldByName("_"); ok {
 			tag = field.Tag
 		}
-		return unmarshalStruct(value, data, tag)
+		return d.unmarshalStruct(value, tok, tag)
 	case "list":
-		return unmarshalList(value, data, tag)
+		return d.unmarshalList(value, tok)
 	case "map":
-		return unmarshalMap(value, data, tag)
+		return d.unmarshalMap(value, tok)
 	default:
-		return unmarshalScalar(value, data, tag)
+		return d.unmarshalScalar(value, tok, tag)
 	}
 }
 
-func unmarshalStruct(value reflect.Value, data interface{}, tag reflect.StructTag) error {
-	if data == nil {
+func (d *streamDecoder) unmarshalStruct(value reflect.Value, tok json.Token, tag reflect.StructTag) error {
+	if tok == nil {
 		return nil
 	}
-	mapData, ok := data.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("JSON value is not a structure (%#v)", data)
+	if tok != json.Delim('{') {
+		return d.mismatch(tok, "JSON value is not a structure")
 	}
 
 	t := value.Type()
@@ -114,89 +191,191 @@ func unmarshalStruct(value reflect.Value, data interface{}, tag reflect.StructTa
 	// unwrap any payloads
 	if payload := tag.Get("payload"); payload != "" {
 		field, _ := t.FieldByName(payload)
-		return unmarshalAny(value.FieldByName(payload), data, field.Tag)
+		return d.unmarshalAny(value.FieldByName(payload), tok, field.Tag)
 	}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		if field.PkgPath != "" {
-			continue // ignore unexported fields
+	if value.CanAddr() && !d.reflectOnly {
+		if u, ok := value.Addr().Interface().(Unmarshaler); ok {
+			return u.UnmarshalJSONFields(&Decoder{d: d})
 		}
+	}
 
-		// figure out what this field is called
-		name := field.Name
-		if locName := field.Tag.Get("locationName"); locName != "" {
-			name = locName
+	fields := cachedStructFields(t)
+	for d.dec.More() {
+		keyTok, err := d.token()
+		if err != nil {
+			return err
 		}
+		key, _ := keyTok.(string)
 
-		member := value.FieldByIndex(field.Index)
-		err := unmarshalAny(member, mapData[name], field.Tag)
+		valueTok, err := d.token()
 		if err != nil {
 			return err
 		}
+
+		members, ok := fields[key]
+		if !ok {
+			if err := d.skipValue(valueTok); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Fields sharing the same name are unmarshaled from the same
+		// value, which is only decoded once. Each field is set to a copy of
+		// the value so that the fields do not share pointers, slices, or
+		// maps.
+		member := value.FieldByIndex(members[0].Index)
+		if err := d.unmarshalAny(member, valueTok, members[0].Tag); err != nil {
+			return err
+		}
+		for _, field := range members[1:] {
+			value.FieldByIndex(field.Index).Set(deepCopy(member))
+		}
+	}
+
+	return d.end()
+}
+
+// deepCopy returns a copy of the value that does not share pointers,
+// slices, or maps with the value.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMap(v.Type())
+		for _, k := range v.MapKeys() {
+			c.SetMapIndex(k, deepCopy(v.MapIndex(k)))
+		}
+		return c
+
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < c.NumField(); i++ {
+			// Unexported fields, such as of time.Time, are copied by value.
+			if f := c.Field(i); f.CanSet() {
+				f.Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+
+	default:
+		return v
 	}
-	return nil
 }
 
-func unmarshalList(value reflect.Value, data interface{}, tag reflect.StructTag) error {
-	if data == nil {
+func (d *streamDecoder) unmarshalList(value reflect.Value, tok json.Token) error {
+	if tok == nil {
 		return nil
 	}
-	listData, ok := data.([]interface{})
-	if !ok {
-		return fmt.Errorf("JSON value is not a list (%#v)", data)
+	if tok != json.Delim('[') {
+		return d.mismatch(tok, "JSON value is not a list")
 	}
 
-	if value.IsNil() {
-		l := len(listData)
-		value.Set(reflect.MakeSlice(value.Type(), l, l))
+	list := value
+	if list.IsNil() {
+		list = reflect.MakeSlice(value.Type(), 0, 0)
 	}
 
-	for i, c := range listData {
-		err := unmarshalAny(value.Index(i), c, "")
+	for i := 0; d.dec.More(); i++ {
+		elemTok, err := d.token()
 		if err != nil {
 			return err
 		}
+
+		if i >= list.Len() {
+			list = reflect.Append(list, reflect.Zero(list.Type().Elem()))
+		}
+		if err := d.unmarshalAny(list.Index(i), elemTok, ""); err != nil {
+			return err
+		}
 	}
+	value.Set(list)
 
-	return nil
+	return d.end()
 }
 
-func unmarshalMap(value reflect.Value, data interface{}, tag reflect.StructTag) error {
-	if data == nil {
+func (d *streamDecoder) unmarshalMap(value reflect.Value, tok json.Token) error {
+	if tok == nil {
 		return nil
 	}
-	mapData, ok := data.(map[string]interface{})
-	if !ok {
-		return fmt.Errorf("JSON value is not a map (%#v)", data)
+	if tok != json.Delim('{') {
+		return d.mismatch(tok, "JSON value is not a map")
 	}
 
 	if value.IsNil() {
 		value.Set(reflect.MakeMap(value.Type()))
 	}
 
-	for k, v := range mapData {
-		kvalue := reflect.ValueOf(k)
-		vvalue := reflect.New(value.Type().Elem()).Elem()
+	for d.dec.More() {
+		keyTok, err := d.token()
+		if err != nil {
+			return err
+		}
+		key, _ := keyTok.(string)
+
+		elemTok, err := d.token()
+		if err != nil {
+			return err
+		}
 
-		unmarshalAny(vvalue, v, "")
-		value.SetMapIndex(kvalue, vvalue)
+		elem := reflect.New(value.Type().Elem()).Elem()
+		if err := d.unmarshalAny(elem, elemTok, ""); err != nil && d.err != nil {
+			// Only errors decoding the JSON stream are returned, invalid map
+			// values are ignored.
+			return err
+		}
+		value.SetMapIndex(reflect.ValueOf(key), elem)
 	}
 
-	return nil
+	return d.end()
 }
 
-func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTag) error {
+// end consumes the closing delimiter of the current object or array.
+func (d *streamDecoder) end() error {
+	_, err := d.token()
+	return err
+}
 
-	switch d := data.(type) {
+func (d *streamDecoder) unmarshalScalar(value reflect.Value, tok json.Token, tag reflect.StructTag) error {
+	switch data := tok.(type) {
 	case nil:
 		return nil // nothing to do here
 	case string:
 		switch value.Interface().(type) {
 		case *string:
-			value.Set(reflect.ValueOf(&d))
+			value.Set(reflect.ValueOf(&data))
 		case []byte:
-			b, err := base64.StdEncoding.DecodeString(d)
+			b, err := base64.StdEncoding.DecodeString(data)
 			if err != nil {
 				return err
 			}
@@ -207,14 +386,14 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 				format = protocol.ISO8601TimeFormatName
 			}
 
-			t, err := protocol.ParseTime(format, d)
+			t, err := protocol.ParseTime(format, data)
 			if err != nil {
 				return err
 			}
 			value.Set(reflect.ValueOf(&t))
 		case aws.JSONValue:
 			// No need to use escaping as the value is a non-quoted string.
-			v, err := protocol.DecodeJSONValue(d, protocol.NoEscape)
+			v, err := protocol.DecodeJSONValue(data, protocol.NoEscape)
 			if err != nil {
 				return err
 			}
@@ -222,16 +401,27 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 		default:
 			return fmt.Errorf("unsupported value: %v (%s)", value.Interface(), value.Type())
 		}
-	case float64:
+	case json.Number:
 		switch value.Interface().(type) {
 		case *int64:
-			di := int64(d)
-			value.Set(reflect.ValueOf(&di))
+			i, err := numberToInt64(data)
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&i))
 		case *float64:
-			value.Set(reflect.ValueOf(&d))
+			f, err := data.Float64()
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&f))
 		case *time.Time:
-			// Time unmarshaled from a float64 can only be epoch seconds
-			t := time.Unix(int64(d), 0).UTC()
+			f, err := data.Float64()
+			if err != nil {
+				return err
+			}
+			// Time unmarshaled from a number can only be epoch seconds
+			t := time.Unix(int64(f), 0).UTC()
 			value.Set(reflect.ValueOf(&t))
 		default:
 			return fmt.Errorf("unsupported value: %v (%s)", value.Interface(), value.Type())
@@ -239,12 +429,65 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 	case bool:
 		switch value.Interface().(type) {
 		case *bool:
-			value.Set(reflect.ValueOf(&d))
+			value.Set(reflect.ValueOf(&data))
 		default:
 			return fmt.Errorf("unsupported value: %v (%s)", value.Interface(), value.Type())
 		}
 	default:
-		return fmt.Errorf("unsupported JSON value (%v)", data)
+		return d.mismatch(tok, "unsupported JSON value")
 	}
 	return nil
 }
+
+// numberToInt64 returns the integer value of the number. Numbers with a
+// fraction or exponent are truncated to an integer.
+func numberToInt64(n json.Number) (int64, error) {
+	if i, err := n.Int64(); err == nil {
+		return i, nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return 0, err
+	}
+	return int64(f), nil
+}
+
+// structFieldCache caches the exported fields of structure types by the
+// member name the fields are unmarshaled from.
+var structFieldCache = struct {
+	sync.RWMutex
+	fields map[reflect.Type]map[string][]reflect.StructField
+}{
+	fields: map[reflect.Type]map[string][]reflect.StructField{},
+}
+
+func cachedStructFields(t reflect.Type) map[string][]reflect.StructField {
+	structFieldCache.RLock()
+	fields, ok := structFieldCache.fields[t]
+	structFieldCache.RUnlock()
+	if ok {
+		return fields
+	}
+
+	fields = map[string][]reflect.StructField{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+
+		// figure out what this field is called
+		name := field.Name
+		if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+		fields[name] = append(fields[name], field)
+	}
+
+	structFieldCache.Lock()
+	structFieldCache.fields[t] = fields
+	structFieldCache.Unlock()
+
+	return fields
+}
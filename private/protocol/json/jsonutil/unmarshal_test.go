@@ -0,0 +1,271 @@
+// +build go1.7
+
+package jsonutil_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+)
+
+type unmarshalNested struct {
+	_ struct{} `type:"structure"`
+
+	Name  *string `locationName:"name" type:"string"`
+	Count *int64  `type:"integer"`
+}
+
+type unmarshalShape struct {
+	_ struct{} `type:"structure"`
+
+	String    *string                     `type:"string"`
+	Int       *int64                      `type:"integer"`
+	Float     *float64                    `type:"double"`
+	Bool      *bool                       `type:"boolean"`
+	Blob      []byte                      `type:"blob"`
+	Time      *time.Time                  `type:"timestamp"`
+	ISOTime   *time.Time                  `type:"timestamp" timestampFormat:"iso8601"`
+	Value     aws.JSONValue               `type:"jsonvalue"`
+	Nested    *unmarshalNested            `type:"structure"`
+	List      []*unmarshalNested          `type:"list"`
+	Map       map[string]*int64           `type:"map"`
+	NestedMap map[string]*unmarshalNested `type:"map"`
+}
+
+type unmarshalPayloadShape struct {
+	_ struct{} `type:"structure" payload:"Body"`
+
+	Body *unmarshalNested `type:"structure"`
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	cases := map[string]struct {
+		Input  string
+		Expect unmarshalShape
+	}{
+		"empty document": {
+			Input: ``,
+		},
+		"scalars": {
+			Input: `{"String":"abc","Int":123,"Float":1.5,"Bool":true,` +
+				`"Blob":"AQID","Time":1.5e9,"ISOTime":"2019-01-02T03:04:05Z",` +
+				`"Value":"{\"k\":\"v\"}"}`,
+			Expect: unmarshalShape{
+				String:  aws.String("abc"),
+				Int:     aws.Int64(123),
+				Float:   aws.Float64(1.5),
+				Bool:    aws.Bool(true),
+				Blob:    []byte{1, 2, 3},
+				Time:    aws.Time(time.Unix(1500000000, 0).UTC()),
+				ISOTime: aws.Time(time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)),
+				Value:   aws.JSONValue{"k": "v"},
+			},
+		},
+		"large integer": {
+			Input:  `{"Int":9007199254740993}`,
+			Expect: unmarshalShape{Int: aws.Int64(9007199254740993)},
+		},
+		"integer with exponent": {
+			Input:  `{"Int":1e3}`,
+			Expect: unmarshalShape{Int: aws.Int64(1000)},
+		},
+		"nested shapes": {
+			Input: `{"Nested":{"name":"a","Count":1},` +
+				`"List":[{"name":"b"},null,{"Count":2}],` +
+				`"NestedMap":{"c":{"name":"c"}}}`,
+			Expect: unmarshalShape{
+				Nested: &unmarshalNested{Name: aws.String("a"), Count: aws.Int64(1)},
+				List: []*unmarshalNested{
+					{Name: aws.String("b")}, nil, {Count: aws.Int64(2)},
+				},
+				NestedMap: map[string]*unmarshalNested{
+					"c": {Name: aws.String("c")},
+				},
+			},
+		},
+		"empty list": {
+			Input:  `{"List":[]}`,
+			Expect: unmarshalShape{List: []*unmarshalNested{}},
+		},
+		"null members": {
+			Input: `{"String":null,"Nested":null,"List":null,"Map":null}`,
+		},
+		"unknown members skipped": {
+			Input: `{"Unknown":{"a":[1,{"b":[]}],"c":"d"},"Other":[[],{}],"String":"abc"}`,
+			Expect: unmarshalShape{
+				String: aws.String("abc"),
+			},
+		},
+		"invalid map values ignored": {
+			Input: `{"Map":{"a":1,"b":"two","c":{"d":[3]},"e":4}}`,
+			Expect: unmarshalShape{
+				Map: map[string]*int64{
+					"a": aws.Int64(1), "b": nil, "c": nil, "e": aws.Int64(4),
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var actual unmarshalShape
+			err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(c.Input))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.Expect, actual; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %#v, got %#v", e, a)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON_Errors(t *testing.T) {
+	cases := map[string]struct {
+		Input     string
+		ExpectErr string
+	}{
+		"not a structure": {
+			Input:     `{"Nested":["a"]}`,
+			ExpectErr: "JSON value is not a structure",
+		},
+		"not a list": {
+			Input:     `{"List":{"a":"b"}}`,
+			ExpectErr: "JSON value is not a list",
+		},
+		"not a map": {
+			Input:     `{"Map":[1]}`,
+			ExpectErr: "JSON value is not a map",
+		},
+		"mismatched scalar": {
+			Input:     `{"String":1}`,
+			ExpectErr: "unsupported value",
+		},
+		"invalid blob": {
+			Input:     `{"Blob":"!!"}`,
+			ExpectErr: "illegal base64 data",
+		},
+		"truncated document": {
+			Input: `{"Nested":{"name":"a"`,
+		},
+		"syntax error in map": {
+			Input: `{"Map":{"a":1,"b":}}`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var actual unmarshalShape
+			err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(c.Input))
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect %q error, got %q", e, a)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON_Payload(t *testing.T) {
+	var actual unmarshalPayloadShape
+	err := jsonutil.UnmarshalJSON(&actual, strings.NewReader(`{"name":"abc","Count":1}`))
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	expect := &unmarshalNested{Name: aws.String("abc"), Count: aws.Int64(1)}
+	if e, a := expect, actual.Body; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %#v, got %#v", e, a)
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"List":[`)
+	for i := 0; i < 1000; i++ {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(`{"name":"abcdefghijklmnopqrstuvwxyz","Count":12345,"Unknown":[1,2,3]}`)
+	}
+	buf.WriteString(`]}`)
+	body := buf.Bytes()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var v unmarshalShape
+		if err := jsonutil.UnmarshalJSON(&v, bytes.NewReader(body)); err != nil {
+			b.Fatalf("expect no error, got %v", err)
+		}
+	}
+}
+
+type unmarshalSharedNameShape struct {
+	_ struct{} `type:"structure"`
+
+	Nested      *unmarshalNested            `locationName:"shared" type:"structure"`
+	OtherNested *unmarshalNested            `locationName:"shared" type:"structure"`
+	List        []*unmarshalNested          `locationName:"list" type:"list"`
+	OtherList   []*unmarshalNested          `locationName:"list" type:"list"`
+	Map         map[string]*unmarshalNested `locationName:"map" type:"map"`
+	OtherMap    map[string]*unmarshalNested `locationName:"map" type:"map"`
+	Value       aws.JSONValue               `locationName:"value" type:"jsonvalue"`
+	OtherValue  aws.JSONValue               `locationName:"value" type:"jsonvalue"`
+}
+
+func TestUnmarshalJSON_SharedName(t *testing.T) {
+	body := `{"shared":{"name":"a"},"list":[{"name":"b"}],"map":{"k":{"name":"c"}},"value":"{\"k\":[1]}"}`
+
+	var v unmarshalSharedNameShape
+	if err := jsonutil.UnmarshalJSON(&v, strings.NewReader(body)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	if e, a := v.Nested, v.OtherNested; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := v.List, v.OtherList; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := v.Map, v.OtherMap; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := v.Value, v.OtherValue; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+
+	// The fields must not share values.
+	*v.Nested.Name = "x"
+	*v.List[0].Name = "x"
+	v.List[0] = nil
+	v.Map["k"].Name = aws.String("x")
+	v.Map["other"] = nil
+	v.Value["k"].([]interface{})[0] = "x"
+
+	if e, a := "a", *v.OtherNested.Name; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if v.OtherList[0] == nil {
+		t.Fatalf("expect other list element not to be modified")
+	}
+	if e, a := "b", *v.OtherList[0].Name; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "c", *v.OtherMap["k"].Name; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := 1, len(v.OtherMap); e != a {
+		t.Errorf("expect %v map entries, got %v", e, a)
+	}
+	if e, a := float64(1), v.OtherValue["k"].([]interface{})[0]; e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
@@ -13,6 +13,16 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
+// Marshaler is implemented by shapes with generated query serializers.
+// Parse will use the shape's MarshalQueryFields method instead of reflection
+// to serialize the shape, and nested shapes implementing the interface.
+//
+// The generated serializers encode the shape for the query sub-protocol of
+// the shape's service, either Query or EC2 Query.
+type Marshaler interface {
+	MarshalQueryFields(v url.Values, prefix string) error
+}
+
 // Parse parses an object i and fills a url.Values object. The isEC2 flag
 // indicates if this is the EC2 Query sub-protocol.
 func Parse(body url.Values, i interface{}, isEC2 bool) error {
@@ -20,6 +30,24 @@ func Parse(body url.Values, i interface{}, isEC2 bool) error {
 	return q.parseValue(body, reflect.ValueOf(i), "", "")
 }
 
+// ParseWithReflection parses an object i and fills a url.Values object, with
+// reflection only. Shapes implementing Marshaler are serialized with
+// reflection instead of their generated serializers, such as to verify the
+// generated serializers.
+func ParseWithReflection(body url.Values, i interface{}, isEC2 bool) error {
+	q := queryParser{isEC2: isEC2, reflectOnly: true}
+	return q.parseValue(body, reflect.ValueOf(i), "", "")
+}
+
+// JoinName returns the query parameter name of the member nested within the
+// prefix.
+func JoinName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
 func elemOf(value reflect.Value) reflect.Value {
 	for value.Kind() == reflect.Ptr {
 		value = value.Elem()
@@ -29,6 +57,9 @@ func elemOf(value reflect.Value) reflect.Value {
 
 type queryParser struct {
 	isEC2 bool
+
+	// reflectOnly disables the generated serializers of shapes.
+	reflectOnly bool
 }
 
 func (q *queryParser) parseValue(v url.Values, value reflect.Value, prefix string, tag reflect.StructTag) error {
@@ -53,6 +84,11 @@ func (q *queryParser) parseValue(v url.Values, value reflect.Value, prefix strin
 
 	switch t {
 	case "structure":
+		if value.CanAddr() && !q.reflectOnly {
+			if m, ok := value.Addr().Interface().(Marshaler); ok {
+				return m.MarshalQueryFields(v, prefix)
+			}
+		}
 		return q.parseStruct(v, value, prefix)
 	case "list":
 		return q.parseList(v, value, prefix, tag)
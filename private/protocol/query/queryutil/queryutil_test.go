@@ -0,0 +1,82 @@
+package queryutil_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
+)
+
+type mockMarshalerShape struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `type:"string"`
+}
+
+func (s *mockMarshalerShape) MarshalQueryFields(v url.Values, prefix string) error {
+	if s.Name != nil {
+		v.Set(queryutil.JoinName(prefix, "CustomName"), *s.Name)
+	}
+	return nil
+}
+
+type mockReflectShape struct {
+	_ struct{} `type:"structure"`
+
+	Nested *mockMarshalerShape `type:"structure"`
+
+	List []*mockMarshalerShape `type:"list"`
+}
+
+func TestParse_Marshaler(t *testing.T) {
+	cases := []struct {
+		In      interface{}
+		Expect  string
+		Reflect string
+	}{
+		{
+			In:      &mockMarshalerShape{Name: aString("abc")},
+			Expect:  "CustomName=abc",
+			Reflect: "Name=abc",
+		},
+		{
+			In: &mockReflectShape{
+				Nested: &mockMarshalerShape{Name: aString("abc")},
+				List:   []*mockMarshalerShape{{Name: aString("def")}},
+			},
+			Expect:  "List.member.1.CustomName=def&Nested.CustomName=abc",
+			Reflect: "List.member.1.Name=def&Nested.Name=abc",
+		},
+	}
+
+	for i, c := range cases {
+		v := url.Values{}
+		if err := queryutil.Parse(v, c.In, false); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, v.Encode(); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		v = url.Values{}
+		if err := queryutil.ParseWithReflection(v, c.In, false); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Reflect, v.Encode(); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestJoinName(t *testing.T) {
+	if e, a := "Name", queryutil.JoinName("", "Name"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+	if e, a := "Prefix.Name", queryutil.JoinName("Prefix", "Name"); e != a {
+		t.Errorf("expect %v, got %v", e, a)
+	}
+}
+
+func aString(v string) *string {
+	return &v
+}
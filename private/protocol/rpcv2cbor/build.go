@@ -0,0 +1,208 @@
+package rpcv2cbor
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+var timeType = reflect.ValueOf(time.Time{}).Type()
+
+// BuildCBOR builds the CBOR encoded value of the shape v.
+func BuildCBOR(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := buildAny(reflect.ValueOf(v), encoder{buf: &buf}, "")
+	return buf.Bytes(), err
+}
+
+func buildAny(value reflect.Value, e encoder, tag reflect.StructTag) error {
+	value = reflect.Indirect(value)
+	if !value.IsValid() {
+		e.Null()
+		return nil
+	}
+
+	vtype := value.Type()
+
+	t := tag.Get("type")
+	if t == "" {
+		switch vtype.Kind() {
+		case reflect.Struct:
+			// also it can't be a time object
+			if value.Type() != timeType {
+				t = "structure"
+			}
+		case reflect.Slice:
+			// also it can't be a byte slice
+			if _, ok := value.Interface().([]byte); !ok {
+				t = "list"
+			}
+		case reflect.Map:
+			// cannot be a JSONValue map
+			if _, ok := value.Interface().(aws.JSONValue); !ok {
+				t = "map"
+			}
+		}
+	}
+
+	switch t {
+	case "structure":
+		return buildStruct(value, e)
+	case "list":
+		return buildList(value, e)
+	case "map":
+		return buildMap(value, e)
+	default:
+		return buildScalar(value, e)
+	}
+}
+
+func buildStruct(value reflect.Value, e encoder) error {
+	type member struct {
+		name  string
+		value reflect.Value
+		tag   reflect.StructTag
+	}
+
+	t := value.Type()
+	members := make([]member, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+		if field.Tag.Get("location") != "" {
+			continue // ignore non-body elements
+		}
+		if field.Tag.Get("ignore") != "" {
+			continue
+		}
+
+		m := value.Field(i)
+		if protocol.CanSetIdempotencyToken(m, field) {
+			token := protocol.GetIdempotencyToken()
+			m = reflect.ValueOf(&token)
+		}
+
+		if (m.Kind() == reflect.Ptr || m.Kind() == reflect.Slice || m.Kind() == reflect.Map) && m.IsNil() {
+			continue // ignore unset fields
+		}
+
+		name := field.Name
+		if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+		members = append(members, member{name: name, value: m, tag: field.Tag})
+	}
+
+	e.Map(len(members))
+	for _, m := range members {
+		e.String(m.name)
+		if err := buildAny(m.value, e, m.tag); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// buildList builds the list's members. Nil members of sparse lists are
+// built as null.
+func buildList(value reflect.Value, e encoder) error {
+	e.List(value.Len())
+	for i := 0; i < value.Len(); i++ {
+		if err := buildAny(value.Index(i), e, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type sortedValues []reflect.Value
+
+func (sv sortedValues) Len() int           { return len(sv) }
+func (sv sortedValues) Swap(i, j int)      { sv[i], sv[j] = sv[j], sv[i] }
+func (sv sortedValues) Less(i, j int) bool { return sv[i].String() < sv[j].String() }
+
+// buildMap builds the map's entries sorted by key. Nil values of sparse maps
+// are built as null.
+func buildMap(value reflect.Value, e encoder) error {
+	sv := sortedValues(value.MapKeys())
+	sort.Sort(sv)
+
+	e.Map(len(sv))
+	for _, k := range sv {
+		e.String(k.String())
+		if err := buildAny(value.MapIndex(k), e, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func buildScalar(value reflect.Value, e encoder) error {
+	switch value.Kind() {
+	case reflect.String:
+		e.String(value.String())
+	case reflect.Bool:
+		e.Bool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		e.Int(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		e.Uint(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		e.Float(value.Float())
+	default:
+		switch converted := value.Interface().(type) {
+		case time.Time:
+			e.Time(converted)
+		case []byte:
+			e.Bytes(converted)
+		case aws.JSONValue:
+			return buildDocument(map[string]interface{}(converted), e)
+		default:
+			return fmt.Errorf("unsupported CBOR value %v (%s)", value.Interface(), value.Type())
+		}
+	}
+	return nil
+}
+
+// buildDocument builds the untyped value of a JSON value shape.
+func buildDocument(v interface{}, e encoder) error {
+	switch tv := v.(type) {
+	case nil:
+		e.Null()
+	case map[string]interface{}:
+		keys := make([]string, 0, len(tv))
+		for k := range tv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		e.Map(len(keys))
+		for _, k := range keys {
+			e.String(k)
+			if err := buildDocument(tv[k], e); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		e.List(len(tv))
+		for _, item := range tv {
+			if err := buildDocument(item, e); err != nil {
+				return err
+			}
+		}
+	default:
+		return buildAny(reflect.ValueOf(v), e, "")
+	}
+	return nil
+}
@@ -0,0 +1,488 @@
+package rpcv2cbor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/internal/sdkmath"
+)
+
+// CBOR major types, RFC 7049.
+const (
+	majorUint   byte = 0
+	majorNegInt byte = 1
+	majorBytes  byte = 2
+	majorString byte = 3
+	majorList   byte = 4
+	majorMap    byte = 5
+	majorTag    byte = 6
+	majorSimple byte = 7
+)
+
+// CBOR tags of the values used by the protocol.
+const (
+	tagEpochTime       = 1
+	tagPosBignum       = 2
+	tagNegBignum       = 3
+	tagDecimalFraction = 4
+)
+
+// CBOR simple values, and the additional information of floats and
+// indefinite length items.
+const (
+	simpleFalse     = 20
+	simpleTrue      = 21
+	simpleNull      = 22
+	simpleUndefined = 23
+	infoFloat16     = 25
+	infoFloat32     = 26
+	infoFloat64     = 27
+	infoIndefinite  = 31
+	breakCode       = 0xff
+)
+
+// maxDepth is the maximum depth of nested lists, maps, and tags decoded.
+const maxDepth = 10000
+
+// encoder writes CBOR encoded values to a buffer.
+type encoder struct {
+	buf *bytes.Buffer
+}
+
+// head writes the initial byte and argument of an item of the major type.
+func (e encoder) head(major byte, n uint64) {
+	var b [9]byte
+	switch {
+	case n < 24:
+		e.buf.WriteByte(major<<5 | byte(n))
+		return
+	case n <= math.MaxUint8:
+		b[0], b[1] = major<<5|24, byte(n)
+		e.buf.Write(b[:2])
+	case n <= math.MaxUint16:
+		b[0] = major<<5 | 25
+		binary.BigEndian.PutUint16(b[1:], uint16(n))
+		e.buf.Write(b[:3])
+	case n <= math.MaxUint32:
+		b[0] = major<<5 | 26
+		binary.BigEndian.PutUint32(b[1:], uint32(n))
+		e.buf.Write(b[:5])
+	default:
+		b[0] = major<<5 | 27
+		binary.BigEndian.PutUint64(b[1:], n)
+		e.buf.Write(b[:9])
+	}
+}
+
+func (e encoder) Int(v int64) {
+	if v < 0 {
+		e.head(majorNegInt, uint64(-(v + 1)))
+		return
+	}
+	e.head(majorUint, uint64(v))
+}
+
+func (e encoder) Uint(v uint64) {
+	e.head(majorUint, v)
+}
+
+func (e encoder) Float(v float64) {
+	var b [9]byte
+	b[0] = majorSimple<<5 | infoFloat64
+	binary.BigEndian.PutUint64(b[1:], math.Float64bits(v))
+	e.buf.Write(b[:])
+}
+
+func (e encoder) Bool(v bool) {
+	if v {
+		e.buf.WriteByte(majorSimple<<5 | simpleTrue)
+	} else {
+		e.buf.WriteByte(majorSimple<<5 | simpleFalse)
+	}
+}
+
+func (e encoder) Null() {
+	e.buf.WriteByte(majorSimple<<5 | simpleNull)
+}
+
+func (e encoder) String(v string) {
+	e.head(majorString, uint64(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e encoder) Bytes(v []byte) {
+	e.head(majorBytes, uint64(len(v)))
+	e.buf.Write(v)
+}
+
+// Time writes the time as epoch seconds, tagged as an epoch based date/time.
+func (e encoder) Time(v time.Time) {
+	e.head(majorTag, tagEpochTime)
+	e.Float(float64(v.Unix()) + float64(v.Nanosecond())/float64(time.Second))
+}
+
+func (e encoder) List(n int) {
+	e.head(majorList, uint64(n))
+}
+
+func (e encoder) Map(n int) {
+	e.head(majorMap, uint64(n))
+}
+
+// decoder decodes CBOR encoded values into Go values. Integers are decoded as
+// int64, or *big.Int if out of range of int64. Floats and decimal fractions
+// are decoded as float64, epoch based date/times as time.Time, text strings
+// as string, byte strings as []byte, lists as []interface{}, and maps as
+// map[string]interface{}. Null and undefined are decoded as nil.
+type decoder struct {
+	b   []byte
+	off int
+}
+
+// decodeCBOR decodes the CBOR encoded value read from the reader. Returns
+// nil if the reader is empty.
+func decodeCBOR(r io.Reader) (interface{}, error) {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, r); err != nil {
+		return nil, err
+	}
+	if buf.Len() == 0 {
+		return nil, nil
+	}
+
+	d := decoder{b: buf.Bytes()}
+	v, err := d.value(0)
+	if err != nil {
+		return nil, err
+	}
+	if d.off != len(d.b) {
+		return nil, fmt.Errorf("unexpected %d bytes after CBOR value", len(d.b)-d.off)
+	}
+	return v, nil
+}
+
+// head reads the initial byte and argument of the next item. Returns the
+// major type, additional information, and argument. The argument of
+// indefinite length items is zero.
+func (d *decoder) head() (major, info byte, n uint64, err error) {
+	if d.off >= len(d.b) {
+		return 0, 0, 0, io.ErrUnexpectedEOF
+	}
+	major, info = d.b[d.off]>>5, d.b[d.off]&0x1f
+	d.off++
+
+	var size int
+	switch {
+	case info < 24:
+		return major, info, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	case info == infoIndefinite:
+		switch major {
+		case majorBytes, majorString, majorList, majorMap:
+			return major, info, 0, nil
+		case majorSimple:
+			return 0, 0, 0, fmt.Errorf("unexpected CBOR break")
+		}
+		fallthrough
+	default:
+		return 0, 0, 0, fmt.Errorf("invalid CBOR additional information %d, major type %d", info, major)
+	}
+
+	if len(d.b)-d.off < size {
+		return 0, 0, 0, io.ErrUnexpectedEOF
+	}
+	p := d.b[d.off : d.off+size]
+	d.off += size
+	switch size {
+	case 1:
+		n = uint64(p[0])
+	case 2:
+		n = uint64(binary.BigEndian.Uint16(p))
+	case 4:
+		n = uint64(binary.BigEndian.Uint32(p))
+	default:
+		n = binary.BigEndian.Uint64(p)
+	}
+	return major, info, n, nil
+}
+
+// isBreak returns if the next byte is the break stop code of an indefinite
+// length item, and consumes it.
+func (d *decoder) isBreak() (bool, error) {
+	if d.off >= len(d.b) {
+		return false, io.ErrUnexpectedEOF
+	}
+	if d.b[d.off] == breakCode {
+		d.off++
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *decoder) value(depth int) (interface{}, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("CBOR value exceeds max depth %d", maxDepth)
+	}
+
+	major, info, n, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+
+	switch major {
+	case majorUint:
+		if n > math.MaxInt64 {
+			return new(big.Int).SetUint64(n), nil
+		}
+		return int64(n), nil
+	case majorNegInt:
+		if n > math.MaxInt64 {
+			v := new(big.Int).SetUint64(n)
+			return v.Neg(v).Sub(v, bigOne), nil
+		}
+		return -1 - int64(n), nil
+	case majorBytes, majorString:
+		b, err := d.bytes(major, info, n)
+		if err != nil {
+			return nil, err
+		}
+		if major == majorBytes {
+			return b, nil
+		}
+		if !utf8.Valid(b) {
+			return nil, fmt.Errorf("invalid UTF-8 CBOR text string")
+		}
+		return string(b), nil
+	case majorList:
+		return d.list(info, n, depth)
+	case majorMap:
+		return d.object(info, n, depth)
+	case majorTag:
+		return d.tag(n, depth)
+	default:
+		return d.simple(info, n)
+	}
+}
+
+// bytes reads the contents of a byte or text string, concatenating the
+// chunks of indefinite length strings.
+func (d *decoder) bytes(major, info byte, n uint64) ([]byte, error) {
+	if info != infoIndefinite {
+		if n > uint64(len(d.b)-d.off) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		b := make([]byte, n)
+		copy(b, d.b[d.off:])
+		d.off += int(n)
+		return b, nil
+	}
+
+	b := []byte{}
+	for {
+		if brk, err := d.isBreak(); err != nil {
+			return nil, err
+		} else if brk {
+			return b, nil
+		}
+
+		chunkMajor, chunkInfo, chunkLen, err := d.head()
+		if err != nil {
+			return nil, err
+		}
+		if chunkMajor != major || chunkInfo == infoIndefinite {
+			return nil, fmt.Errorf("invalid CBOR indefinite length string chunk")
+		}
+		chunk, err := d.bytes(chunkMajor, chunkInfo, chunkLen)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, chunk...)
+	}
+}
+
+func (d *decoder) list(info byte, n uint64, depth int) (interface{}, error) {
+	if info != infoIndefinite && n > uint64(len(d.b)-d.off) {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	l := make([]interface{}, 0, n)
+	for i := uint64(0); info == infoIndefinite || i < n; i++ {
+		if info == infoIndefinite {
+			if brk, err := d.isBreak(); err != nil {
+				return nil, err
+			} else if brk {
+				break
+			}
+		}
+
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, v)
+	}
+	return l, nil
+}
+
+func (d *decoder) object(info byte, n uint64, depth int) (interface{}, error) {
+	if info != infoIndefinite && n > uint64(len(d.b)-d.off)/2 {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	m := make(map[string]interface{}, n)
+	for i := uint64(0); info == infoIndefinite || i < n; i++ {
+		if info == infoIndefinite {
+			if brk, err := d.isBreak(); err != nil {
+				return nil, err
+			} else if brk {
+				break
+			}
+		}
+
+		k, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("unsupported CBOR map key type %T", k)
+		}
+
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, nil
+}
+
+func (d *decoder) tag(tag uint64, depth int) (interface{}, error) {
+	v, err := d.value(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagEpochTime:
+		return epochTime(v)
+	case tagPosBignum, tagNegBignum:
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("invalid CBOR bignum type %T", v)
+		}
+		i := new(big.Int).SetBytes(b)
+		if tag == tagNegBignum {
+			i.Neg(i).Sub(i, bigOne)
+		}
+		return bigIntValue(i), nil
+	case tagDecimalFraction:
+		l, ok := v.([]interface{})
+		if !ok || len(l) != 2 {
+			return nil, fmt.Errorf("invalid CBOR decimal fraction")
+		}
+		exp, ok := l[0].(int64)
+		if !ok {
+			return nil, fmt.Errorf("invalid CBOR decimal fraction exponent type %T", l[0])
+		}
+		var mantissa string
+		switch m := l[1].(type) {
+		case int64:
+			mantissa = strconv.FormatInt(m, 10)
+		case *big.Int:
+			mantissa = m.String()
+		default:
+			return nil, fmt.Errorf("invalid CBOR decimal fraction mantissa type %T", l[1])
+		}
+		return strconv.ParseFloat(mantissa+"e"+strconv.FormatInt(exp, 10), 64)
+	default:
+		// Unknown tags are ignored, and the tagged value is used.
+		return v, nil
+	}
+}
+
+// epochTime returns the time of the epoch seconds, rounded to milliseconds.
+func epochTime(v interface{}) (time.Time, error) {
+	switch t := v.(type) {
+	case int64:
+		return time.Unix(t, 0).UTC(), nil
+	case float64:
+		if math.IsNaN(t) || math.IsInf(t, 0) {
+			return time.Time{}, fmt.Errorf("invalid CBOR epoch time %v", t)
+		}
+		sec, frac := math.Modf(t)
+		frac = sdkmath.Round(frac*1e3) / 1e3 // Rounds 0.1229999 to 0.123
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid CBOR epoch time type %T", v)
+	}
+}
+
+var (
+	bigOne      = big.NewInt(1)
+	bigMinInt64 = big.NewInt(math.MinInt64)
+	bigMaxInt64 = big.NewInt(math.MaxInt64)
+)
+
+// bigIntValue returns the integer as an int64 if it is in range of int64.
+func bigIntValue(i *big.Int) interface{} {
+	if i.Cmp(bigMinInt64) >= 0 && i.Cmp(bigMaxInt64) <= 0 {
+		return i.Int64()
+	}
+	return i
+}
+
+func (d *decoder) simple(info byte, n uint64) (interface{}, error) {
+	switch info {
+	case simpleFalse:
+		return false, nil
+	case simpleTrue:
+		return true, nil
+	case simpleNull, simpleUndefined:
+		return nil, nil
+	case infoFloat16:
+		return float16ToFloat64(uint16(n)), nil
+	case infoFloat32:
+		return float64(math.Float32frombits(uint32(n))), nil
+	case infoFloat64:
+		return math.Float64frombits(n), nil
+	default:
+		return nil, fmt.Errorf("unsupported CBOR simple value %d", n)
+	}
+}
+
+// float16ToFloat64 converts an IEEE 754 half-precision float to a float64.
+func float16ToFloat64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h>>10) & 0x1f
+	frac := float64(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(frac, -24)
+	case 0x1f:
+		if frac != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	default:
+		return sign * math.Ldexp(frac+1024, exp-25)
+	}
+}
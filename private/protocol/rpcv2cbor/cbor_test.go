@@ -0,0 +1,180 @@
+// +build go1.7
+
+package rpcv2cbor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEncoder(t *testing.T) {
+	cases := map[string]struct {
+		Encode func(encoder)
+		Expect string
+	}{
+		"small uint":     {func(e encoder) { e.Int(23) }, "17"},
+		"uint8":          {func(e encoder) { e.Int(24) }, "1818"},
+		"uint16":         {func(e encoder) { e.Int(256) }, "190100"},
+		"uint32":         {func(e encoder) { e.Int(65536) }, "1a00010000"},
+		"uint64":         {func(e encoder) { e.Int(math.MaxInt64) }, "1b7fffffffffffffff"},
+		"negative":       {func(e encoder) { e.Int(-1) }, "20"},
+		"negative int64": {func(e encoder) { e.Int(math.MinInt64) }, "3b7fffffffffffffff"},
+		"float":          {func(e encoder) { e.Float(1.1) }, "fb3ff199999999999a"},
+		"bool":           {func(e encoder) { e.Bool(true); e.Bool(false) }, "f5f4"},
+		"null":           {func(e encoder) { e.Null() }, "f6"},
+		"string":         {func(e encoder) { e.String("IETF") }, "6449455446"},
+		"bytes":          {func(e encoder) { e.Bytes([]byte{1, 2, 3, 4}) }, "4401020304"},
+		"time": {
+			func(e encoder) { e.Time(time.Unix(1363896240, 5e8)) },
+			"c1fb41d452d9ec200000",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			c.Encode(encoder{buf: &buf})
+			if e, a := c.Expect, hex.EncodeToString(buf.Bytes()); e != a {
+				t.Errorf("expect %v, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	bigUint, _ := new(big.Int).SetString("18446744073709551615", 10)
+	bigNeg, _ := new(big.Int).SetString("-18446744073709551616", 10)
+	bigTag, _ := new(big.Int).SetString("18446744073709551616", 10)
+
+	cases := map[string]struct {
+		Input  string
+		Expect interface{}
+	}{
+		"uint":              {"1903e8", int64(1000)},
+		"negative":          {"3903e7", int64(-1000)},
+		"uint64 overflow":   {"1bffffffffffffffff", bigUint},
+		"negative overflow": {"3bffffffffffffffff", bigNeg},
+		"half float":        {"f93c00", 1.0},
+		"half float small":  {"f90001", 5.960464477539063e-8},
+		"half float inf":    {"f97c00", math.Inf(1)},
+		"single float":      {"fa47c35000", 100000.0},
+		"double float":      {"fb3ff199999999999a", 1.1},
+		"true":              {"f5", true},
+		"null":              {"f6", nil},
+		"undefined":         {"f7", nil},
+		"string":            {"6449455446", "IETF"},
+		"indefinite string": {"7f657374726561646d696e67ff", "streaming"},
+		"bytes":             {"4401020304", []byte{1, 2, 3, 4}},
+		"indefinite bytes":  {"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+		"list":              {"83010203", []interface{}{int64(1), int64(2), int64(3)}},
+		"indefinite list":   {"9f018202039f0405ffff", []interface{}{int64(1), []interface{}{int64(2), int64(3)}, []interface{}{int64(4), int64(5)}}},
+		"map":               {"a26161016162820203", map[string]interface{}{"a": int64(1), "b": []interface{}{int64(2), int64(3)}}},
+		"indefinite map":    {"bf6346756ef563416d7421ff", map[string]interface{}{"Fun": true, "Amt": int64(-2)}},
+		"sparse map":        {"a16161f6", map[string]interface{}{"a": nil}},
+		"epoch time":        {"c11a514b67b0", time.Unix(1363896240, 0).UTC()},
+		"epoch time float":  {"c1fb41d452d9ec200000", time.Unix(1363896240, 5e8).UTC()},
+		"bignum":            {"c249010000000000000000", bigTag},
+		"small bignum":      {"c2420100", int64(256)},
+		"negative bignum":   {"c3420100", int64(-257)},
+		"decimal fraction":  {"c48221196ab3", 273.15},
+		"unknown tag":       {"d8206968747470733a2f2f61", "https://a"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, _ := hex.DecodeString(c.Input)
+			v, err := decodeCBOR(bytes.NewReader(b))
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, ok := c.Expect.(*big.Int); ok {
+				a, ok := v.(*big.Int)
+				if !ok || e.Cmp(a) != 0 {
+					t.Errorf("expect %v, got %#v", e, v)
+				}
+				return
+			}
+			if e, a := c.Expect, v; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %#v, got %#v", e, a)
+			}
+		})
+	}
+}
+
+func TestDecodeCBOR_Invalid(t *testing.T) {
+	cases := map[string]struct {
+		Input     string
+		ExpectErr string
+	}{
+		"truncated":             {"19", "unexpected EOF"},
+		"truncated string":      {"6449", "unexpected EOF"},
+		"truncated list":        {"83", "unexpected EOF"},
+		"trailing bytes":        {"0101", "after CBOR value"},
+		"unexpected break":      {"ff", "unexpected CBOR break"},
+		"reserved info":         {"1c", "invalid CBOR additional information"},
+		"indefinite uint":       {"1f", "invalid CBOR additional information"},
+		"non-string map key":    {"a10101", "map key"},
+		"invalid chunk":         {"7f4101ff", "indefinite length string chunk"},
+		"invalid utf8":          {"62c328", "UTF-8"},
+		"invalid epoch time":    {"c16161", "epoch time"},
+		"unterminated list":     {"9f01", "unexpected EOF"},
+		"invalid simple":        {"f0", "simple value"},
+		"list length too large": {"9bffffffffffffffff", "unexpected EOF"},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, _ := hex.DecodeString(c.Input)
+			_, err := decodeCBOR(bytes.NewReader(b))
+			if err == nil {
+				t.Fatalf("expect error, got none")
+			}
+			if e, a := c.ExpectErr, err.Error(); !strings.Contains(a, e) {
+				t.Errorf("expect %q error, got %v", e, a)
+			}
+		})
+	}
+}
+
+func TestDecodeCBOR_MaxDepth(t *testing.T) {
+	b := bytes.Repeat([]byte{0x81}, maxDepth+2)
+	b = append(b, 0x01)
+
+	_, err := decodeCBOR(bytes.NewReader(b))
+	if err == nil {
+		t.Fatalf("expect error, got none")
+	}
+	if e, a := "max depth", err.Error(); !strings.Contains(a, e) {
+		t.Errorf("expect %q error, got %v", e, a)
+	}
+}
+
+func TestUnmarshalCBOR_TypeMismatch(t *testing.T) {
+	type shape struct {
+		_ struct{} `type:"structure"`
+
+		Count *int64 `type:"long"`
+	}
+
+	cases := map[string]string{
+		"string for long": "a165436f756e746161",
+		"long overflow":   "a165436f756e74c249010000000000000000",
+		"not structure":   "8101",
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			b, _ := hex.DecodeString(input)
+			if err := UnmarshalCBOR(&shape{}, bytes.NewReader(b)); err == nil {
+				t.Errorf("expect error, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package rpcv2cbor provides Smithy RPC v2 CBOR protocol utilities for
+// serialization of AWS requests and responses.
+//
+// Requests are sent with the POST method to the operation's path,
+// "/service/{ServiceName}/operation/{OperationName}", where ServiceName is
+// the client's TargetPrefix. The request and response bodies are the CBOR
+// encoded input and output shapes.
+package rpcv2cbor
+
+//go:generate go run -tags codegen ../../../models/protocol_tests/generate.go ../../../models/protocol_tests/input/rpcv2cbor.json build_test.go
+//go:generate go run -tags codegen ../../../models/protocol_tests/generate.go ../../../models/protocol_tests/output/rpcv2cbor.json unmarshal_test.go
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol/rest"
+)
+
+const (
+	// ProtocolHeader is the header identifying the protocol of requests and
+	// responses.
+	ProtocolHeader = "Smithy-Protocol"
+
+	// ProtocolName is the value of the ProtocolHeader.
+	ProtocolName = "rpc-v2-cbor"
+
+	// ContentType is the media type of CBOR request and response bodies.
+	ContentType = "application/cbor"
+)
+
+// BuildHandler is a named request handler for building rpcv2cbor protocol
+// requests
+var BuildHandler = request.NamedHandler{
+	Name: "awssdk.rpcv2cbor.Build",
+	Fn:   Build,
+}
+
+// UnmarshalHandler is a named request handler for unmarshaling rpcv2cbor
+// protocol requests
+var UnmarshalHandler = request.NamedHandler{
+	Name: "awssdk.rpcv2cbor.Unmarshal",
+	Fn:   Unmarshal,
+}
+
+// UnmarshalMetaHandler is a named request handler for unmarshaling rpcv2cbor
+// protocol request metadata
+var UnmarshalMetaHandler = request.NamedHandler{
+	Name: "awssdk.rpcv2cbor.UnmarshalMeta",
+	Fn:   UnmarshalMeta,
+}
+
+// Build builds a CBOR payload for a RPC v2 CBOR request. Requests of
+// operations without input members are sent without a body.
+func Build(req *request.Request) {
+	u := req.HTTPRequest.URL
+	u.Path = strings.TrimSuffix(u.Path, "/") +
+		"/service/" + req.ClientInfo.TargetPrefix +
+		"/operation/" + req.Operation.Name
+	u.RawPath = ""
+
+	req.HTTPRequest.Header.Set(ProtocolHeader, ProtocolName)
+	req.HTTPRequest.Header.Set("Accept", ContentType)
+
+	if !req.ParamsFilled() || !hasBodyMembers(req.Params) {
+		return
+	}
+
+	buf, err := BuildCBOR(req.Params)
+	if err != nil {
+		req.Error = awserr.New(request.ErrCodeSerialization, "failed encoding RPC v2 CBOR request", err)
+		return
+	}
+	req.SetBufferBody(buf)
+	req.HTTPRequest.Header.Set("Content-Type", ContentType)
+}
+
+// hasBodyMembers returns if the input shape has any members.
+func hasBodyMembers(params interface{}) bool {
+	t := reflect.Indirect(reflect.ValueOf(params)).Type()
+	if t.Kind() != reflect.Struct {
+		return true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Unmarshal unmarshals a response for a RPC v2 CBOR service.
+func Unmarshal(req *request.Request) {
+	defer req.HTTPResponse.Body.Close()
+	if req.DataFilled() {
+		err := UnmarshalCBOR(req.Data, req.HTTPResponse.Body)
+		if err != nil {
+			req.Error = awserr.NewRequestFailure(
+				awserr.New(request.ErrCodeSerialization, "failed decoding RPC v2 CBOR response", err),
+				req.HTTPResponse.StatusCode,
+				req.RequestID,
+			)
+		}
+	}
+}
+
+// UnmarshalMeta unmarshals headers from a response for a RPC v2 CBOR
+// service.
+func UnmarshalMeta(req *request.Request) {
+	rest.UnmarshalMeta(req)
+}
@@ -0,0 +1,238 @@
+package rpcv2cbor
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+// UnmarshalCBOR reads the CBOR encoded value from the reader, and unmarshals
+// it into the shape v. Members of v not in the value are not modified, and
+// values not modeled by v are ignored.
+func UnmarshalCBOR(v interface{}, r io.Reader) error {
+	doc, err := decodeCBOR(r)
+	if err != nil {
+		return err
+	}
+	if doc == nil {
+		return nil
+	}
+
+	return unmarshalAny(reflect.ValueOf(v), doc, "")
+}
+
+func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag) error {
+	vtype := value.Type()
+	if vtype.Kind() == reflect.Ptr {
+		vtype = vtype.Elem() // check kind of actual element type
+	}
+
+	t := tag.Get("type")
+	if t == "" {
+		switch vtype.Kind() {
+		case reflect.Struct:
+			// also it can't be a time object
+			if _, ok := value.Interface().(*time.Time); !ok {
+				t = "structure"
+			}
+		case reflect.Slice:
+			// also it can't be a byte slice
+			if _, ok := value.Interface().([]byte); !ok {
+				t = "list"
+			}
+		case reflect.Map:
+			// cannot be a JSONValue map
+			if _, ok := value.Interface().(aws.JSONValue); !ok {
+				t = "map"
+			}
+		}
+	}
+
+	switch t {
+	case "structure":
+		return unmarshalStruct(value, data)
+	case "list":
+		return unmarshalList(value, data)
+	case "map":
+		return unmarshalMap(value, data)
+	default:
+		return unmarshalScalar(value, data)
+	}
+}
+
+func unmarshalStruct(value reflect.Value, data interface{}) error {
+	if data == nil {
+		return nil
+	}
+	mapData, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("CBOR value is not a structure (%T)", data)
+	}
+
+	t := value.Type()
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() { // create the structure if it's nil
+			s := reflect.New(value.Type().Elem())
+			value.Set(s)
+			value = s
+		}
+
+		value = value.Elem()
+		t = t.Elem()
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // ignore unexported fields
+		}
+		if field.Tag.Get("json") == "-" {
+			continue
+		}
+		if field.Tag.Get("location") != "" {
+			continue // ignore non-body elements
+		}
+
+		// figure out what this field is called
+		name := field.Name
+		if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+
+		member, ok := mapData[name]
+		if !ok {
+			continue
+		}
+		if err := unmarshalAny(value.FieldByIndex(field.Index), member, field.Tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalList(value reflect.Value, data interface{}) error {
+	if data == nil {
+		return nil
+	}
+	listData, ok := data.([]interface{})
+	if !ok {
+		return fmt.Errorf("CBOR value is not a list (%T)", data)
+	}
+
+	if value.IsNil() {
+		l := len(listData)
+		value.Set(reflect.MakeSlice(value.Type(), l, l))
+	}
+
+	for i, c := range listData {
+		if err := unmarshalAny(value.Index(i), c, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unmarshalMap unmarshals the map's entries. Null values of sparse maps are
+// unmarshaled as nil.
+func unmarshalMap(value reflect.Value, data interface{}) error {
+	if data == nil {
+		return nil
+	}
+	mapData, ok := data.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("CBOR value is not a map (%T)", data)
+	}
+
+	if value.IsNil() {
+		value.Set(reflect.MakeMap(value.Type()))
+	}
+
+	for k, v := range mapData {
+		kvalue := reflect.ValueOf(k)
+		vvalue := reflect.New(value.Type().Elem()).Elem()
+
+		if err := unmarshalAny(vvalue, v, ""); err != nil {
+			return err
+		}
+		value.SetMapIndex(kvalue, vvalue)
+	}
+
+	return nil
+}
+
+func unmarshalScalar(value reflect.Value, data interface{}) error {
+	if data == nil {
+		return nil // nothing to do for null values
+	}
+
+	switch value.Interface().(type) {
+	case *string:
+		s, ok := data.(string)
+		if !ok {
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(&s))
+	case []byte:
+		b, ok := data.([]byte)
+		if !ok {
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(b))
+	case *bool:
+		b, ok := data.(bool)
+		if !ok {
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(&b))
+	case *int64:
+		i, ok := data.(int64)
+		if !ok {
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(&i))
+	case *float64:
+		var f float64
+		switch d := data.(type) {
+		case float64:
+			f = d
+		case int64:
+			f = float64(d)
+		case *big.Int:
+			f, _ = new(big.Float).SetInt(d).Float64()
+		default:
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(&f))
+	case *time.Time:
+		t, ok := data.(time.Time)
+		if !ok {
+			var err error
+			if t, err = epochTime(data); err != nil {
+				return err
+			}
+		}
+		value.Set(reflect.ValueOf(&t))
+	case aws.JSONValue:
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return unmarshalTypeError(data, value)
+		}
+		value.Set(reflect.ValueOf(aws.JSONValue(m)))
+	default:
+		return fmt.Errorf("unsupported CBOR value %v (%s)", value.Interface(), value.Type())
+	}
+
+	return nil
+}
+
+func unmarshalTypeError(data interface{}, value reflect.Value) error {
+	if i, ok := data.(*big.Int); ok {
+		return fmt.Errorf("CBOR integer %v overflows %s", i, value.Type())
+	}
+	return fmt.Errorf("unsupported CBOR value type %T for %s", data, value.Type())
+}
@@ -0,0 +1,117 @@
+package rpcv2cbor
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+// UnmarshalTypedError provides unmarshaling errors API response errors
+// for both typed and untyped errors.
+type UnmarshalTypedError struct {
+	exceptions map[string]func(protocol.ResponseMetadata) error
+}
+
+// NewUnmarshalTypedError returns an UnmarshalTypedError initialized for the
+// set of exception names to the error unmarshalers
+func NewUnmarshalTypedError(exceptions map[string]func(protocol.ResponseMetadata) error) *UnmarshalTypedError {
+	return &UnmarshalTypedError{
+		exceptions: exceptions,
+	}
+}
+
+// UnmarshalError attempts to unmarshal the HTTP response error as a known
+// error type. If unable to unmarshal the error type, the generic SDK error
+// type will be used.
+func (u *UnmarshalTypedError) UnmarshalError(
+	resp *http.Response,
+	respMeta protocol.ResponseMetadata,
+) (error, error) {
+
+	var buf bytes.Buffer
+	code, msg, err := unmarshalErrorResponse(io.TeeReader(resp.Body, &buf))
+	if err != nil {
+		return nil, err
+	}
+
+	if fn, ok := u.exceptions[code]; ok {
+		// If exception code is know, use associated constructor to get a value
+		// for the exception that the CBOR body can be unmarshaled into.
+		v := fn(respMeta)
+		if err := UnmarshalCBOR(v, &buf); err != nil {
+			return nil, err
+		}
+
+		return v, nil
+	}
+
+	// fallback to unmodeled generic exceptions
+	return awserr.NewRequestFailure(
+		awserr.New(code, msg, nil),
+		respMeta.StatusCode,
+		respMeta.RequestID,
+	), nil
+}
+
+// UnmarshalErrorHandler is a named request handler for unmarshaling
+// rpcv2cbor protocol request errors
+var UnmarshalErrorHandler = request.NamedHandler{
+	Name: "awssdk.rpcv2cbor.UnmarshalError",
+	Fn:   UnmarshalError,
+}
+
+// UnmarshalError unmarshals an error response for a RPC v2 CBOR service.
+func UnmarshalError(req *request.Request) {
+	defer req.HTTPResponse.Body.Close()
+
+	code, msg, err := unmarshalErrorResponse(req.HTTPResponse.Body)
+	if err != nil {
+		req.Error = awserr.NewRequestFailure(
+			awserr.New(request.ErrCodeSerialization,
+				"failed to unmarshal error message", err),
+			req.HTTPResponse.StatusCode,
+			req.RequestID,
+		)
+		return
+	}
+
+	req.Error = awserr.NewRequestFailure(
+		awserr.New(code, msg, nil),
+		req.HTTPResponse.StatusCode,
+		req.RequestID,
+	)
+}
+
+type errorResponse struct {
+	Code         *string `locationName:"__type" type:"string"`
+	Message      *string `locationName:"message" type:"string"`
+	MessageUpper *string `locationName:"Message" type:"string"`
+}
+
+// unmarshalErrorResponse returns the error code and message of the error
+// response. The code is the shape name of the __type member, without the
+// namespace.
+func unmarshalErrorResponse(r io.Reader) (code, msg string, err error) {
+	var errResp errorResponse
+	if err := UnmarshalCBOR(&errResp, r); err != nil {
+		return "", "", err
+	}
+
+	// Code may be separated by hash(#), with the last element being the code
+	// used by the SDK.
+	codeParts := strings.SplitN(aws.StringValue(errResp.Code), "#", 2)
+	code = codeParts[len(codeParts)-1]
+
+	msg = aws.StringValue(errResp.Message)
+	if len(msg) == 0 {
+		msg = aws.StringValue(errResp.MessageUpper)
+	}
+
+	return code, msg, nil
+}
@@ -0,0 +1,178 @@
+// +build go1.7
+
+package rpcv2cbor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/private/protocol"
+)
+
+type SimpleError struct {
+	_ struct{} `type:"structure"`
+	error
+
+	RespMetadata protocol.ResponseMetadata `json:"-" xml:"-"`
+
+	Message2 *string `type:"string" locationName:"message"`
+	Foo      *int64  `type:"integer" locationName:"foo"`
+}
+
+type ComplexError struct {
+	_ struct{} `type:"structure"`
+	error
+
+	Message2 *string      `type:"string" locationName:"message"`
+	Foo      *ErrorNested `type:"structure" locationName:"foo"`
+}
+
+type ErrorNested struct {
+	_ struct{} `type:"structure"`
+
+	Bar *string `type:"string" locationName:"bar"`
+	Baz *int64  `type:"integer" locationName:"baz"`
+}
+
+func cborBody(t *testing.T, v aws.JSONValue) *http.Response {
+	b, err := BuildCBOR(v)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	return &http.Response{
+		StatusCode: 400,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(b)),
+	}
+}
+
+func TestUnmarshalTypedError(t *testing.T) {
+	respMeta := protocol.ResponseMetadata{
+		StatusCode: 400,
+		RequestID:  "abc123",
+	}
+
+	exceptions := map[string]func(protocol.ResponseMetadata) error{
+		"SimpleError": func(meta protocol.ResponseMetadata) error {
+			return &SimpleError{RespMetadata: meta}
+		},
+		"ComplexError": func(meta protocol.ResponseMetadata) error {
+			return &ComplexError{}
+		},
+	}
+
+	cases := map[string]struct {
+		Response *http.Response
+		Expect   error
+		Err      string
+	}{
+		"simple error": {
+			Response: cborBody(t, aws.JSONValue{
+				"__type": "SimpleError", "message": "some message", "foo": 123,
+			}),
+			Expect: &SimpleError{
+				RespMetadata: respMeta,
+				Message2:     aws.String("some message"),
+				Foo:          aws.Int64(123),
+			},
+		},
+		"namespaced code": {
+			Response: cborBody(t, aws.JSONValue{
+				"__type": "smithy.example#SimpleError", "message": "some message",
+			}),
+			Expect: &SimpleError{
+				RespMetadata: respMeta,
+				Message2:     aws.String("some message"),
+			},
+		},
+		"complex error": {
+			Response: cborBody(t, aws.JSONValue{
+				"__type":  "ComplexError",
+				"message": "some message",
+				"foo":     map[string]interface{}{"bar": "abc123", "baz": 123},
+			}),
+			Expect: &ComplexError{
+				Message2: aws.String("some message"),
+				Foo: &ErrorNested{
+					Bar: aws.String("abc123"),
+					Baz: aws.Int64(123),
+				},
+			},
+		},
+		"unknown error": {
+			Response: cborBody(t, aws.JSONValue{
+				"__type": "UnknownError", "Message": "error message",
+			}),
+			Expect: awserr.NewRequestFailure(
+				awserr.New("UnknownError", "error message", nil),
+				respMeta.StatusCode,
+				respMeta.RequestID,
+			),
+		},
+		"invalid error": {
+			Response: &http.Response{
+				StatusCode: 400,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte{0xa1})),
+			},
+			Err: "unexpected EOF",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := NewUnmarshalTypedError(exceptions)
+			v, err := u.UnmarshalError(c.Response, respMeta)
+
+			if len(c.Err) != 0 {
+				if err == nil {
+					t.Fatalf("expect error, got none")
+				}
+				if e, a := c.Err, err.Error(); !strings.Contains(a, e) {
+					t.Fatalf("expect %v in error, got %v", e, a)
+				}
+			} else if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+
+			if e, a := c.Expect, v; !reflect.DeepEqual(e, a) {
+				t.Errorf("expect %+#v, got %#+v", e, a)
+			}
+		})
+	}
+}
+
+func TestUnmarshalError(t *testing.T) {
+	req := &request.Request{
+		HTTPResponse: cborBody(t, aws.JSONValue{
+			"__type": "aws.example#ThrottlingException", "message": "slow down",
+		}),
+		RequestID: "abc123",
+	}
+
+	UnmarshalError(req)
+
+	aerr, ok := req.Error.(awserr.RequestFailure)
+	if !ok {
+		t.Fatalf("expect request failure, got %T", req.Error)
+	}
+	if e, a := "ThrottlingException", aerr.Code(); e != a {
+		t.Errorf("expect %v code, got %v", e, a)
+	}
+	if e, a := "slow down", aerr.Message(); e != a {
+		t.Errorf("expect %v message, got %v", e, a)
+	}
+	if e, a := 400, aerr.StatusCode(); e != a {
+		t.Errorf("expect %v status code, got %v", e, a)
+	}
+	if e, a := "abc123", aerr.RequestID(); e != a {
+		t.Errorf("expect %v request ID, got %v", e, a)
+	}
+}
@@ -13,24 +13,48 @@ import (
 	"github.com/aws/aws-sdk-go/private/protocol"
 )
 
+// Marshaler is implemented by shapes with generated XML serializers.
+// BuildXML will use the shape's MarshalXMLFields method instead of
+// reflection to serialize the shape's members to the shape's XML element.
+type Marshaler interface {
+	MarshalXMLFields(node *XMLNode) error
+}
+
 // BuildXML will serialize params into an xml.Encoder. Error will be returned
 // if the serialization of any of the params or nested values fails.
+//
+// Shapes implementing Marshaler are serialized with their generated
+// serializers instead of reflection.
 func BuildXML(params interface{}, e *xml.Encoder) error {
 	return buildXML(params, e, false)
 }
 
-func buildXML(params interface{}, e *xml.Encoder, sorted bool) error {
-	b := xmlBuilder{encoder: e, namespaces: map[string]string{}}
-	root := NewXMLElement(xml.Name{})
-	if err := b.buildValue(reflect.ValueOf(params), root, ""); err != nil {
+// BuildXMLWithReflection will serialize params into an xml.Encoder, with
+// reflection only. Shapes implementing Marshaler are serialized with
+// reflection instead of their generated serializers, such as to verify the
+// generated serializers.
+func BuildXMLWithReflection(params interface{}, e *xml.Encoder) error {
+	node, err := newXMLBuilder(true).buildNode(params)
+	if err != nil || node == nil {
 		return err
 	}
-	for _, c := range root.Children {
-		for _, v := range c {
-			return StructToXML(e, v, sorted)
-		}
+	return StructToXML(e, node, false)
+}
+
+func buildXML(params interface{}, e *xml.Encoder, sorted bool) error {
+	node, err := BuildXMLNode(params)
+	if err != nil || node == nil {
+		return err
 	}
-	return nil
+	return StructToXML(e, node, sorted)
+}
+
+// BuildXMLNode returns the XMLNode of the params' body members, without
+// encoding it. The node's name is the locationName of the params' shape, and
+// is empty if the shape does not have one. Nil is returned if params does not
+// have any body members.
+func BuildXMLNode(params interface{}) (*XMLNode, error) {
+	return newXMLBuilder(false).buildNode(params)
 }
 
 // Returns the reflection element of a value, if it is a pointer.
@@ -43,8 +67,28 @@ func elemOf(value reflect.Value) reflect.Value {
 
 // A xmlBuilder serializes values from Go code to XML
 type xmlBuilder struct {
-	encoder    *xml.Encoder
 	namespaces map[string]string
+
+	// reflectOnly disables the generated serializers of shapes.
+	reflectOnly bool
+}
+
+func newXMLBuilder(reflectOnly bool) *xmlBuilder {
+	return &xmlBuilder{namespaces: map[string]string{}, reflectOnly: reflectOnly}
+}
+
+// buildNode returns the XMLNode of the params' body members.
+func (b *xmlBuilder) buildNode(params interface{}) (*XMLNode, error) {
+	root := NewXMLElement(xml.Name{})
+	if err := b.buildValue(reflect.ValueOf(params), root, ""); err != nil {
+		return nil, err
+	}
+	for _, c := range root.Children {
+		for _, v := range c {
+			return v, nil
+		}
+	}
+	return nil, nil
 }
 
 // buildValue generic XMLNode builder for any type. Will build value for their specific type
@@ -121,6 +165,16 @@ func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag refl
 		child.Attr = append(child.Attr, ns)
 	}
 
+	if value.CanAddr() && !b.reflectOnly {
+		if m, ok := value.Addr().Interface().(Marshaler); ok {
+			if err := m.MarshalXMLFields(child); err != nil {
+				return err
+			}
+			current.AddChild(child)
+			return nil
+		}
+	}
+
 	var payloadFields, nonPayloadFields int
 
 	t := value.Type()
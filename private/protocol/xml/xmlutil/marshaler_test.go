@@ -0,0 +1,146 @@
+package xmlutil
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+type mockMarshalerShape struct {
+	_ struct{} `type:"structure" locationName:"Shape"`
+
+	Name *string `type:"string"`
+}
+
+func (s *mockMarshalerShape) MarshalXMLFields(node *XMLNode) error {
+	if s.Name != nil {
+		node.AddChild(&XMLNode{Name: xml.Name{Local: "CustomName"}, Text: *s.Name})
+	}
+	return nil
+}
+
+func (s *mockMarshalerShape) UnmarshalXMLFields(node *XMLNode) error {
+	for _, n := range node.Elems("CustomName") {
+		s.Name = &n.Text
+	}
+	return nil
+}
+
+type mockReflectShape struct {
+	_ struct{} `type:"structure" locationName:"Reflect"`
+
+	Nested *mockMarshalerShape `type:"structure"`
+
+	List []*mockMarshalerShape `type:"list"`
+}
+
+func TestBuildXML_Marshaler(t *testing.T) {
+	cases := []struct {
+		In      interface{}
+		Expect  string
+		Reflect string
+	}{
+		{
+			In:      &mockMarshalerShape{Name: aws.String("abc")},
+			Expect:  `<Shape><CustomName>abc</CustomName></Shape>`,
+			Reflect: `<Shape><Name>abc</Name></Shape>`,
+		},
+		{
+			In: &mockReflectShape{
+				Nested: &mockMarshalerShape{Name: aws.String("abc")},
+			},
+			Expect:  `<Reflect><Nested><CustomName>abc</CustomName></Nested></Reflect>`,
+			Reflect: `<Reflect><Nested><Name>abc</Name></Nested></Reflect>`,
+		},
+		{
+			In: &mockReflectShape{
+				List: []*mockMarshalerShape{{Name: aws.String("def")}},
+			},
+			Expect:  `<Reflect><List><member><Shape><CustomName>def</CustomName></Shape></member></List></Reflect>`,
+			Reflect: `<Reflect><List><member><Shape><Name>def</Name></Shape></member></List></Reflect>`,
+		},
+	}
+
+	for i, c := range cases {
+		var buf bytes.Buffer
+		if err := BuildXML(c.In, xml.NewEncoder(&buf)); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Expect, buf.String(); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+
+		buf.Reset()
+		if err := BuildXMLWithReflection(c.In, xml.NewEncoder(&buf)); err != nil {
+			t.Fatalf("%d, expect no error, got %v", i, err)
+		}
+		if e, a := c.Reflect, buf.String(); e != a {
+			t.Errorf("%d, expect %v, got %v", i, e, a)
+		}
+	}
+}
+
+func TestUnmarshalXML_Unmarshaler(t *testing.T) {
+	const body = `<Reflect><Nested><CustomName>abc</CustomName><Name>def</Name></Nested>` +
+		`<List><member><CustomName>ghi</CustomName></member></List></Reflect>`
+
+	expect := &mockReflectShape{
+		Nested: &mockMarshalerShape{Name: aws.String("abc")},
+		List:   []*mockMarshalerShape{{Name: aws.String("ghi")}},
+	}
+	actual := &mockReflectShape{}
+	if err := UnmarshalXML(actual, xml.NewDecoder(strings.NewReader(body)), ""); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !reflect.DeepEqual(expect, actual) {
+		t.Errorf("expect %#v, got %#v", expect, actual)
+	}
+
+	expect = &mockReflectShape{
+		Nested: &mockMarshalerShape{Name: aws.String("def")},
+		List:   []*mockMarshalerShape{{}},
+	}
+	actual = &mockReflectShape{}
+	err := UnmarshalXMLWithReflection(actual, xml.NewDecoder(strings.NewReader(body)), "")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !reflect.DeepEqual(expect, actual) {
+		t.Errorf("expect %#v, got %#v", expect, actual)
+	}
+}
+
+func TestXMLNodeElems(t *testing.T) {
+	const body = `<Root><Child xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="typ">` +
+		`<Name>a</Name><Name>b</Name></Child></Root>`
+
+	root, err := XMLToStruct(xml.NewDecoder(strings.NewReader(body)), nil)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	child := root.Children["Root"][0].Children["Child"][0]
+
+	var names []string
+	for _, n := range child.Elems("Name") {
+		names = append(names, n.Text)
+	}
+	if e, a := []string{"a", "b"}, names; !reflect.DeepEqual(e, a) {
+		t.Errorf("expect %v elements, got %v", e, a)
+	}
+
+	attr := child.Elems("xsi:type")
+	if e, a := 1, len(attr); e != a {
+		t.Fatalf("expect %v attribute element, got %v", e, a)
+	}
+	if e, a := "typ", attr[0].Text; e != a {
+		t.Errorf("expect %v attribute, got %v", e, a)
+	}
+
+	if elems := child.Elems("Missing"); elems != nil {
+		t.Errorf("expect no elements, got %v", elems)
+	}
+}
@@ -32,10 +32,39 @@ func UnmarshalXMLError(v interface{}, stream io.Reader) error {
 	return nil
 }
 
+// Unmarshaler is implemented by shapes with generated XML deserializers.
+// UnmarshalXML will use the shape's UnmarshalXMLFields method instead of
+// reflection to deserialize the shape's members from the shape's XML
+// element.
+type Unmarshaler interface {
+	UnmarshalXMLFields(node *XMLNode) error
+}
+
 // UnmarshalXML deserializes an xml.Decoder into the container v. V
 // needs to match the shape of the XML expected to be decoded.
 // If the shape doesn't match unmarshaling will fail.
+//
+// Shapes implementing Unmarshaler are deserialized with their generated
+// deserializers instead of reflection.
 func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
+	return xmlParser{}.unmarshalXML(v, d, wrapper)
+}
+
+// UnmarshalXMLWithReflection deserializes an xml.Decoder into the container
+// v, with reflection only. Shapes implementing Unmarshaler are deserialized
+// with reflection instead of their generated deserializers, such as to verify
+// the generated deserializers.
+func UnmarshalXMLWithReflection(v interface{}, d *xml.Decoder, wrapper string) error {
+	return xmlParser{reflectOnly: true}.unmarshalXML(v, d, wrapper)
+}
+
+// xmlParser deserializes XMLNodes into the SDK's shapes.
+type xmlParser struct {
+	// reflectOnly disables the generated deserializers of shapes.
+	reflectOnly bool
+}
+
+func (p xmlParser) unmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 	n, err := XMLToStruct(d, nil)
 	if err != nil {
 		return err
@@ -47,7 +76,7 @@ func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 					c = wrappedChild[0] // pull out wrapped element
 				}
 
-				err = parse(reflect.ValueOf(v), c, "")
+				err = p.parse(reflect.ValueOf(v), c, "")
 				if err != nil {
 					if err == io.EOF {
 						return nil
@@ -63,7 +92,7 @@ func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 
 // parse deserializes any value from the XMLNode. The type tag is used to infer the type, or reflect
 // will be used to determine the type from r.
-func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (p xmlParser) parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	rtype := r.Type()
 	if rtype.Kind() == reflect.Ptr {
 		rtype = rtype.Elem() // check kind of actual element type
@@ -92,11 +121,11 @@ func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		if field, ok := rtype.FieldByName("_"); ok {
 			tag = field.Tag
 		}
-		return parseStruct(r, node, tag)
+		return p.parseStruct(r, node, tag)
 	case "list":
-		return parseList(r, node, tag)
+		return p.parseList(r, node, tag)
 	case "map":
-		return parseMap(r, node, tag)
+		return p.parseMap(r, node, tag)
 	default:
 		return parseScalar(r, node, tag)
 	}
@@ -104,7 +133,7 @@ func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 // parseStruct deserializes a structure and its fields from an XMLNode. Any nested
 // types in the structure will also be deserialized.
-func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (p xmlParser) parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	t := r.Type()
 	if r.Kind() == reflect.Ptr {
 		if r.IsNil() { // create the structure if it's nil
@@ -120,7 +149,13 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	// unwrap any payloads
 	if payload := tag.Get("payload"); payload != "" {
 		field, _ := t.FieldByName(payload)
-		return parseStruct(r.FieldByName(payload), node, field.Tag)
+		return p.parseStruct(r.FieldByName(payload), node, field.Tag)
+	}
+
+	if r.CanAddr() && !p.reflectOnly {
+		if u, ok := r.Addr().Interface().(Unmarshaler); ok {
+			return u.UnmarshalXMLFields(node)
+		}
 	}
 
 	for i := 0; i < t.NumField(); i++ {
@@ -137,18 +172,9 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 			name = locName
 		}
 
-		// try to find the field by name in elements
-		elems := node.Children[name]
-
-		if elems == nil { // try to find the field in attributes
-			if val, ok := node.findElem(name); ok {
-				elems = []*XMLNode{{Text: val}}
-			}
-		}
-
 		member := r.FieldByName(field.Name)
-		for _, elem := range elems {
-			err := parse(member, elem, field.Tag)
+		for _, elem := range node.Elems(name) {
+			err := p.parse(member, elem, field.Tag)
 			if err != nil {
 				return err
 			}
@@ -159,7 +185,7 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 // parseList deserializes a list of values from an XML node. Each list entry
 // will also be deserialized.
-func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (p xmlParser) parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	t := r.Type()
 
 	if tag.Get("flattened") == "" { // look at all item entries
@@ -174,7 +200,7 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 			}
 
 			for i, c := range Children {
-				err := parse(r.Index(i), c, "")
+				err := p.parse(r.Index(i), c, "")
 				if err != nil {
 					return err
 				}
@@ -187,7 +213,7 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 		childR := reflect.Zero(t.Elem())
 		r.Set(reflect.Append(r, childR))
-		err := parse(r.Index(r.Len()-1), node, "")
+		err := p.parse(r.Index(r.Len()-1), node, "")
 		if err != nil {
 			return err
 		}
@@ -198,24 +224,24 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 // parseMap deserializes a map from an XMLNode. The direct children of the XMLNode
 // will also be deserialized as map entries.
-func parseMap(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (p xmlParser) parseMap(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	if r.IsNil() {
 		r.Set(reflect.MakeMap(r.Type()))
 	}
 
 	if tag.Get("flattened") == "" { // look at all child entries
 		for _, entry := range node.Children["entry"] {
-			parseMapEntry(r, entry, tag)
+			p.parseMapEntry(r, entry, tag)
 		}
 	} else { // this element is itself an entry
-		parseMapEntry(r, node, tag)
+		p.parseMapEntry(r, node, tag)
 	}
 
 	return nil
 }
 
 // parseMapEntry deserializes a map entry from a XML node.
-func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (p xmlParser) parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	kname, vname := "key", "value"
 	if n := tag.Get("locationNameKey"); n != "" {
 		kname = n
@@ -232,7 +258,7 @@ func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error
 			value := values[i]
 			valueR := reflect.New(r.Type().Elem()).Elem()
 
-			parse(valueR, value, "")
+			p.parse(valueR, value, "")
 			r.SetMapIndex(keyR, valueR)
 		}
 	}
@@ -117,6 +117,19 @@ func (n *XMLNode) findElem(name string) (string, bool) {
 	return "", false
 }
 
+// Elems returns the node's child elements with the name. If the node does not
+// have child elements with the name, the value of the node's, or its
+// parents', attribute with the name is returned as a text element.
+func (n *XMLNode) Elems(name string) []*XMLNode {
+	if elems := n.Children[name]; elems != nil {
+		return elems
+	}
+	if val, ok := n.findElem(name); ok {
+		return []*XMLNode{{Text: val}}
+	}
+	return nil
+}
+
 // StructToXML writes an XMLNode to a xml.Encoder as tokens.
 func StructToXML(e *xml.Encoder, node *XMLNode, sorted bool) error {
 	// Sort Attributes
@@ -0,0 +1,213 @@
+package dynamodb_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+var (
+	_ jsonutil.Marshaler   = (*dynamodb.PutItemInput)(nil)
+	_ jsonutil.Unmarshaler = (*dynamodb.QueryOutput)(nil)
+)
+
+// parityItem returns an item using each of the attribute value types. Lists
+// do not contain nil elements, which the reflection based marshaler writes as
+// invalid JSON.
+func parityItem() map[string]*dynamodb.AttributeValue {
+	return map[string]*dynamodb.AttributeValue{
+		"id":    {S: aws.String("abc \"quoted\" ⌘")},
+		"count": {N: aws.String("123.45")},
+		"blob":  {B: []byte{0, 1, 2, 255}},
+		"flag":  {BOOL: aws.Bool(true)},
+		"null":  {NULL: aws.Bool(true)},
+		"ss":    {SS: []*string{aws.String("a"), aws.String("b")}},
+		"ns":    {NS: []*string{aws.String("1"), aws.String("-2.5e3")}},
+		"bs":    {BS: [][]byte{{1}, {}}},
+		"list": {L: []*dynamodb.AttributeValue{
+			{S: aws.String("elem")},
+			{M: map[string]*dynamodb.AttributeValue{
+				"nested": {L: []*dynamodb.AttributeValue{}},
+			}},
+		}},
+		"map": {M: map[string]*dynamodb.AttributeValue{
+			"b": {N: aws.String("2")},
+			"a": {M: map[string]*dynamodb.AttributeValue{}},
+		}},
+	}
+}
+
+func TestFieldMarshalersParity(t *testing.T) {
+	cases := map[string]interface{}{
+		"PutItem": &dynamodb.PutItemInput{
+			TableName:           aws.String("table"),
+			Item:                parityItem(),
+			ConditionExpression: aws.String("attribute_not_exists(id)"),
+			ExpressionAttributeNames: map[string]*string{
+				"#c": aws.String("count"),
+			},
+			ExpressionAttributeValues: map[string]*dynamodb.AttributeValue{
+				":v": {N: aws.String("1")},
+			},
+			ReturnValues: aws.String(dynamodb.ReturnValueAllOld),
+		},
+		"Query": &dynamodb.QueryInput{
+			TableName:              aws.String("table"),
+			IndexName:              aws.String("index"),
+			KeyConditionExpression: aws.String("id = :id"),
+			ExclusiveStartKey:      parityItem(),
+			Limit:                  aws.Int64(10),
+			ScanIndexForward:       aws.Bool(false),
+			KeyConditions: map[string]*dynamodb.Condition{
+				"id": {
+					ComparisonOperator: aws.String(dynamodb.ComparisonOperatorEq),
+					AttributeValueList: []*dynamodb.AttributeValue{{S: aws.String("abc")}},
+				},
+			},
+		},
+		"BatchWriteItem": &dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				"table": {
+					{PutRequest: &dynamodb.PutRequest{Item: parityItem()}},
+					{DeleteRequest: &dynamodb.DeleteRequest{
+						Key: map[string]*dynamodb.AttributeValue{"id": {S: aws.String("abc")}},
+					}},
+				},
+			},
+		},
+		"TransactWriteItems": &dynamodb.TransactWriteItemsInput{
+			ClientRequestToken: aws.String("token"),
+			TransactItems: []*dynamodb.TransactWriteItem{
+				{Update: &dynamodb.Update{
+					TableName:        aws.String("table"),
+					Key:              parityItem(),
+					UpdateExpression: aws.String("SET #c = :v"),
+				}},
+			},
+		},
+		"CreateTable": &dynamodb.CreateTableInput{
+			TableName: aws.String("table"),
+			AttributeDefinitions: []*dynamodb.AttributeDefinition{
+				{AttributeName: aws.String("id"), AttributeType: aws.String("S")},
+			},
+			KeySchema: []*dynamodb.KeySchemaElement{
+				{AttributeName: aws.String("id"), KeyType: aws.String("HASH")},
+			},
+			ProvisionedThroughput: &dynamodb.ProvisionedThroughput{
+				ReadCapacityUnits:  aws.Int64(1),
+				WriteCapacityUnits: aws.Int64(1),
+			},
+			Tags: []*dynamodb.Tag{},
+		},
+		"RestoreTableToPointInTime": &dynamodb.RestoreTableToPointInTimeInput{
+			SourceTableName: aws.String("source"),
+			TargetTableName: aws.String("target"),
+			RestoreDateTime: aws.Time(time.Unix(1500000000, 123000000)),
+		},
+		"Empty": &dynamodb.ListTablesInput{},
+	}
+
+	for name, input := range cases {
+		expect, err := jsonutil.BuildJSONWithReflection(input)
+		if err != nil {
+			t.Fatalf("%s, expect no reflection error, got %v", name, err)
+		}
+		actual, err := jsonutil.BuildJSON(input)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if !bytes.Equal(expect, actual) {
+			t.Errorf("%s, expect generated marshaler to match reflection\nexpect: %s\nactual: %s",
+				name, expect, actual)
+		}
+	}
+}
+
+func TestFieldUnmarshalersParity(t *testing.T) {
+	cases := map[string]struct {
+		Output interface{}
+		Body   string
+	}{
+		"Query": {
+			Output: &dynamodb.QueryOutput{},
+			Body: `{"Count":2,"ScannedCount":2.0,"Items":[{"id":{"S":"a"},"n":{"N":"1"}},null,` +
+				`{"list":{"L":[{"M":{"k":{"BS":["AAE=",null]}}},{"NULL":true}]}}],` +
+				`"LastEvaluatedKey":{"id":{"S":"a"},"bad":"value"},` +
+				`"ConsumedCapacity":{"TableName":"table","CapacityUnits":1.5,` +
+				`"Table":{"CapacityUnits":0.5},"Unknown":{"a":[1,{"b":[]}]}}}`,
+		},
+		"DescribeTable": {
+			Output: &dynamodb.DescribeTableOutput{},
+			Body: `{"Table":{"TableName":"table","TableStatus":"ACTIVE","CreationDateTime":1.5e9,` +
+				`"ItemCount":10,"TableSizeBytes":1e3,"KeySchema":[{"AttributeName":"id","KeyType":"HASH"}],` +
+				`"ProvisionedThroughput":{"LastIncreaseDateTime":"2019-01-02T03:04:05Z",` +
+				`"NumberOfDecreasesToday":0},"StreamSpecification":{"StreamEnabled":false},` +
+				`"GlobalSecondaryIndexes":[],"LocalSecondaryIndexes":null}}`,
+		},
+		"BatchGetItem": {
+			Output: &dynamodb.BatchGetItemOutput{},
+			Body: `{"Responses":{"table":[{"id":{"S":"a"}}],"other":[]},` +
+				`"UnprocessedKeys":{"table":{"Keys":[{"id":{"S":"b"}}],"ConsistentRead":true}}}`,
+		},
+		"TransactGetItems": {
+			Output: &dynamodb.TransactGetItemsOutput{},
+			Body:   `{"Responses":[{"Item":{"id":{"S":"a"},"m":{"M":{"x":{"SS":["a","b"]}}}}}]}`,
+		},
+		"Empty": {
+			Output: &dynamodb.PutItemOutput{},
+			Body:   `{}`,
+		},
+	}
+
+	for name, c := range cases {
+		expect := reflect.New(reflect.TypeOf(c.Output).Elem()).Interface()
+		err := jsonutil.UnmarshalJSONWithReflection(expect, strings.NewReader(c.Body))
+		if err != nil {
+			t.Fatalf("%s, expect no reflection error, got %v", name, err)
+		}
+
+		actual := reflect.New(reflect.TypeOf(c.Output).Elem()).Interface()
+		if err := jsonutil.UnmarshalJSON(actual, strings.NewReader(c.Body)); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if !reflect.DeepEqual(expect, actual) {
+			t.Errorf("%s, expect generated unmarshaler to match reflection\nexpect: %s\nactual: %s",
+				name, awsutil.Prettify(expect), awsutil.Prettify(actual))
+		}
+	}
+}
+
+func TestFieldUnmarshalersParity_RoundTrip(t *testing.T) {
+	output := &dynamodb.ScanOutput{
+		Count: aws.Int64(1),
+		Items: []map[string]*dynamodb.AttributeValue{parityItem(), {}},
+		ConsumedCapacity: &dynamodb.ConsumedCapacity{
+			TableName: aws.String("table"),
+			GlobalSecondaryIndexes: map[string]*dynamodb.Capacity{
+				"index": {ReadCapacityUnits: aws.Float64(0.5)},
+			},
+		},
+	}
+
+	body, err := jsonutil.BuildJSONWithReflection(output)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	actual := &dynamodb.ScanOutput{}
+	if err := jsonutil.UnmarshalJSON(actual, bytes.NewReader(body)); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if !reflect.DeepEqual(output, actual) {
+		t.Errorf("expect round trip to match\nexpect: %s\nactual: %s",
+			awsutil.Prettify(output), awsutil.Prettify(actual))
+	}
+}
@@ -0,0 +1,210 @@
+package sqs_test
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/aws/aws-sdk-go/private/protocol/query/queryutil"
+	"github.com/aws/aws-sdk-go/private/protocol/xml/xmlutil"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+var (
+	_ queryutil.Marshaler = (*sqs.SendMessageBatchInput)(nil)
+	_ xmlutil.Unmarshaler = (*sqs.ReceiveMessageOutput)(nil)
+)
+
+func parityMessageAttributes() map[string]*sqs.MessageAttributeValue {
+	return map[string]*sqs.MessageAttributeValue{
+		"str": {DataType: aws.String("String"), StringValue: aws.String("a b&c=d")},
+		"bin": {DataType: aws.String("Binary"), BinaryValue: []byte{0, 1, 255}},
+		"lists": {
+			DataType:         aws.String("String"),
+			StringListValues: []*string{aws.String("a"), aws.String("b")},
+			BinaryListValues: [][]byte{{1}, {}},
+		},
+	}
+}
+
+func TestFieldMarshalersParity(t *testing.T) {
+	cases := map[string]interface{}{
+		"SendMessageBatch": &sqs.SendMessageBatchInput{
+			QueueUrl: aws.String("https://queue.amazonaws.com/123456789012/queue"),
+			Entries: []*sqs.SendMessageBatchRequestEntry{
+				{
+					Id:                aws.String("1"),
+					MessageBody:       aws.String("body ⌘"),
+					DelaySeconds:      aws.Int64(5),
+					MessageAttributes: parityMessageAttributes(),
+					MessageSystemAttributes: map[string]*sqs.MessageSystemAttributeValue{
+						"AWSTraceHeader": {DataType: aws.String("String"), StringValue: aws.String("trace")},
+					},
+				},
+				{
+					Id:                     aws.String("2"),
+					MessageBody:            aws.String(""),
+					MessageDeduplicationId: aws.String("dedup"),
+					MessageGroupId:         aws.String("group"),
+					MessageAttributes:      map[string]*sqs.MessageAttributeValue{},
+				},
+			},
+		},
+		"SendMessage": &sqs.SendMessageInput{
+			QueueUrl:          aws.String("url"),
+			MessageBody:       aws.String("body"),
+			MessageAttributes: parityMessageAttributes(),
+		},
+		"ReceiveMessage": &sqs.ReceiveMessageInput{
+			QueueUrl:              aws.String("url"),
+			AttributeNames:        []*string{aws.String("All")},
+			MessageAttributeNames: []*string{},
+			MaxNumberOfMessages:   aws.Int64(10),
+			WaitTimeSeconds:       aws.Int64(20),
+		},
+		"CreateQueue": &sqs.CreateQueueInput{
+			QueueName: aws.String("queue"),
+			Attributes: map[string]*string{
+				"VisibilityTimeout": aws.String("30"),
+				"FifoQueue":         aws.String("true"),
+			},
+			Tags: map[string]*string{"team": aws.String("a")},
+		},
+		"ChangeMessageVisibilityBatch": &sqs.ChangeMessageVisibilityBatchInput{
+			QueueUrl: aws.String("url"),
+			Entries: []*sqs.ChangeMessageVisibilityBatchRequestEntry{
+				{Id: aws.String("1"), ReceiptHandle: aws.String("h"), VisibilityTimeout: aws.Int64(0)},
+			},
+		},
+		"RemovePermission": &sqs.RemovePermissionInput{
+			QueueUrl: aws.String("url"),
+			Label:    aws.String("label"),
+		},
+		"AddPermission": &sqs.AddPermissionInput{
+			QueueUrl:      aws.String("url"),
+			Label:         aws.String("label"),
+			AWSAccountIds: []*string{aws.String("123"), aws.String("456")},
+			Actions:       []*string{aws.String("SendMessage")},
+		},
+	}
+
+	for name, input := range cases {
+		expect := url.Values{}
+		if err := queryutil.ParseWithReflection(expect, input, false); err != nil {
+			t.Fatalf("%s, expect no reflection error, got %v", name, err)
+		}
+		actual := url.Values{}
+		if err := queryutil.Parse(actual, input, false); err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+		if e, a := expect.Encode(), actual.Encode(); e != a {
+			t.Errorf("%s, expect generated marshaler to match reflection\nexpect: %s\nactual: %s",
+				name, e, a)
+		}
+	}
+}
+
+func TestFieldUnmarshalersParity(t *testing.T) {
+	cases := map[string]struct {
+		Output  interface{}
+		Wrapper string
+		Body    string
+	}{
+		"ReceiveMessage": {
+			Output:  &sqs.ReceiveMessageOutput{},
+			Wrapper: "ReceiveMessageResult",
+			Body: `<ReceiveMessageResponse><ReceiveMessageResult>
+<Message>
+	<MessageId>id-1</MessageId>
+	<ReceiptHandle>handle</ReceiptHandle>
+	<MD5OfBody>fafb00f5732ab283681e124bf8747ed1</MD5OfBody>
+	<Body>This is a test message</Body>
+	<Attribute><Name>SenderId</Name><Value>195004372649</Value></Attribute>
+	<Attribute><Name>SentTimestamp</Name><Value>1238099229000</Value></Attribute>
+	<MessageAttribute>
+		<Name>bin</Name>
+		<Value><DataType>Binary</DataType><BinaryValue>AAH/</BinaryValue></Value>
+	</MessageAttribute>
+	<MessageAttribute>
+		<Name>lists</Name>
+		<Value>
+			<DataType>String</DataType>
+			<StringListValue>a</StringListValue><StringListValue>b</StringListValue>
+			<BinaryListValue>AQ==</BinaryListValue>
+		</Value>
+	</MessageAttribute>
+</Message>
+<Message><MessageId>id-2</MessageId><Body></Body></Message>
+</ReceiveMessageResult>
+<ResponseMetadata><RequestId>request-id</RequestId></ResponseMetadata>
+</ReceiveMessageResponse>`,
+		},
+		"SendMessageBatch": {
+			Output:  &sqs.SendMessageBatchOutput{},
+			Wrapper: "SendMessageBatchResult",
+			Body: `<SendMessageBatchResponse><SendMessageBatchResult>
+<SendMessageBatchResultEntry>
+	<Id>1</Id><MessageId>id-1</MessageId>
+	<MD5OfMessageBody>0e024d309850c78cba5eabbeff7cae71</MD5OfMessageBody>
+	<SequenceNumber>100</SequenceNumber>
+</SendMessageBatchResultEntry>
+<SendMessageBatchResultEntry><Id>2</Id><MessageId>id-2</MessageId></SendMessageBatchResultEntry>
+<BatchResultErrorEntry>
+	<Id>3</Id><Code>InvalidParameterValue</Code><Message>invalid</Message><SenderFault>true</SenderFault>
+</BatchResultErrorEntry>
+</SendMessageBatchResult></SendMessageBatchResponse>`,
+		},
+		"GetQueueAttributes": {
+			Output:  &sqs.GetQueueAttributesOutput{},
+			Wrapper: "GetQueueAttributesResult",
+			Body: `<GetQueueAttributesResponse><GetQueueAttributesResult>
+<Attribute><Name>VisibilityTimeout</Name><Value>30</Value></Attribute>
+<Attribute><Name>DelaySeconds</Name><Value>0</Value></Attribute>
+</GetQueueAttributesResult></GetQueueAttributesResponse>`,
+		},
+		"ListQueues": {
+			Output:  &sqs.ListQueuesOutput{},
+			Wrapper: "ListQueuesResult",
+			Body: `<ListQueuesResponse><ListQueuesResult>
+<QueueUrl>https://queue.amazonaws.com/123456789012/a</QueueUrl>
+<QueueUrl>https://queue.amazonaws.com/123456789012/b</QueueUrl>
+</ListQueuesResult></ListQueuesResponse>`,
+		},
+		"ListQueueTags": {
+			Output:  &sqs.ListQueueTagsOutput{},
+			Wrapper: "ListQueueTagsResult",
+			Body: `<ListQueueTagsResponse><ListQueueTagsResult>
+<Tag><Key>team</Key><Value>a</Value></Tag>
+</ListQueueTagsResult></ListQueueTagsResponse>`,
+		},
+		"Empty": {
+			Output:  &sqs.SendMessageBatchOutput{},
+			Wrapper: "SendMessageBatchResult",
+			Body:    `<SendMessageBatchResponse><SendMessageBatchResult/></SendMessageBatchResponse>`,
+		},
+	}
+
+	for name, c := range cases {
+		expect := reflect.New(reflect.TypeOf(c.Output).Elem()).Interface()
+		err := xmlutil.UnmarshalXMLWithReflection(expect,
+			xml.NewDecoder(strings.NewReader(c.Body)), c.Wrapper)
+		if err != nil {
+			t.Fatalf("%s, expect no reflection error, got %v", name, err)
+		}
+
+		actual := reflect.New(reflect.TypeOf(c.Output).Elem()).Interface()
+		err = xmlutil.UnmarshalXML(actual, xml.NewDecoder(strings.NewReader(c.Body)), c.Wrapper)
+		if err != nil {
+			t.Fatalf("%s, expect no error, got %v", name, err)
+		}
+
+		if !reflect.DeepEqual(expect, actual) {
+			t.Errorf("%s, expect generated unmarshaler to match reflection\nexpect: %s\nactual: %s",
+				name, awsutil.Prettify(expect), awsutil.Prettify(actual))
+		}
+	}
+}